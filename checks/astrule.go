@@ -0,0 +1,256 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// astrule is a pattern matching engine on the Go AST.
+
+package checks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/scanner"
+	"go/token"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// ASTRule matches user defined patterns against the AST of the modified
+// files.
+type ASTRule struct {
+	Limits `yaml:",inline"`
+
+	Rules []ASTPattern `yaml:"rules"`
+}
+
+// ASTPattern is a single rule of ASTRule.
+//
+// Pattern is a Go expression where identifiers starting with '$' are
+// wildcards matching any expression, e.g. "fmt.Errorf($x)". A wildcard used
+// multiple times must match the same expression each time, e.g. "$x == $x". The
+// wildcard "$_" matches anything and is never bound.
+type ASTPattern struct {
+	Pattern string `yaml:"pattern"`
+	Message string `yaml:"message"`
+	// Severity is either "error" (default) or "warning". The issues of a rule
+	// with severity "warning" are reported without failing the run, like the
+	// ones of a check with severity "warning".
+	Severity string `yaml:"severity"`
+}
+
+// GetDescription implements Check.
+func (a *ASTRule) GetDescription() string {
+	return "enforces user defined AST patterns are not used"
+}
+
+// GetName implements Check.
+func (a *ASTRule) GetName() string {
+	return "astrule"
+}
+
+// GetPrerequisites implements Check.
+func (a *ASTRule) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+//
+// When only rules with severity "warning" match, the check fails with issues
+// all of severity "warning", which doesn't fail the run.
+func (a *ASTRule) Run(change scm.Change, options *Options) Result {
+	issues, err := a.run(change)
+	if err != nil {
+		return newResult(err, ParseIssues)
+	}
+	if len(issues) == 0 {
+		return Result{}
+	}
+	lines := make([]string, len(issues))
+	for i, issue := range issues {
+		lines[i] = fmt.Sprintf("%s:%d:%d: %s", issue.File, issue.Line, issue.Column, issue.Message)
+	}
+	return Result{Err: errors.New("astrule failed:\n" + strings.Join(lines, "\n")), Issues: issues}
+}
+
+func (a *ASTRule) run(change scm.Change) ([]Issue, error) {
+	patterns := make([]ast.Expr, len(a.Rules))
+	for i, r := range a.Rules {
+		if r.Severity != "" && r.Severity != "error" && r.Severity != "warning" {
+			return nil, fmt.Errorf("invalid severity \"%s\" for pattern %q", r.Severity, r.Pattern)
+		}
+		p, err := parsePattern(r.Pattern)
+		if err != nil {
+			return nil, err
+		}
+		patterns[i] = p
+	}
+	var issues []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f, content, 0)
+		if err != nil {
+			if list, ok := err.(scanner.ErrorList); ok {
+				for _, e := range list {
+					issues = append(issues, Issue{File: f, Line: e.Pos.Line, Column: e.Pos.Column, Message: e.Msg, Severity: SeverityError})
+				}
+			} else {
+				issues = append(issues, Issue{File: f, Message: err.Error(), Severity: SeverityError})
+			}
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			e, ok := n.(ast.Expr)
+			if !ok {
+				return true
+			}
+			for i, p := range patterns {
+				if matchAST(p, e, map[string]string{}) {
+					pos := fset.Position(e.Pos())
+					issue := Issue{File: f, Line: pos.Line, Column: pos.Column, Message: fmt.Sprintf("%s (%s)", a.Rules[i].Message, a.Rules[i].Pattern), Severity: SeverityError}
+					if a.Rules[i].Severity == "warning" {
+						issue.Severity = SeverityWarning
+					}
+					issues = append(issues, issue)
+				}
+			}
+			return true
+		})
+	}
+	sort.Sort(issuesByLocation(issues))
+	return issues, nil
+}
+
+// Private stuff.
+
+// issuesByLocation sorts the issues by file, line, column then message.
+type issuesByLocation []Issue
+
+func (i issuesByLocation) Len() int      { return len(i) }
+func (i issuesByLocation) Swap(x, y int) { i[x], i[y] = i[y], i[x] }
+func (i issuesByLocation) Less(x, y int) bool {
+	if i[x].File != i[y].File {
+		return i[x].File < i[y].File
+	}
+	if i[x].Line != i[y].Line {
+		return i[x].Line < i[y].Line
+	}
+	if i[x].Column != i[y].Column {
+		return i[x].Column < i[y].Column
+	}
+	return i[x].Message < i[y].Message
+}
+
+// wildcardPrefix replaces '$' in patterns so they can be parsed by go/parser.
+const wildcardPrefix = "pcg_wildcard_"
+
+var reWildcard = regexp.MustCompile(`\$([A-Za-z_][A-Za-z0-9_]*)`)
+
+// parsePattern parses an ASTPattern.Pattern into an AST.
+func parsePattern(pattern string) (ast.Expr, error) {
+	e, err := parser.ParseExpr(reWildcard.ReplaceAllString(pattern, wildcardPrefix+"$1"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid pattern %q: %s", pattern, err)
+	}
+	return e, nil
+}
+
+var (
+	typePos    = reflect.TypeOf(token.NoPos)
+	typeObject = reflect.TypeOf(&ast.Object{})
+	typeScope  = reflect.TypeOf(&ast.Scope{})
+	typeIdent  = reflect.TypeOf(&ast.Ident{})
+)
+
+// matchAST returns true if node matches pattern. Wildcards are bound in
+// bindings to the printed form of the expression they matched.
+func matchAST(pattern, node ast.Node, bindings map[string]string) bool {
+	return matchValue(reflect.ValueOf(pattern), reflect.ValueOf(node), bindings)
+}
+
+func matchValue(p, n reflect.Value, bindings map[string]string) bool {
+	if p.Kind() == reflect.Interface {
+		if p.IsNil() || n.IsNil() {
+			return p.IsNil() && n.IsNil()
+		}
+		p = p.Elem()
+		n = n.Elem()
+	}
+	if p.Type() == typeIdent && !p.IsNil() {
+		id := p.Interface().(*ast.Ident)
+		if strings.HasPrefix(id.Name, wildcardPrefix) {
+			e, ok := n.Interface().(ast.Expr)
+			if !ok || n.IsNil() {
+				return false
+			}
+			name := id.Name[len(wildcardPrefix):]
+			if name == "_" {
+				return true
+			}
+			printed := printExpr(e)
+			if prev, ok := bindings[name]; ok {
+				return prev == printed
+			}
+			bindings[name] = printed
+			return true
+		}
+	}
+	if p.Type() != n.Type() {
+		return false
+	}
+	switch p.Kind() {
+	case reflect.Ptr:
+		if p.IsNil() || n.IsNil() {
+			return p.IsNil() && n.IsNil()
+		}
+		return matchValue(p.Elem(), n.Elem(), bindings)
+	case reflect.Slice:
+		if p.Len() != n.Len() {
+			return false
+		}
+		for i := 0; i < p.Len(); i++ {
+			if !matchValue(p.Index(i), n.Index(i), bindings) {
+				return false
+			}
+		}
+		return true
+	case reflect.Struct:
+		for i := 0; i < p.NumField(); i++ {
+			switch p.Field(i).Type() {
+			case typePos, typeObject, typeScope:
+				continue
+			}
+			if p.Type().Field(i).Name == "Comment" || p.Type().Field(i).Name == "Doc" {
+				continue
+			}
+			if !matchValue(p.Field(i), n.Field(i), bindings) {
+				return false
+			}
+		}
+		return true
+	case reflect.String:
+		return p.String() == n.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return p.Int() == n.Int()
+	case reflect.Bool:
+		return p.Bool() == n.Bool()
+	}
+	return true
+}
+
+func printExpr(e ast.Expr) string {
+	b := &bytes.Buffer{}
+	_ = printer.Fprint(b, token.NewFileSet(), e)
+	return b.String()
+}
@@ -0,0 +1,75 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"go/parser"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestMatchAST(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		pattern  string
+		expr     string
+		expected bool
+	}{
+		{"fmt.Errorf($x)", "fmt.Errorf(\"foo\")", true},
+		{"fmt.Errorf($x)", "fmt.Errorf(\"foo %d\", 1)", false},
+		{"fmt.Errorf($x, $_)", "fmt.Errorf(\"foo %d\", 1)", true},
+		{"fmt.Errorf($x)", "fmt.Printf(\"foo\")", false},
+		{"$x == $x", "a.b == a.b", true},
+		{"$x == $x", "a.b == a.c", false},
+		{"$_ == $_", "a.b == a.c", true},
+		{"len($x) == 0", "len(foo) == 0", true},
+		{"len($x) == 0", "len(foo) == 1", false},
+		{"time.$f()", "time.Now()", true},
+	}
+	for i, line := range data {
+		p, err := parsePattern(line.pattern)
+		ut.AssertEqualIndex(t, i, nil, err)
+		e, err := parser.ParseExpr(line.expr)
+		ut.AssertEqualIndex(t, i, nil, err)
+		ut.AssertEqualIndex(t, i, line.expected, matchAST(p, e, map[string]string{}))
+	}
+}
+
+func TestParsePatternInvalid(t *testing.T) {
+	t.Parallel()
+	_, err := parsePattern("foo(")
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestASTRuleSeverity(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{
+		"foo.go": "package foo\n\nimport \"errors\"\n\nvar a = errors.New(\"a\")\nvar b = len(a.Error()) == 0\n",
+	})
+	warn := ASTPattern{Pattern: "errors.New($x)", Message: "no errors", Severity: "warning"}
+	fail := ASTPattern{Pattern: "len($x) == 0", Message: "no len"}
+
+	a := &ASTRule{Rules: []ASTPattern{warn}}
+	r := a.Run(change, &Options{})
+	ut.AssertEqual(t, errors.New("astrule failed:\nfoo.go:5:9: no errors (errors.New($x))"), r.Err)
+	ut.AssertEqual(t, []Issue{{File: "foo.go", Line: 5, Column: 9, Message: "no errors (errors.New($x))", Severity: SeverityWarning}}, r.Issues)
+
+	a = &ASTRule{Rules: []ASTPattern{fail, warn}}
+	r = a.Run(change, &Options{})
+	ut.AssertEqual(t, errors.New("astrule failed:\nfoo.go:5:9: no errors (errors.New($x))\nfoo.go:6:9: no len (len($x) == 0)"), r.Err)
+	expected := []Issue{
+		{File: "foo.go", Line: 5, Column: 9, Message: "no errors (errors.New($x))", Severity: SeverityWarning},
+		{File: "foo.go", Line: 6, Column: 9, Message: "no len (len($x) == 0)", Severity: SeverityError},
+	}
+	ut.AssertEqual(t, expected, r.Issues)
+
+	a = &ASTRule{Rules: []ASTPattern{{Pattern: "foo", Severity: "info"}}}
+	ut.AssertEqual(t, errors.New("invalid severity \"info\" for pattern \"foo\""), a.Run(change, &Options{}).Err)
+}
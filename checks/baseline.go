@@ -0,0 +1,155 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// baseline grandfathers the existing lint findings.
+
+package checks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// BaselineFile is the name of the baseline file at the root of the
+// repository. It is meant to be committed so the findings grandfathered are
+// shared.
+const BaselineFile = "pre-commit-go-baseline.json"
+
+// Baseline is the set of lint findings grandfathered in. The findings in it
+// are not reported by the lint checks.
+//
+// A finding is recorded as "file: message", without the line number, so it
+// survives unrelated edits of the file. It is safe to use concurrently. A nil
+// *Baseline grandfathers nothing.
+type Baseline struct {
+	lock      sync.Mutex
+	recording bool
+	findings  map[string]map[string]bool
+}
+
+// NewBaseline returns an empty Baseline. When recording is true, every finding
+// is added to it and none is reported.
+func NewBaseline(recording bool) *Baseline {
+	return &Baseline{recording: recording, findings: map[string]map[string]bool{}}
+}
+
+// LoadBaseline loads the baseline file p. It returns nil if the file doesn't
+// exist.
+func LoadBaseline(p string) (*Baseline, error) {
+	content, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var findings map[string][]string
+	if err := json.Unmarshal(content, &findings); err != nil {
+		return nil, err
+	}
+	b := NewBaseline(false)
+	for check, items := range findings {
+		for _, f := range items {
+			b.add(check, f)
+		}
+	}
+	return b, nil
+}
+
+// Save writes the baseline file p, keyed by check name.
+func (b *Baseline) Save(p string) error {
+	content, err := json.MarshalIndent(b.Findings(), "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, append(content, '\n'), 0666)
+}
+
+// Findings returns the findings, keyed by check name, sorted.
+func (b *Baseline) Findings() map[string][]string {
+	out := map[string][]string{}
+	if b == nil {
+		return out
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	for check, items := range b.findings {
+		for f := range items {
+			out[check] = append(out[check], f)
+		}
+		sort.Strings(out[check])
+	}
+	return out
+}
+
+// UsesBaseline returns true if the check filters its findings with the
+// baseline.
+func UsesBaseline(check Check) bool {
+	return baselineChecks[check.GetName()]
+}
+
+// Private stuff.
+
+// baselineChecks are the checks calling isBaselined.
+var baselineChecks = map[string]bool{
+	"errcheck":      true,
+	"golangci-lint": true,
+	"golint":        true,
+	"govet":         true,
+}
+
+func (b *Baseline) add(check, finding string) {
+	if b.findings[check] == nil {
+		b.findings[check] = map[string]bool{}
+	}
+	b.findings[check][finding] = true
+}
+
+// contains returns true if the finding is grandfathered. When recording, the
+// finding is added first.
+func (b *Baseline) contains(check, finding string) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.recording {
+		b.add(check, finding)
+		return true
+	}
+	return b.findings[check][finding]
+}
+
+// isBaselined returns true if the finding message of check on file must not
+// be reported because it is in the baseline.
+func (o *Options) isBaselined(check Check, file, message string) bool {
+	if o.Baseline == nil {
+		return false
+	}
+	return o.Baseline.contains(check.GetName(), file+": "+message)
+}
+
+// lintMessage returns the message from an output line in the form
+// "file:line:col: message", with the line and column stripped.
+func lintMessage(l string) string {
+	// TODO(maruel): Will fail with files with ':' in their name.
+	items := strings.SplitN(l, ":", 2)
+	if len(items) != 2 {
+		return strings.TrimSpace(l)
+	}
+	rest := items[1]
+	for i := 0; i < 2; i++ {
+		j := strings.Index(rest, ":")
+		if j <= 0 {
+			break
+		}
+		if _, err := strconv.Atoi(rest[:j]); err != nil {
+			break
+		}
+		rest = rest[j+1:]
+	}
+	return strings.TrimSpace(rest)
+}
@@ -0,0 +1,66 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestBaseline(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	p := filepath.Join(td, BaselineFile)
+	b, err := LoadBaseline(p)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, (*Baseline)(nil), b)
+	ut.AssertEqual(t, false, (&Options{Baseline: b}).isBaselined(&Golint{}, "a.go", "bad"))
+
+	options := &Options{Baseline: NewBaseline(true)}
+	ut.AssertEqual(t, true, options.isBaselined(&Golint{}, "a.go", "bad"))
+	ut.AssertEqual(t, true, options.isBaselined(&Govet{}, "b.go", "worse"))
+	ut.AssertEqual(t, true, options.isBaselined(&Golint{}, "a.go", "also bad"))
+	ut.AssertEqual(t, nil, options.Baseline.Save(p))
+
+	b, err = LoadBaseline(p)
+	ut.AssertEqual(t, nil, err)
+	expected := map[string][]string{
+		"golint": {"a.go: also bad", "a.go: bad"},
+		"govet":  {"b.go: worse"},
+	}
+	ut.AssertEqual(t, expected, b.Findings())
+	options = &Options{Baseline: b}
+	ut.AssertEqual(t, true, options.isBaselined(&Golint{}, "a.go", "bad"))
+	ut.AssertEqual(t, false, options.isBaselined(&Golint{}, "a.go", "new"))
+	ut.AssertEqual(t, false, options.isBaselined(&Golint{}, "b.go", "worse"))
+	ut.AssertEqual(t, expected, b.Findings())
+}
+
+func TestLintMessage(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		line     string
+		expected string
+	}{
+		{"a.go:12:5: exported func Foo should have comment", "exported func Foo should have comment"},
+		{"a.go:12: unreachable code", "unreachable code"},
+		{"/src/a.go:3:2:\tf.Close()", "f.Close()"},
+		{"a.go: bad: really", "bad: really"},
+		{"no colon", "no colon"},
+	}
+	for i, item := range data {
+		ut.AssertEqualIndex(t, i, item.expected, lintMessage(item.line))
+	}
+}
+
+func TestUsesBaseline(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, true, UsesBaseline(&Govet{}))
+	ut.AssertEqual(t, false, UsesBaseline(&Gofmt{}))
+}
@@ -0,0 +1,163 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// boundaries enforces the import graph respects the package boundaries.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Boundaries enforces that no package imports another tree's internal
+// packages and that the layering rules between top-level directories are
+// respected.
+//
+// The import path of the repository is the module declared in the root go.mod
+// when present, otherwise its path relative to $GOPATH/src.
+type Boundaries struct {
+	Limits `yaml:",inline"`
+
+	// Layers is the list of layering rules.
+	Layers []LayerRule `yaml:"layers"`
+}
+
+// LayerRule forbids a top-level directory from importing packages in other
+// top-level directories of the repository.
+type LayerRule struct {
+	// From is the top-level directory the rule applies to, e.g. "scm". Use "."
+	// for the package at the root of the repository.
+	From string `yaml:"from"`
+	// Deny is the list of top-level directories From must not import.
+	Deny []string `yaml:"deny"`
+}
+
+// GetDescription implements Check.
+func (b *Boundaries) GetDescription() string {
+	return "enforces internal packages and layering rules are respected"
+}
+
+// GetName implements Check.
+func (b *Boundaries) GetName() string {
+	return "boundaries"
+}
+
+// GetPrerequisites implements Check.
+func (b *Boundaries) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (b *Boundaries) Run(change scm.Change, options *Options) Result {
+	return newResult(b.run(change, options), ParseIssues)
+}
+
+func (b *Boundaries) run(change scm.Change, options *Options) error {
+	root := rootImportPath(change)
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f, content, parser.ImportsOnly)
+		if err != nil {
+			bad = append(bad, parseErrorIssues(err)...)
+			continue
+		}
+		dir := path.Dir(f)
+		for _, i := range file.Imports {
+			p, err := strconv.Unquote(i.Path.Value)
+			if err != nil {
+				continue
+			}
+			if reason := b.violation(root, dir, p); reason != "" {
+				bad = append(bad, issueAt(fset.Position(i.Pos()), "%s -> %s: %s", importPathOf(root, dir), p, reason))
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("forbidden imports:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// violation returns why the package in directory dir, relative to the root
+// of the repository at import path root, can't import p. Returns an empty
+// string if the import is allowed.
+func (b *Boundaries) violation(root, dir, p string) string {
+	if parent, ok := internalParent(p); ok && root != "" {
+		importer := importPathOf(root, dir)
+		if importer != parent && !strings.HasPrefix(importer, parent+"/") {
+			return "use of internal package outside of " + parent
+		}
+	}
+	if root == "" || !strings.HasPrefix(p, root+"/") {
+		return ""
+	}
+	from := strings.SplitN(dir, "/", 2)[0]
+	to := strings.SplitN(p[len(root)+1:], "/", 2)[0]
+	for _, l := range b.Layers {
+		if l.From != from {
+			continue
+		}
+		for _, d := range l.Deny {
+			if d == to {
+				return fmt.Sprintf("%s must not import %s", from, to)
+			}
+		}
+	}
+	return ""
+}
+
+// Private stuff.
+
+// internalParent returns the import path of the tree allowed to import p when
+// p is an internal package. The standard library internal packages are
+// ignored, the compiler already rejects them.
+func internalParent(p string) (string, bool) {
+	i := strings.LastIndex(p, "/internal/")
+	if i == -1 && strings.HasSuffix(p, "/internal") {
+		i = len(p) - len("/internal")
+	}
+	if i == -1 {
+		return "", false
+	}
+	return p[:i], true
+}
+
+// rootImportPath returns the import path of the root of the repository, or
+// an empty string if it can't be determined.
+func rootImportPath(change scm.Change) string {
+	for _, f := range change.All().Files() {
+		if f != "go.mod" {
+			continue
+		}
+		if m, err := parseGoMod(change.Content(f)); err == nil && m.module != "" {
+			return m.module
+		}
+	}
+	return change.Package()
+}
+
+// importPathOf returns the import path of the directory dir relative to the
+// root of the repository at import path root.
+func importPathOf(root, dir string) string {
+	if dir == "." {
+		return root
+	}
+	if root == "" {
+		return dir
+	}
+	return root + "/" + dir
+}
@@ -0,0 +1,55 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestInternalParent(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		p        string
+		parent   string
+		internal bool
+	}{
+		{"foo/bar", "", false},
+		{"foo/internal", "foo", true},
+		{"foo/internal/bar", "foo", true},
+		{"foo/internal/bar/internal/baz", "foo/internal/bar", true},
+		{"foo/internals/bar", "", false},
+		{"internal/race", "", false},
+	}
+	for i, line := range data {
+		parent, internal := internalParent(line.p)
+		ut.AssertEqualIndex(t, i, line.parent, parent)
+		ut.AssertEqualIndex(t, i, line.internal, internal)
+	}
+}
+
+func TestBoundaries(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"go.mod":           "module example.com/foo\n",
+		"foo.go":           "package foo\n\nimport _ \"example.com/foo/internal/a\"\n",
+		"internal/a/a.go":  "package a\n\nimport _ \"example.com/foo/internal/b\"\n",
+		"internal/b/b.go":  "package b\n",
+		"scm/scm.go":       "package scm\n\nimport (\n\t_ \"example.com/foo/checks\"\n\t_ \"example.com/foo/internal/b\"\n)\n",
+		"checks/checks.go": "package checks\n\nimport (\n\t_ \"example.com/bar/internal/c\"\n\t_ \"example.com/foo/scm\"\n)\n",
+	}
+	change := setup(t, td, files)
+	expected := "forbidden imports:\n" +
+		"checks/checks.go:4:2: example.com/foo/checks -> example.com/bar/internal/c: use of internal package outside of example.com/bar"
+	ut.AssertEqual(t, errors.New(expected), (&Boundaries{}).Run(change, &Options{}).Err)
+	b := &Boundaries{Layers: []LayerRule{{From: "scm", Deny: []string{"checks", "cmd"}}}}
+	expected += "\nscm/scm.go:4:2: example.com/foo/scm -> example.com/foo/checks: scm must not import checks"
+	ut.AssertEqual(t, errors.New(expected), b.Run(change, &Options{}).Err)
+}
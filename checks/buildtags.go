@@ -0,0 +1,254 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// buildtags validates build constraints.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/build/constraint"
+	"path"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// BuildTags enforces the build constraints of the modified Go files are
+// consistent.
+//
+// The //go:build and legacy // +build lines must agree, and a file with a GOOS
+// or GOARCH file name suffix, e.g. foo_windows.go, must not carry a constraint
+// that contradicts it.
+type BuildTags struct {
+	Limits `yaml:",inline"`
+
+	// RemoveLegacy requires the legacy // +build lines to be removed.
+	RemoveLegacy bool `yaml:"remove_legacy"`
+}
+
+// GetDescription implements Check.
+func (b *BuildTags) GetDescription() string {
+	return "enforces build constraints are consistent"
+}
+
+// GetName implements Check.
+func (b *BuildTags) GetName() string {
+	return "buildtags"
+}
+
+// GetPrerequisites implements Check.
+func (b *BuildTags) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (b *BuildTags) Run(change scm.Change, options *Options) Result {
+	return newResult(b.run(change, options), ParseIssues)
+}
+
+func (b *BuildTags) run(change scm.Change, options *Options) error {
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		if content := change.Content(f); content != nil {
+			for _, issue := range b.lint(f, string(content)) {
+				bad = append(bad, Issue{File: f, Message: issue})
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("inconsistent build constraints:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// lint returns the issues with the build constraints of the file f.
+func (b *BuildTags) lint(f, content string) []string {
+	var out []string
+	var goBuild constraint.Expr
+	var plusBuild []constraint.Expr
+	for _, line := range constraintLines(content) {
+		expr, err := constraint.Parse(line)
+		if err != nil {
+			out = append(out, fmt.Sprintf("invalid constraint \"%s\": %s", line, err))
+			continue
+		}
+		if constraint.IsGoBuild(line) {
+			if goBuild != nil {
+				out = append(out, "multiple //go:build lines")
+			}
+			goBuild = expr
+		} else {
+			plusBuild = append(plusBuild, expr)
+		}
+	}
+	if len(plusBuild) != 0 && b.RemoveLegacy {
+		out = append(out, "legacy // +build lines must be removed")
+	}
+	expr := goBuild
+	if len(plusBuild) != 0 {
+		// Multiple // +build lines are ANDed.
+		legacy := plusBuild[0]
+		for _, e := range plusBuild[1:] {
+			legacy = &constraint.AndExpr{X: legacy, Y: e}
+		}
+		if goBuild != nil && !equivalentExpr(goBuild, legacy) {
+			out = append(out, fmt.Sprintf("//go:build %s and // +build lines disagree", goBuild))
+		}
+		if expr == nil {
+			expr = legacy
+		}
+	}
+	if expr != nil {
+		goos, goarch := fileNameTags(f)
+		if (goos != "" || goarch != "") && !satisfiable(expr, goos, goarch) {
+			out = append(out, fmt.Sprintf("constraint \"%s\" contradicts the file name", expr))
+		}
+	}
+	return out
+}
+
+// Private stuff.
+
+// knownOS and knownArch are the GOOS and GOARCH values recognized in file name
+// suffixes.
+var (
+	knownOS   = []string{"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos", "ios", "js", "linux", "nacl", "netbsd", "openbsd", "plan9", "solaris", "wasip1", "windows", "zos"}
+	knownArch = []string{"386", "amd64", "amd64p32", "arm", "armbe", "arm64", "arm64be", "loong64", "mips", "mipsle", "mips64", "mips64le", "mips64p32", "mips64p32le", "ppc", "ppc64", "ppc64le", "riscv", "riscv64", "s390", "s390x", "sparc", "sparc64", "wasm"}
+	unixOS    = []string{"aix", "android", "darwin", "dragonfly", "freebsd", "hurd", "illumos", "ios", "linux", "netbsd", "openbsd", "solaris"}
+)
+
+// constraintLines returns the build constraint lines in the file header, before
+// the package clause.
+func constraintLines(content string) []string {
+	var out []string
+	inComment := false
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimSpace(line)
+		if inComment {
+			inComment = !strings.Contains(line, "*/")
+			continue
+		}
+		if strings.HasPrefix(line, "/*") {
+			inComment = !strings.Contains(line[2:], "*/")
+			continue
+		}
+		if line != "" && !strings.HasPrefix(line, "//") {
+			break
+		}
+		if constraint.IsGoBuild(line) || constraint.IsPlusBuild(line) {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+// fileNameTags returns the GOOS and GOARCH implied by the file name, as done
+// by the go tool, e.g. "foo_linux_amd64_test.go".
+func fileNameTags(f string) (string, string) {
+	name := strings.TrimSuffix(strings.TrimSuffix(path.Base(f), ".go"), "_test")
+	parts := strings.Split(name, "_")
+	if len(parts) < 2 {
+		return "", ""
+	}
+	last := parts[len(parts)-1]
+	if len(parts) >= 3 && contains(knownOS, parts[len(parts)-2]) && contains(knownArch, last) {
+		return parts[len(parts)-2], last
+	}
+	if contains(knownOS, last) {
+		return last, ""
+	}
+	if contains(knownArch, last) {
+		return "", last
+	}
+	return "", ""
+}
+
+// satisfiable returns true if expr can be true when building for goos and
+// goarch. An empty value means any.
+func satisfiable(expr constraint.Expr, goos, goarch string) bool {
+	var free []string
+	fixed := map[string]bool{}
+	for _, tag := range exprTags(expr) {
+		switch {
+		case goos != "" && contains(knownOS, tag):
+			// android implies linux, illumos implies solaris and ios implies darwin.
+			fixed[tag] = tag == goos || (tag == "linux" && goos == "android") || (tag == "solaris" && goos == "illumos") || (tag == "darwin" && goos == "ios")
+		case goos != "" && tag == "unix":
+			fixed[tag] = contains(unixOS, goos)
+		case goarch != "" && contains(knownArch, tag):
+			fixed[tag] = tag == goarch
+		default:
+			free = append(free, tag)
+		}
+	}
+	if len(free) > 16 {
+		// Too many combinations, give up.
+		return true
+	}
+	for i := 0; i < 1<<uint(len(free)); i++ {
+		ok := expr.Eval(func(tag string) bool {
+			if v, ok := fixed[tag]; ok {
+				return v
+			}
+			for j, t := range free {
+				if t == tag {
+					return i&(1<<uint(j)) != 0
+				}
+			}
+			return false
+		})
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// equivalentExpr returns true if a and b evaluate the same for all tag
+// combinations.
+func equivalentExpr(a, b constraint.Expr) bool {
+	tags := exprTags(&constraint.AndExpr{X: a, Y: b})
+	if len(tags) > 16 {
+		return a.String() == b.String()
+	}
+	for i := 0; i < 1<<uint(len(tags)); i++ {
+		eval := func(tag string) bool {
+			for j, t := range tags {
+				if t == tag {
+					return i&(1<<uint(j)) != 0
+				}
+			}
+			return false
+		}
+		if a.Eval(eval) != b.Eval(eval) {
+			return false
+		}
+	}
+	return true
+}
+
+// exprTags returns the unique tags used in expr.
+func exprTags(expr constraint.Expr) []string {
+	seen := map[string]bool{}
+	var out []string
+	expr.Eval(func(tag string) bool {
+		if !seen[tag] {
+			seen[tag] = true
+			out = append(out, tag)
+		}
+		return false
+	})
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
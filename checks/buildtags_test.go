@@ -0,0 +1,57 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestBuildTagsLint(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		f        string
+		content  string
+		legacy   bool
+		expected []string
+	}{
+		{"foo.go", "package foo\n", true, nil},
+		{"foo.go", "//go:build linux && !386\n// +build linux,!386\n\npackage foo\n", false, nil},
+		{"foo.go", "//go:build linux\n// +build linux\n\npackage foo\n", true, []string{"legacy // +build lines must be removed"}},
+		{"foo.go", "//go:build linux || darwin\n// +build linux\n\npackage foo\n", false, []string{"//go:build linux || darwin and // +build lines disagree"}},
+		{"foo.go", "// +build linux darwin\n// +build amd64\n\npackage foo\n\n//go:build windows\n", false, nil},
+		{"foo.go", "//go:build linux &&\n\npackage foo\n", false, []string{"invalid constraint \"//go:build linux &&\": unexpected end of expression"}},
+		{"foo_windows.go", "//go:build linux\n\npackage foo\n", false, []string{"constraint \"linux\" contradicts the file name"}},
+		{"foo_windows_test.go", "//go:build !windows || cgo\n\npackage foo\n", false, nil},
+		{"foo_android.go", "//go:build linux && unix\n\npackage foo\n", false, nil},
+		{"foo_linux_arm64.go", "/* Header\n*/\n\n//go:build amd64\n\npackage foo\n", false, []string{"constraint \"amd64\" contradicts the file name"}},
+	}
+	for i, line := range data {
+		b := &BuildTags{RemoveLegacy: line.legacy}
+		ut.AssertEqualIndex(t, i, line.expected, b.lint(line.f, line.content))
+	}
+}
+
+func TestFileNameTags(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		f      string
+		goos   string
+		goarch string
+	}{
+		{"foo.go", "", ""},
+		{"linux.go", "", ""},
+		{"a/foo_linux.go", "linux", ""},
+		{"foo_amd64_test.go", "", "amd64"},
+		{"foo_windows_386.go", "windows", "386"},
+		{"foo_bar_arm.go", "", "arm"},
+	}
+	for i, line := range data {
+		goos, goarch := fileNameTags(line.f)
+		ut.AssertEqualIndex(t, i, line.goos, goos)
+		ut.AssertEqualIndex(t, i, line.goarch, goarch)
+	}
+}
@@ -12,13 +12,20 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/golang.org/x/tools/go/analysis/suite/vet"
+	"github.com/maruel/pre-commit-go/checks/internal/analyze"
 	"github.com/maruel/pre-commit-go/internal"
 	"github.com/maruel/pre-commit-go/scm"
 )
@@ -35,8 +42,12 @@ type CheckPrerequisite struct {
 	HelpCommand []string `yaml:"help_command"`
 	// ExpectedExitCode is the exit code expected when HelpCommand is executed.
 	ExpectedExitCode int `yaml:"expected_exit_code"`
-	// URL is the url to fetch as `go get URL`.
+	// URL is the url to fetch as `go get URL`. It is empty when the
+	// prerequisite can't be installed with `go get`, e.g. a binary tool.
 	URL string
+	// InstallHint is printed when the prerequisite is missing and URL is empty,
+	// e.g. "apt-get install shellcheck".
+	InstallHint string `yaml:"install_hint"`
 }
 
 // IsPresent returns true if the prerequisite is present on the system.
@@ -54,14 +65,49 @@ type Check interface {
 	// GetPrerequisites lists all the go packages to be installed before running
 	// this check.
 	GetPrerequisites() []CheckPrerequisite
-	// Run executes the check.
-	Run(change scm.Change, options *Options) error
+	// Run executes the check. Result.Err is nil when the check passes.
+	Run(change scm.Change, options *Options) Result
+}
+
+// Fixer is implemented by the checks that can repair the issues they report.
+type Fixer interface {
+	// Fix modifies the files in the working tree to fix the issues Run would
+	// report and returns the files modified.
+	Fix(change scm.Change, options *Options) ([]string, error)
+}
+
+// Commander is implemented by the checks running external commands.
+type Commander interface {
+	// Commands returns the command lines Run would execute on change, without
+	// executing them. It returns nil if Run would do nothing.
+	Commands(change scm.Change, options *Options) [][]string
+}
+
+// Limiter is implemented by the checks that can be stopped after a timeout,
+// scoped to some paths, enabled conditionally, ordered, weighted or made
+// non-blocking. All the checks embedding Limits implement it.
+type Limiter interface {
+	// GetTimeout returns the maximum duration of the check, 0 if unlimited.
+	GetTimeout() time.Duration
+	// IsEnabled returns false if the check must not run on this machine.
+	IsEnabled() bool
+	// GetWeight returns the number of parallelism slots the check uses.
+	GetWeight() int
+	// GetDependencies returns the names of the checks to run before this one.
+	GetDependencies() []string
+	// GetSeverity returns whether the failure of the check fails the run.
+	GetSeverity() Severity
+	// Scope returns the part of change the check applies to, or nil if no
+	// modified file is in its scope.
+	Scope(change scm.Change) scm.Change
 }
 
 // Native checks.
 
 // Build builds packages without tests via 'go build'.
 type Build struct {
+	Limits `yaml:",inline"`
+
 	BuildAll  bool     `yaml:"build_all"`
 	ExtraArgs []string `yaml:"extra_args"`
 }
@@ -91,31 +137,60 @@ func (b *Build) Unlock() {
 	buildLock.Unlock()
 }
 
+// GetWeight implements Limiter.
+//
+// The packages are built concurrently so it defaults to heavyWeight.
+func (b *Build) GetWeight() int {
+	if b.Weight <= 0 {
+		return heavyWeight()
+	}
+	return b.Weight
+}
+
 // Run implements Check.
-func (b *Build) Run(change scm.Change, options *Options) error {
-	// go build accepts packages, not files.
-	// Cannot build concurrently since it leaves files in the tree.
-	// TODO(maruel): Build in a temporary directory to not leave junk in the tree
-	// with -o. On the other hand, ./... and -o foo are incompatible. But
-	// building would have to be done in an efficient way by looking at which
-	// package builds what, to not result in a O(n²) algorithm.
-	pkgs := change.Indirect().Packages()
-	if len(pkgs) == 0 {
+func (b *Build) Run(change scm.Change, options *Options) Result {
+	return newResult(b.run(change, options), ParseIssues)
+}
+
+func (b *Build) run(change scm.Change, options *Options) error {
+	// go build accepts packages, not files. Each package is built separately,
+	// up to the weight of the check concurrently, so a slow package doesn't
+	// hold the others and the failures are reported per package.
+	return forEachPackage(options, change.Indirect().Packages(), b.GetWeight(), func(pkg string) error {
+		args := b.args(pkg)
+		start := time.Now()
+		out, _, err := capture(options, change.Repo(), args...)
+		options.PackageTimings.Record(b.GetName(), pkg, time.Since(start))
+		if len(out) != 0 {
+			return fmt.Errorf("%s failed: %s", strings.Join(args, " "), out)
+		}
+		if err != nil {
+			return fmt.Errorf("%s failed: %s", strings.Join(args, " "), err.Error())
+		}
 		return nil
+	})
+}
+
+// Commands implements Commander.
+func (b *Build) Commands(change scm.Change, options *Options) [][]string {
+	var out [][]string
+	for _, pkg := range change.Indirect().Packages() {
+		out = append(out, b.args(pkg))
 	}
-	args := append([]string{"go", "build"}, b.ExtraArgs...)
-	out, _, err := capture(change.Repo(), append(args, pkgs...)...)
-	if len(out) != 0 {
-		return fmt.Errorf("%s failed: %s", strings.Join(args, " "), out)
-	}
-	if err != nil {
-		return fmt.Errorf("%s failed: %s", strings.Join(args, " "), err.Error())
-	}
-	return nil
+	return out
+}
+
+// args returns the command to build pkg. The executable of a main package is
+// discarded instead of being written in the tree.
+func (b *Build) args(pkg string) []string {
+	args := append([]string{"go", "build", "-o", os.DevNull}, b.ExtraArgs...)
+	return append(args, pkg)
 }
 
 // Copyright looks for copyright headers in all files.
 type Copyright struct {
+	Limits `yaml:",inline"`
+
 	Header string
 }
 
@@ -135,20 +210,22 @@ func (c *Copyright) GetPrerequisites() []CheckPrerequisite {
 }
 
 // Run implements Check.
-func (c *Copyright) Run(change scm.Change, options *Options) error {
+func (c *Copyright) Run(change scm.Change, options *Options) Result {
+	return newResult(c.run(change, options), ParseIssues)
+}
+
+func (c *Copyright) run(change scm.Change, options *Options) error {
 	var badFiles []string
 	prefix := []byte(c.Header)
 	// This this serially since it's I/O bound and will compete with process
 	// startup of other checks.
-	for _, f := range change.Changed().GoFiles() {
-		if !change.IsIgnored(f) {
-			if content := change.Content(f); content != nil {
-				if !bytes.HasPrefix(content, prefix) {
-					badFiles = append(badFiles, f)
-				}
-			} else {
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		if content := change.Content(f); content != nil {
+			if !bytes.HasPrefix(content, prefix) {
 				badFiles = append(badFiles, f)
 			}
+		} else {
+			badFiles = append(badFiles, f)
 		}
 	}
 	if len(badFiles) != 0 {
@@ -157,8 +234,76 @@ func (c *Copyright) Run(change scm.Change, options *Options) error {
 	return nil
 }
 
-// Gofmt runs gofmt in check mode with code simplification enabled.
+// CopyrightYear enforces the copyright header of the modified files contains
+// the current year.
+//
+// Only the files in the change are examined, so untouched files with an older
+// year are not flagged.
+type CopyrightYear struct {
+	Limits `yaml:",inline"`
+
+	// year overrides the current year, for testing.
+	year int
+}
+
+// GetDescription implements Check.
+func (c *CopyrightYear) GetDescription() string {
+	return "enforces the copyright header of modified .go sources has the current year"
+}
+
+// GetName implements Check.
+func (c *CopyrightYear) GetName() string {
+	return "copyrightyear"
+}
+
+// GetPrerequisites implements Check.
+func (c *CopyrightYear) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (c *CopyrightYear) Run(change scm.Change, options *Options) Result {
+	return newResult(c.run(change, options), ParseIssues)
+}
+
+func (c *CopyrightYear) run(change scm.Change, options *Options) error {
+	year := c.year
+	if year == 0 {
+		year = time.Now().Year()
+	}
+	var badFiles []string
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		// Only look at the header; files without copyright are the concern of
+		// the copyright check.
+		lines := strings.SplitN(string(content), "\n", copyrightLines+1)
+		if len(lines) > copyrightLines {
+			lines = lines[:copyrightLines]
+		}
+		for i, line := range lines {
+			m := reCopyrightYear.FindStringSubmatch(line)
+			if m == nil {
+				continue
+			}
+			if err := checkCopyrightYears(m[1], year); err != nil {
+				badFiles = append(badFiles, fmt.Sprintf("%s:%d: %s", f, i+1, err))
+			}
+			break
+		}
+	}
+	if len(badFiles) != 0 {
+		return fmt.Errorf("files have a stale copyright year:\n  %s", strings.Join(badFiles, "\n  "))
+	}
+	return nil
+}
+
+// Gofmt enforces that the sources are formatted like 'gofmt -s' does, in
+// process.
 type Gofmt struct {
+	Limits `yaml:",inline"`
 }
 
 // GetDescription implements Check.
@@ -177,31 +322,63 @@ func (g *Gofmt) GetPrerequisites() []CheckPrerequisite {
 }
 
 // Run implements Check.
-func (g *Gofmt) Run(change scm.Change, options *Options) error {
-	// gofmt doesn't return non-zero even if some files need to be updated.
-	// gofmt accepts files, not packages but using . makes it recursive.
-	//
-	// TODO(maruel): Do it in process. It'll be much faster as the content of the
-	// modified files is already in memory.
-	out, _, err := capture(change.Repo(), "gofmt", "-l", "-s", ".")
-	// Split the files to ignore as needed.
-	files := []string{}
-	for _, line := range strings.Split(string(out), "\n") {
-		if len(line) != 0 && !change.IsIgnored(line) {
-			files = append(files, line)
+func (g *Gofmt) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), parseFormatIssues)
+}
+
+func (g *Gofmt) run(change scm.Change, options *Options) error {
+	bad, errs := formatFiles(change.Repo().Root(), gofmtTargets(change, options))
+	if len(errs) != 0 {
+		return fmt.Errorf("failed to parse:\n%s", strings.Join(errs, "\n"))
+	}
+	if len(bad) == 0 {
+		return nil
+	}
+	files := make([]string, 0, len(bad))
+	diffs := ""
+	for _, f := range bad {
+		files = append(files, f.name)
+		diffs += unifiedDiff(f.name, f.src, f.formatted)
+	}
+	return fmt.Errorf("these files are improperly formmatted, please run: gofmt -w -s .\n%s\n\n%s", strings.Join(files, "\n"), strings.TrimRight(diffs, "\n"))
+}
+
+// Fix implements Fixer.
+func (g *Gofmt) Fix(change scm.Change, options *Options) ([]string, error) {
+	bad, errs := formatFiles(change.Repo().Root(), gofmtTargets(change, options))
+	if len(errs) != 0 {
+		return nil, fmt.Errorf("failed to parse:\n%s", strings.Join(errs, "\n"))
+	}
+	var files []string
+	for _, f := range bad {
+		if err := ioutil.WriteFile(filepath.Join(change.Repo().Root(), f.name), f.formatted, 0666); err != nil {
+			return files, err
 		}
+		files = append(files, f.name)
 	}
-	if len(files) != 0 {
-		return fmt.Errorf("these files are improperly formmatted, please run: gofmt -w -s .\n%s", strings.Join(files, "\n"))
+	return files, nil
+}
+
+// gofmtTargets returns the Go files to check that are not ignored. Like
+// 'gofmt .', all the files in the checkout are checked, including the ones not
+// tracked, unless only the changed ones are.
+func gofmtTargets(change scm.Change, options *Options) []string {
+	if options.OnlyChanged {
+		return change.Filtered().Changed().GoFiles()
 	}
-	if err != nil {
-		return fmt.Errorf("gofmt -l -s . failed: %s", err)
+	var out []string
+	for _, f := range change.Tree() {
+		if strings.HasSuffix(f, ".go") && !strings.HasPrefix(filepath.Base(f), ".") {
+			out = append(out, f)
+		}
 	}
-	return nil
+	return out
 }
 
 // Test runs all tests via go test.
 type Test struct {
+	Limits `yaml:",inline"`
+
 	ExtraArgs []string `yaml:"extra_args"`
 }
 
@@ -220,45 +397,71 @@ func (t *Test) GetPrerequisites() []CheckPrerequisite {
 	return nil
 }
 
+// GetWeight implements Limiter.
+//
+// The packages are tested concurrently so it defaults to heavyWeight.
+func (t *Test) GetWeight() int {
+	if t.Weight <= 0 {
+		return heavyWeight()
+	}
+	return t.Weight
+}
+
 // Run implements Check.
-func (t *Test) Run(change scm.Change, options *Options) error {
-	// go test accepts packages, not files.
-	var wg sync.WaitGroup
-	testPkgs := change.Indirect().TestPackages()
-	errs := make(chan error, len(testPkgs))
-	for _, tp := range testPkgs {
-		wg.Add(1)
-		go func(testPkg string) {
-			defer wg.Done()
-			args := append(
-				[]string{
-					"go", "test",
-					"-timeout", fmt.Sprintf("%ds", options.MaxDuration),
-				},
-				t.ExtraArgs...)
-			args = append(args, testPkg)
-			start := time.Now()
-			out, exitCode, _ := capture(change.Repo(), args...)
-			duration := time.Since(start)
-			if duration > time.Second {
-				log.Printf("%s was slow: %s", args, round(duration, time.Millisecond))
-			}
-			if exitCode != 0 {
-				errs <- fmt.Errorf("%s failed:\n%s", strings.Join(args, " "), processStackTrace(out))
+func (t *Test) Run(change scm.Change, options *Options) Result {
+	return newResult(t.run(change, options), parseGoTestIssues)
+}
+
+func (t *Test) run(change scm.Change, options *Options) error {
+	// go test accepts packages, not files. The packages are tested up to the
+	// weight of the check concurrently and the failures of all of them are
+	// reported.
+	testPkgs := options.Shard.Select(change.Indirect().TestPackages())
+	return forEachPackage(options, testPkgs, t.GetWeight(), func(testPkg string) error {
+		if options.testRuns.shared(testPkg) {
+			// Use the run of the coverage check, it is a superset.
+			run := options.testRuns.run(testPkg, options, change.Repo())
+			options.PackageTimings.Record(t.GetName(), testPkg, run.duration)
+			if run.exitCode != 0 {
+				return fmt.Errorf("%s failed:\n%s", strings.Join(run.args, " "), processStackTrace(run.out))
 			}
-		}(tp)
-	}
-	wg.Wait()
-	select {
-	case err := <-errs:
-		return err
-	default:
+			return nil
+		}
+		args := append(
+			[]string{
+				"go", "test",
+				"-timeout", fmt.Sprintf("%ds", options.MaxDuration),
+			},
+			t.ExtraArgs...)
+		args = append(args, testPkg)
+		start := time.Now()
+		out, exitCode, _ := capture(options, change.Repo(), args...)
+		duration := time.Since(start)
+		options.PackageTimings.Record(t.GetName(), testPkg, duration)
+		if duration > time.Second {
+			log.Printf("%s was slow: %s", args, round(duration, time.Millisecond))
+		}
+		if exitCode != 0 {
+			return fmt.Errorf("%s failed:\n%s", strings.Join(args, " "), processStackTrace(out))
+		}
+		return nil
+	})
+}
+
+// Commands implements Commander.
+func (t *Test) Commands(change scm.Change, options *Options) [][]string {
+	var out [][]string
+	for _, testPkg := range options.Shard.Select(change.Indirect().TestPackages()) {
+		args := append([]string{"go", "test", "-timeout", fmt.Sprintf("%ds", options.MaxDuration)}, t.ExtraArgs...)
+		out = append(out, append(args, testPkg))
 	}
-	return nil
+	return out
 }
 
 // Errcheck runs errcheck on packages.
 type Errcheck struct {
+	Limits `yaml:",inline"`
+
 	Ignores string
 }
 
@@ -275,15 +478,31 @@ func (e *Errcheck) GetName() string {
 // GetPrerequisites implements Check.
 func (e *Errcheck) GetPrerequisites() []CheckPrerequisite {
 	return []CheckPrerequisite{
-		{[]string{"errcheck", "-h"}, 2, "github.com/kisielk/errcheck"},
+		{[]string{"errcheck", "-h"}, 2, "github.com/kisielk/errcheck", ""},
 	}
 }
 
 // Run implements Check.
-func (e *Errcheck) Run(change scm.Change, options *Options) error {
+func (e *Errcheck) Run(change scm.Change, options *Options) Result {
+	return newResult(e.run(change, options), ParseIssues)
+}
+
+func (e *Errcheck) run(change scm.Change, options *Options) error {
 	// errcheck accepts packages, not files.
 	args := []string{"errcheck", "-ignore", e.Ignores}
-	out, _, err := capture(change.Repo(), append(args, change.Changed().Packages()...)...)
+	out, _, err := capture(options, change.Repo(), append(args, change.Changed().Packages()...)...)
+	if len(out) != 0 && (options.OwnedOnly || options.Baseline != nil) {
+		var owned []string
+		for _, line := range strings.Split(out, "\n") {
+			if line == "" {
+				continue
+			}
+			if f, l := parseLintLine(change.Repo().Root(), line); options.isOwned(change, f, l) && !options.isBaselined(e, f, lintMessage(line)) {
+				owned = append(owned, line)
+			}
+		}
+		out = strings.Join(owned, "\n")
+	}
 	if len(out) != 0 {
 		// TODO(maruel): Process output so paths are relative from
 		// change.Repo().Root().
@@ -297,8 +516,14 @@ func (e *Errcheck) Run(change scm.Change, options *Options) error {
 	return nil
 }
 
+// Commands implements Commander.
+func (e *Errcheck) Commands(change scm.Change, options *Options) [][]string {
+	return [][]string{append([]string{"errcheck", "-ignore", e.Ignores}, change.Changed().Packages()...)}
+}
+
 // Goimports runs goimports in check mode.
 type Goimports struct {
+	Limits `yaml:",inline"`
 }
 
 // GetDescription implements Check.
@@ -314,17 +539,22 @@ func (g *Goimports) GetName() string {
 // GetPrerequisites implements Check.
 func (g *Goimports) GetPrerequisites() []CheckPrerequisite {
 	return []CheckPrerequisite{
-		{[]string{"goimports", "-h"}, 2, "golang.org/x/tools/cmd/goimports"},
+		{[]string{"goimports", "-h"}, 2, "golang.org/x/tools/cmd/goimports", ""},
 	}
 }
 
 // Run implements Check.
-func (g *Goimports) Run(change scm.Change, options *Options) error {
+func (g *Goimports) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), parseFormatIssues)
+}
+
+func (g *Goimports) run(change scm.Change, options *Options) error {
 	// goimports accepts files, not packages.
 	// goimports doesn't return non-zero even if some files need to be updated.
-	out, _, err := capture(change.Repo(), append([]string{"goimports", "-l"}, change.Changed().GoFiles()...)...)
+	out, _, err := capture(options, change.Repo(), append([]string{"goimports", "-l"}, change.Changed().GoFiles()...)...)
 	if len(out) != 0 {
-		return fmt.Errorf("these files are improperly formmatted, please run: goimports -w <files>\n%s", out)
+		files := strings.Split(strings.TrimSpace(out), "\n")
+		return fmt.Errorf("these files are improperly formmatted, please run: goimports -w <files>\n%s%s", strings.Join(files, "\n"), formatDiff(change, options, []string{"goimports", "-d"}, files))
 	}
 	if err != nil {
 		return fmt.Errorf("goimports -w . failed: %s", err)
@@ -332,8 +562,237 @@ func (g *Goimports) Run(change scm.Change, options *Options) error {
 	return nil
 }
 
+// Fix implements Fixer.
+func (g *Goimports) Fix(change scm.Change, options *Options) ([]string, error) {
+	files := change.Changed().GoFiles()
+	if len(files) == 0 {
+		return nil, nil
+	}
+	return fixFiles(change, options, append([]string{"goimports", "-l"}, files...), []string{"goimports", "-w"})
+}
+
+// Commands implements Commander.
+func (g *Goimports) Commands(change scm.Change, options *Options) [][]string {
+	return [][]string{append([]string{"goimports", "-l"}, change.Changed().GoFiles()...)}
+}
+
+// Asmfmt runs asmfmt in check mode on assembly files.
+type Asmfmt struct {
+	Limits `yaml:",inline"`
+}
+
+// GetDescription implements Check.
+func (a *Asmfmt) GetDescription() string {
+	return "enforces all .s sources are formatted with 'asmfmt'"
+}
+
+// GetName implements Check.
+func (a *Asmfmt) GetName() string {
+	return "asmfmt"
+}
+
+// GetPrerequisites implements Check.
+func (a *Asmfmt) GetPrerequisites() []CheckPrerequisite {
+	return []CheckPrerequisite{
+		{[]string{"asmfmt", "-h"}, 2, "github.com/klauspost/asmfmt/cmd/asmfmt", ""},
+	}
+}
+
+// Run implements Check.
+func (a *Asmfmt) Run(change scm.Change, options *Options) Result {
+	return newResult(a.run(change, options), ParseIssues)
+}
+
+func (a *Asmfmt) run(change scm.Change, options *Options) error {
+	var files []string
+	for _, f := range change.Filtered().Changed().Files() {
+		if strings.HasSuffix(f, ".s") {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	// asmfmt accepts files, like gofmt.
+	out, _, err := capture(options, change.Repo(), append([]string{"asmfmt", "-l"}, files...)...)
+	if len(out) != 0 {
+		return fmt.Errorf("these files are improperly formmatted, please run: asmfmt -w <files>\n%s", out)
+	}
+	if err != nil {
+		return fmt.Errorf("asmfmt -l failed: %s", err)
+	}
+	return nil
+}
+
+// Fix implements Fixer.
+func (a *Asmfmt) Fix(change scm.Change, options *Options) ([]string, error) {
+	var files []string
+	for _, f := range change.Changed().Files() {
+		if strings.HasSuffix(f, ".s") {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+	return fixFiles(change, options, append([]string{"asmfmt", "-l"}, files...), []string{"asmfmt", "-w"})
+}
+
+// Commands implements Commander.
+func (a *Asmfmt) Commands(change scm.Change, options *Options) [][]string {
+	var files []string
+	for _, f := range change.Filtered().Changed().Files() {
+		if strings.HasSuffix(f, ".s") {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	return [][]string{append([]string{"asmfmt", "-l"}, files...)}
+}
+
+// Shellcheck runs shellcheck on shell scripts.
+type Shellcheck struct {
+	Limits `yaml:",inline"`
+
+	// ExtraArgs are passed to shellcheck, e.g. []string{"-e", "SC2034"}.
+	ExtraArgs []string `yaml:"extra_args"`
+}
+
+// GetDescription implements Check.
+func (s *Shellcheck) GetDescription() string {
+	return "enforces all .sh sources pass 'shellcheck'"
+}
+
+// GetName implements Check.
+func (s *Shellcheck) GetName() string {
+	return "shellcheck"
+}
+
+// GetPrerequisites implements Check.
+func (s *Shellcheck) GetPrerequisites() []CheckPrerequisite {
+	return []CheckPrerequisite{
+		{[]string{"shellcheck", "--version"}, 0, "", "see https://github.com/koalaman/shellcheck#installing, e.g. apt-get install shellcheck or brew install shellcheck"},
+	}
+}
+
+// Run implements Check.
+func (s *Shellcheck) Run(change scm.Change, options *Options) Result {
+	return newResult(s.run(change, options), ParseIssues)
+}
+
+func (s *Shellcheck) run(change scm.Change, options *Options) error {
+	var files []string
+	for _, f := range change.Filtered().Changed().Files() {
+		if strings.HasSuffix(f, ".sh") {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	args := append(append([]string{"shellcheck"}, s.ExtraArgs...), files...)
+	out, exitCode, err := capture(options, change.Repo(), args...)
+	if exitCode != 0 {
+		return fmt.Errorf("shellcheck failed:\n%s", out)
+	}
+	if err != nil {
+		return fmt.Errorf("shellcheck failed: %s", err)
+	}
+	return nil
+}
+
+// Commands implements Commander.
+func (s *Shellcheck) Commands(change scm.Change, options *Options) [][]string {
+	var files []string
+	for _, f := range change.Filtered().Changed().Files() {
+		if strings.HasSuffix(f, ".sh") {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	return [][]string{append(append([]string{"shellcheck"}, s.ExtraArgs...), files...)}
+}
+
+// Hadolint runs hadolint on Dockerfiles.
+type Hadolint struct {
+	Limits `yaml:",inline"`
+
+	// Ignore is the list of rules to ignore, e.g. []string{"DL3008"}.
+	Ignore []string `yaml:"ignore"`
+}
+
+// GetDescription implements Check.
+func (h *Hadolint) GetDescription() string {
+	return "enforces all Dockerfiles pass 'hadolint'"
+}
+
+// GetName implements Check.
+func (h *Hadolint) GetName() string {
+	return "hadolint"
+}
+
+// GetPrerequisites implements Check.
+func (h *Hadolint) GetPrerequisites() []CheckPrerequisite {
+	return []CheckPrerequisite{
+		{[]string{"hadolint", "--version"}, 0, "", "see https://github.com/hadolint/hadolint#install, e.g. brew install hadolint"},
+	}
+}
+
+// Run implements Check.
+func (h *Hadolint) Run(change scm.Change, options *Options) Result {
+	return newResult(h.run(change, options), ParseIssues)
+}
+
+func (h *Hadolint) run(change scm.Change, options *Options) error {
+	var files []string
+	for _, f := range change.Filtered().Changed().Files() {
+		if isDockerfile(f) {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	args := []string{"hadolint"}
+	for _, i := range h.Ignore {
+		args = append(args, "--ignore", i)
+	}
+	out, exitCode, err := capture(options, change.Repo(), append(args, files...)...)
+	if exitCode != 0 {
+		return fmt.Errorf("hadolint failed:\n%s", out)
+	}
+	if err != nil {
+		return fmt.Errorf("hadolint failed: %s", err)
+	}
+	return nil
+}
+
+// Commands implements Commander.
+func (h *Hadolint) Commands(change scm.Change, options *Options) [][]string {
+	var files []string
+	for _, f := range change.Filtered().Changed().Files() {
+		if isDockerfile(f) {
+			files = append(files, f)
+		}
+	}
+	if len(files) == 0 {
+		return nil
+	}
+	args := []string{"hadolint"}
+	for _, i := range h.Ignore {
+		args = append(args, "--ignore", i)
+	}
+	return [][]string{append(args, files...)}
+}
+
 // Golint runs golint.
 type Golint struct {
+	Limits `yaml:",inline"`
+
 	Blacklist []string
 }
 
@@ -350,36 +809,40 @@ func (g *Golint) GetName() string {
 // GetPrerequisites implements Check.
 func (g *Golint) GetPrerequisites() []CheckPrerequisite {
 	return []CheckPrerequisite{
-		{[]string{"golint", "-h"}, 2, "github.com/golang/lint/golint"},
+		{[]string{"golint", "-h"}, 2, "github.com/golang/lint/golint", ""},
 	}
 }
 
 // Run implements Check.
-func (g *Golint) Run(change scm.Change, options *Options) error {
+func (g *Golint) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), ParseIssues)
+}
+
+func (g *Golint) run(change scm.Change, options *Options) error {
 	// - accepts packages, not files.
 	// - doesn't return non-zero ever.
 	// - doesn't like multiple packages per call.
 	// - "." is not recursive.
-	pkgs := change.Changed().Packages()
+	pkgs := change.Filtered().Changed().Packages()
 	resultsC := make(chan []string, len(pkgs))
 	files := map[string]bool{}
-	for _, f := range change.Changed().GoFiles() {
+	for _, f := range change.Filtered().Changed().GoFiles() {
 		files[f] = true
 	}
 	for _, pkg := range pkgs {
 		go func(p string) {
 			r := []string{}
-			out, _, _ := capture(change.Repo(), "golint", p)
+			out, _, _ := capture(options, change.Repo(), "golint", p)
 			for _, line := range strings.Split(string(out), "\n") {
 				if len(line) == 0 {
 					continue
 				}
 				// TODO(maruel): Will fail with files with ':' in their name.
 				items := strings.SplitN(line, ":", 2)
-				if change.IsIgnored(items[0]) {
+				if _, ok := files[items[0]]; !ok {
 					continue
 				}
-				if _, ok := files[items[0]]; !ok {
+				if f, l := parseLintLine(change.Repo().Root(), line); !options.isOwned(change, f, l) {
 					continue
 				}
 				for _, b := range g.Blacklist {
@@ -387,6 +850,9 @@ func (g *Golint) Run(change scm.Change, options *Options) error {
 						goto skip
 					}
 				}
+				if options.isBaselined(g, items[0], lintMessage(line)) {
+					continue
+				}
 				r = append(r, line)
 			skip:
 			}
@@ -405,14 +871,25 @@ func (g *Golint) Run(change scm.Change, options *Options) error {
 	return nil
 }
 
-// Govet runs "go tool vet".
+// Commands implements Commander.
+func (g *Golint) Commands(change scm.Change, options *Options) [][]string {
+	var out [][]string
+	for _, pkg := range change.Filtered().Changed().Packages() {
+		out = append(out, []string{"golint", pkg})
+	}
+	return out
+}
+
+// Govet runs the analyzers of go vet in process on the packages loaded once.
 type Govet struct {
+	Limits `yaml:",inline"`
+
 	Blacklist []string
 }
 
 // GetDescription implements Check.
 func (g *Govet) GetDescription() string {
-	return "enforces all .go sources passes go tool vet"
+	return "enforces all .go sources passes go vet"
 }
 
 // GetName implements Check.
@@ -422,56 +899,101 @@ func (g *Govet) GetName() string {
 
 // GetPrerequisites implements Check.
 func (g *Govet) GetPrerequisites() []CheckPrerequisite {
-	return []CheckPrerequisite{
-		{[]string{"go", "tool", "vet", "-h"}, 1, "golang.org/x/tools/cmd/vet"},
-	}
+	return nil
 }
 
 // Run implements Check.
-func (g *Govet) Run(change scm.Change, options *Options) error {
-	// - accepts packages, not files.
-	// - returns non-zero on report.
-	// - accepts multiple packages per call.
-	// - "." is recursive.
-	// Ignore the return code since we ignore many errors.
-	out, _, _ := capture(change.Repo(), "go", "tool", "vet", "-all", ".")
+func (g *Govet) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), ParseIssues)
+}
+
+func (g *Govet) run(change scm.Change, options *Options) error {
+	// The output is filtered to the modified files anyway.
+	targets := govetTargets(change, options)
+	if len(targets) == 0 {
+		return nil
+	}
+	// go list builds the export data of the dependencies, then all the
+	// analyzers run on each package parsed and type checked once.
+	out, _, err := capture(options, change.Repo(), analyze.ListArgs(targets)...)
+	if err != nil {
+		return fmt.Errorf("go list failed: %s", err)
+	}
+	diags, err := analyze.Run([]byte(out), vet.Suite)
+	if err != nil {
+		return err
+	}
+	root := change.Repo().Root()
 	result := []string{}
 	files := map[string]bool{}
-	for _, f := range change.Changed().GoFiles() {
+	for _, f := range change.Filtered().Changed().GoFiles() {
 		files[f] = true
 	}
-	for _, line := range strings.Split(string(out), "\n") {
-		if len(line) == 0 {
+	for _, d := range diags {
+		rel, err := filepath.Rel(root, d.Position.Filename)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			// The package may be listed through a symlink, e.g. in GOPATH.
+			if p, err2 := filepath.EvalSymlinks(d.Position.Filename); err2 == nil {
+				rel, err = filepath.Rel(root, p)
+			}
+		}
+		if err != nil {
 			continue
 		}
-		// TODO(maruel): Will fail with files with ':' in their name.
-		items := strings.SplitN(line, ":", 2)
-		if change.IsIgnored(items[0]) {
+		// A package that doesn't compile fails the check even if the error is in
+		// a file not modified, like 'go vet' does.
+		if _, ok := files[rel]; !ok && d.Analyzer != analyze.TypeCheck {
 			continue
 		}
-		if _, ok := files[items[0]]; !ok {
+		if !options.isOwned(change, filepath.ToSlash(rel), d.Position.Line) {
 			continue
 		}
+		line := fmt.Sprintf("%s:%d:%d: %s", rel, d.Position.Line, d.Position.Column, d.Message)
+		if d.Position.Line == 0 {
+			line = fmt.Sprintf("%s: %s", rel, d.Message)
+		}
 		for _, b := range g.Blacklist {
 			if strings.Contains(line, b) {
 				goto skip
 			}
 		}
+		if options.isBaselined(g, rel, lintMessage(line)) {
+			continue
+		}
 		result = append(result, line)
 	skip:
 	}
 	if len(result) != 0 {
-		return errors.New("go tool vet failed:\n" + strings.Join(result, "\n"))
+		return errors.New("go vet failed:\n" + strings.Join(result, "\n"))
 	}
 	return nil
 }
 
+// Commands implements Commander.
+func (g *Govet) Commands(change scm.Change, options *Options) [][]string {
+	targets := govetTargets(change, options)
+	if len(targets) == 0 {
+		return nil
+	}
+	return [][]string{analyze.ListArgs(targets)}
+}
+
+// govetTargets returns the packages to analyze.
+func govetTargets(change scm.Change, options *Options) []string {
+	if options.OnlyChanged {
+		return change.Filtered().Changed().Packages()
+	}
+	return []string{"./..."}
+}
+
 // Extensibility.
 
 // Custom represents a user configured check running an external program.
 //
 // It can be used multiple times to run multiple external checks.
 type Custom struct {
+	Limits `yaml:",inline"`
+
 	// DisplayName is check's display name, required.
 	DisplayName string `yaml:"display_name"`
 	// Description is check's description, optional.
@@ -484,6 +1006,10 @@ type Custom struct {
 	// Prerequisites are check's prerequisite packages to install first before
 	// running the check, optional.
 	Prerequisites []CheckPrerequisite `yaml:"prerequisites"`
+	// PassFiles specifies if the modified files are appended to Command. When
+	// true and no file is modified, the check is skipped. This is mostly useful
+	// for checks defined in a language.
+	PassFiles bool `yaml:"pass_files"`
 }
 
 // GetDescription implements Check.
@@ -505,30 +1031,77 @@ func (c *Custom) GetPrerequisites() []CheckPrerequisite {
 }
 
 // Run implements Check.
-func (c *Custom) Run(change scm.Change, options *Options) error {
+func (c *Custom) Run(change scm.Change, options *Options) Result {
+	return newResult(c.run(change, options), ParseIssues)
+}
+
+func (c *Custom) run(change scm.Change, options *Options) error {
 	// TODO(maruel): Make what is passed to the command configurable, e.g. one of:
 	// (Changed, Indirect, All) x (GoFiles, Packages, TestPackages)
-	out, exitCode, err := capture(change.Repo(), c.Command...)
+	args := c.Command
+	if c.PassFiles {
+		files := change.Changed().Files()
+		if len(files) == 0 {
+			return nil
+		}
+		args = append(append([]string{}, c.Command...), files...)
+	}
+	out, exitCode, err := capture(options, change.Repo(), args...)
 	if exitCode != 0 && c.CheckExitCode {
 		return fmt.Errorf("\"%s\" failed with code %d:\n%s", strings.Join(c.Command, " "), exitCode, out)
 	}
 	return err
 }
 
+// Commands implements Commander.
+func (c *Custom) Commands(change scm.Change, options *Options) [][]string {
+	if !c.PassFiles {
+		return [][]string{c.Command}
+	}
+	files := change.Changed().Files()
+	if len(files) == 0 {
+		return nil
+	}
+	return [][]string{append(append([]string{}, c.Command...), files...)}
+}
+
 // Rest.
 
 // KnownChecks is the map of all known checks per check name.
 var KnownChecks = map[string]func() Check{
-	(&Build{}).GetName():     func() Check { return &Build{} },
-	(&Copyright{}).GetName(): func() Check { return &Copyright{} },
-	(&Coverage{}).GetName():  func() Check { return &Coverage{} },
-	(&Custom{}).GetName():    func() Check { return &Custom{} },
-	(&Errcheck{}).GetName():  func() Check { return &Errcheck{} },
-	(&Gofmt{}).GetName():     func() Check { return &Gofmt{} },
-	(&Goimports{}).GetName(): func() Check { return &Goimports{} },
-	(&Golint{}).GetName():    func() Check { return &Golint{} },
-	(&Govet{}).GetName():     func() Check { return &Govet{} },
-	(&Test{}).GetName():      func() Check { return &Test{} },
+	(&ASTRule{}).GetName():       func() Check { return &ASTRule{} },
+	(&Asmfmt{}).GetName():        func() Check { return &Asmfmt{} },
+	(&Boundaries{}).GetName():    func() Check { return &Boundaries{} },
+	(&Build{}).GetName():         func() Check { return &Build{} },
+	(&BuildTags{}).GetName():     func() Check { return &BuildTags{} },
+	(&Clock{}).GetName():         func() Check { return &Clock{} },
+	(&CommitMessage{}).GetName(): func() Check { return &CommitMessage{} },
+	(&ConfigLint{}).GetName():    func() Check { return &ConfigLint{} },
+	(&Copyright{}).GetName():     func() Check { return &Copyright{} },
+	(&CopyrightYear{}).GetName(): func() Check { return &CopyrightYear{} },
+	(&Coverage{}).GetName():      func() Check { return &Coverage{} },
+	(&Custom{}).GetName():        func() Check { return &Custom{} },
+	(&Embed{}).GetName():         func() Check { return &Embed{} },
+	(&Errcheck{}).GetName():      func() Check { return &Errcheck{} },
+	(&Generated{}).GetName():     func() Check { return &Generated{} },
+	(&GoDirective{}).GetName():   func() Check { return &GoDirective{} },
+	(&GoSum{}).GetName():         func() Check { return &GoSum{} },
+	(&Gofmt{}).GetName():         func() Check { return &Gofmt{} },
+	(&Goimports{}).GetName():     func() Check { return &Goimports{} },
+	(&GolangciLint{}).GetName():  func() Check { return &GolangciLint{} },
+	(&Golint{}).GetName():        func() Check { return &Golint{} },
+	(&Govet{}).GetName():         func() Check { return &Govet{} },
+	(&Hadolint{}).GetName():      func() Check { return &Hadolint{} },
+	(&Length{}).GetName():        func() Check { return &Length{} },
+	(&Markdown{}).GetName():      func() Check { return &Markdown{} },
+	(&ModReplace{}).GetName():    func() Check { return &ModReplace{} },
+	(&Naming{}).GetName():        func() Check { return &Naming{} },
+	(&Shellcheck{}).GetName():    func() Check { return &Shellcheck{} },
+	(&Spelling{}).GetName():      func() Check { return &Spelling{} },
+	(&SQLVet{}).GetName():        func() Check { return &SQLVet{} },
+	(&StaleBranch{}).GetName():   func() Check { return &StaleBranch{} },
+	(&Test{}).GetName():          func() Check { return &Test{} },
+	(&TestHygiene{}).GetName():   func() Check { return &TestHygiene{} },
 }
 
 // Private stuff.
@@ -536,6 +1109,46 @@ var KnownChecks = map[string]func() Check{
 // See build.Run() for information.
 var buildLock sync.Mutex
 
+// isDockerfile returns true if f is named like a Dockerfile, e.g.
+// "Dockerfile", "Dockerfile.prod" or "prod.dockerfile".
+func isDockerfile(f string) bool {
+	base := path.Base(strings.Replace(f, "\\", "/", -1))
+	return base == "Dockerfile" || strings.HasPrefix(base, "Dockerfile.") || strings.HasSuffix(strings.ToLower(base), ".dockerfile")
+}
+
+// copyrightLines is the number of lines at the top of a file where the
+// copyright header is searched for.
+const copyrightLines = 20
+
+// reCopyrightYear matches "Copyright 2015", "Copyright (c) 2012-2015" or
+// "Copyright 2012, 2014, 2015".
+var reCopyrightYear = regexp.MustCompile(`(?i)copyright\s+(?:\(c\)\s*|\x{00a9}\s*)?(\d{4}(?:\s*[-,]\s*\d{4})*)`)
+
+// checkCopyrightYears verifies that years, as matched by reCopyrightYear, ends
+// with year and that ranges are valid.
+func checkCopyrightYears(years string, year int) error {
+	last := 0
+	for _, r := range strings.Split(years, ",") {
+		bounds := strings.Split(r, "-")
+		if len(bounds) > 2 {
+			return fmt.Errorf("invalid copyright years \"%s\"", years)
+		}
+		start, _ := strconv.Atoi(strings.TrimSpace(bounds[0]))
+		end := start
+		if len(bounds) == 2 {
+			end, _ = strconv.Atoi(strings.TrimSpace(bounds[1]))
+		}
+		if start > end || start < last {
+			return fmt.Errorf("invalid copyright years \"%s\"", years)
+		}
+		last = end
+	}
+	if last != year {
+		return fmt.Errorf("copyright \"%s\" doesn't end with %d", years, year)
+	}
+	return nil
+}
+
 // cwd provides a valid path to CheckPrerequisite.IsPresent().
 var cwd string
 
@@ -5,9 +5,11 @@
 package checks
 
 import (
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"sync"
 	"testing"
@@ -31,17 +33,16 @@ func TestChecksSuccess(t *testing.T) {
 	if testing.Short() {
 		t.SkipNow()
 	}
-	td, err := ioutil.TempDir("", "pre-commit-go")
-	ut.AssertEqual(t, nil, err)
-	defer func() {
-		if err := internal.RemoveAll(td); err != nil {
-			t.Fail()
-		}
-	}()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
 	change := setup(t, td, goodFiles)
+	dict := filepath.Join(td, "words")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(dict, []byte("foo\nreturns\n"), 0600))
 	for _, name := range getKnownChecks() {
 		c := KnownChecks[name]()
 		switch name {
+		case "astrule":
+			c.(*ASTRule).Rules = []ASTPattern{{Pattern: "errors.New($x)", Message: "no errors"}}
 		case "custom":
 			c = &Custom{
 				Description:   "foo",
@@ -64,12 +65,32 @@ func TestChecksSuccess(t *testing.T) {
 			cov.Global.MaxCoverage = 100
 			cov.PerDirDefault.MinCoverage = 100
 			cov.PerDirDefault.MaxCoverage = 100
+		case "length":
+			c.(*Length).MaxLineLength = 80
+			c.(*Length).MaxFunctionLength = 10
+			c.(*Length).MaxFileLength = 100
+		case "naming":
+			c.(*Naming).LowercaseFiles = true
+			c.(*Naming).TestHelpers = true
+			c.(*Naming).PackageName = true
+			c.(*Naming).Banned = []string{"util", "common"}
+		case "golangci-lint":
+			if !c.GetPrerequisites()[0].IsPresent() {
+				// It must be installed manually.
+				continue
+			}
+		case "spelling":
+			c.(*Spelling).Dictionary = dict
+		case "testhygiene":
+			c.(*TestHygiene).RequireTests = true
+			c.(*TestHygiene).Parallel = "forbidden"
+			c.(*TestHygiene).SkipReason = true
 		}
 		if l, ok := c.(sync.Locker); ok {
 			l.Lock()
 			l.Unlock()
 		}
-		if err := c.Run(change, &Options{MaxDuration: 1}); err != nil {
+		if err := c.Run(change, &Options{MaxDuration: 1}).Err; err != nil {
 			t.Errorf("%s failed: %s", c.GetName(), err)
 		}
 	}
@@ -81,17 +102,16 @@ func TestChecksFailure(t *testing.T) {
 	if testing.Short() {
 		t.SkipNow()
 	}
-	td, err := ioutil.TempDir("", "pre-commit-go")
-	ut.AssertEqual(t, nil, err)
-	defer func() {
-		if err := internal.RemoveAll(td); err != nil {
-			t.Fail()
-		}
-	}()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
 	change := setup(t, td, badFiles)
+	msgFile := filepath.Join(td, "COMMIT_EDITMSG")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(msgFile, []byte("Bad commit message.\n"), 0600))
 	for _, name := range getKnownChecks() {
 		c := KnownChecks[name]()
 		switch name {
+		case "astrule":
+			c.(*ASTRule).Rules = []ASTPattern{{Pattern: "errors.New($x)", Message: "no errors"}}
 		case "custom":
 			c = &Custom{
 				Description:   "foo",
@@ -108,14 +128,40 @@ func TestChecksFailure(t *testing.T) {
 		case "copyright":
 			cop := c.(*Copyright)
 			cop.Header = "// Expected header"
+		case "clock":
+			c.(*Clock).Packages = []string{"*"}
+		case "commitmsg":
+			c.(*CommitMessage).MaxSubjectLength = 1
+		case "copyrightyear":
+			c.(*CopyrightYear).year = 2015
 		case "coverage":
 			cov := c.(*Coverage)
 			cov.Global.MinCoverage = 100
 			cov.Global.MaxCoverage = 100
 			cov.PerDirDefault.MinCoverage = 100
 			cov.PerDirDefault.MaxCoverage = 100
+		case "length":
+			c.(*Length).MaxLineLength = 10
+		case "markdown":
+			c.(*Markdown).TrailingSpaces = true
+		case "naming":
+			c.(*Naming).Banned = []string{"foo"}
+		case "generated":
+			c.(*Generated).Generators = []Generator{{Name: "foo", Command: []string{"go", "invalid"}, Outputs: []string{"*.pb.go"}}}
+		case "godirective":
+			// go.mod has no go directive.
+			c.(*GoDirective).MinVersion = "1.10"
+		case "spelling":
+			c.(*Spelling).Dictionary = msgFile
+		case "sqlvet":
+			c.(*SQLVet).Dialect = "foo"
+		case "stalebranch":
+			// There's no commit in the repository.
+			c.(*StaleBranch).Against = "HEAD"
+		case "testhygiene":
+			c.(*TestHygiene).Parallel = "required"
 		}
-		if err := c.Run(change, &Options{MaxDuration: 1}); err == nil {
+		if err := c.Run(change, &Options{MaxDuration: 1, CommitMessageFile: msgFile}).Err; err == nil {
 			t.Errorf("%s didn't fail but was expected to", c.GetName())
 		}
 	}
@@ -130,6 +176,36 @@ func TestChecksDescriptions(t *testing.T) {
 	}
 }
 
+func TestCopyrightYears(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		line     string
+		expected error
+	}{
+		{"// Copyright 2015 Foo. All rights reserved.", nil},
+		{"# Copyright (c) 2012-2015 Foo", nil},
+		{"/* Copyright 2012, 2014 - 2015 */", nil},
+		{"// Copyright 2014 Foo.", errors.New("copyright \"2014\" doesn't end with 2015")},
+		{"// Copyright 2012-2014 Foo.", errors.New("copyright \"2012-2014\" doesn't end with 2015")},
+		{"// Copyright 2015-2012 Foo.", errors.New("invalid copyright years \"2015-2012\"")},
+	}
+	for i, line := range data {
+		m := reCopyrightYear.FindStringSubmatch(line.line)
+		ut.AssertEqualIndex(t, i, 2, len(m))
+		ut.AssertEqualIndex(t, i, line.expected, checkCopyrightYears(m[1], 2015))
+	}
+	ut.AssertEqual(t, true, reCopyrightYear.FindStringSubmatch("// No copyright here") == nil)
+}
+
+func TestIsDockerfile(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, true, isDockerfile("Dockerfile"))
+	ut.AssertEqual(t, true, isDockerfile("build/Dockerfile.prod"))
+	ut.AssertEqual(t, true, isDockerfile("prod.Dockerfile"))
+	ut.AssertEqual(t, false, isDockerfile("Dockerfiles/README"))
+	ut.AssertEqual(t, false, isDockerfile("foo.go"))
+}
+
 func TestCustom(t *testing.T) {
 	t.Parallel()
 	p := []CheckPrerequisite{
@@ -148,6 +224,105 @@ func TestCustom(t *testing.T) {
 	ut.AssertEqual(t, p, c.GetPrerequisites())
 }
 
+func TestCustomEnvCwd(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{"sub/marker": "", "foo.go": "package foo\n"})
+	c := &Custom{
+		Limits:        Limits{Env: map[string]string{"PCG_FOO": "bar"}, Cwd: "sub"},
+		Command:       []string{"sh", "-c", "test \"$PCG_FOO\" = bar && test -f marker"},
+		CheckExitCode: true,
+	}
+	options := &Options{MaxDuration: 1}
+	ut.AssertEqual(t, nil, c.Run(change, options.ForCheck(c)).Err)
+	ut.AssertEqual(t, false, c.Run(change, options).Err == nil)
+	ut.AssertEqual(t, options, options.ForCheck(&Custom{}))
+}
+
+func TestCustomResourceLimits(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{"foo.go": "package foo\n"})
+	c := &Custom{
+		Limits:        Limits{Nice: 5, CPUs: 2, MemoryMB: 100},
+		Command:       []string{"sh", "-c", "test \"$GOMAXPROCS\" = 2 && test \"$GOMEMLIMIT\" = 100MiB && test \"$(nice)\" -ge 5"},
+		CheckExitCode: true,
+	}
+	options := &Options{MaxDuration: 1}
+	o := options.ForCheck(c)
+	ut.AssertEqual(t, []string{"GOMAXPROCS=2", "GOMEMLIMIT=100MiB"}, o.env)
+	ut.AssertEqual(t, []string{"nice", "-n", "5", "go"}, o.procArgs([]string{"go"}))
+	ut.AssertEqual(t, nil, c.Run(change, o).Err)
+	ut.AssertEqual(t, false, c.Run(change, options).Err == nil)
+
+	// env overrides the limits.
+	c.Env = map[string]string{"GOMAXPROCS": "1"}
+	ut.AssertEqual(t, []string{"GOMAXPROCS=2", "GOMEMLIMIT=100MiB", "GOMAXPROCS=1"}, options.ForCheck(c).env)
+}
+
+func TestGofmtFix(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{
+		"foo.go": "package foo\n\nfunc  Foo() {\n}\n",
+		"bar.go": "package foo\n",
+	})
+	g := &Gofmt{}
+	ut.AssertEqual(t, true, g.Run(change, &Options{}).Err != nil)
+	fixed, err := g.Fix(change, &Options{})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"foo.go"}, fixed)
+	ut.AssertEqual(t, nil, g.Run(change, &Options{}).Err)
+	fixed, err = g.Fix(change, &Options{})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string(nil), fixed)
+}
+
+func TestGofmtOnlyChanged(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{
+		"foo.go": "package foo\n",
+	})
+	// bar.go is badly formatted but it is not part of the change.
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(change.Repo().Root(), "bar.go"), []byte("package foo\n\nfunc  Bar() {\n}\n"), 0600))
+	g := &Gofmt{}
+	ut.AssertEqual(t, true, g.Run(change, &Options{}).Err != nil)
+	ut.AssertEqual(t, nil, g.Run(change, &Options{OnlyChanged: true}).Err)
+	fixed, err := g.Fix(change, &Options{OnlyChanged: true})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string(nil), fixed)
+}
+
+func TestGovet(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{
+		"foo.go":      "package foo\n\nimport \"fmt\"\n\nfunc Foo() {\n\tfmt.Printf(\"%d\\n\", \"a\")\n}\n",
+		"foo_test.go": "package foo\n\nimport \"testing\"\n\nfunc TestFoo(t *testing.T) {\n\tx := 1\n\tx = x\n}\n",
+		"bar/bar.go":  "package bar\n",
+	})
+	g := &Govet{}
+	expected := "go vet failed:\n" +
+		"foo.go:6:14: fmt.Printf format %d has arg \"a\" of wrong type string\n" +
+		"foo_test.go:7:2: self-assignment of x"
+	ut.AssertEqual(t, errors.New(expected), g.Run(change, &Options{MaxDuration: 60}).Err)
+	ut.AssertEqual(t, errors.New(expected), g.Run(change, &Options{MaxDuration: 60, OnlyChanged: true}).Err)
+	g.Blacklist = []string{"self-assignment"}
+	ut.AssertEqual(t, errors.New("go vet failed:\nfoo.go:6:14: fmt.Printf format %d has arg \"a\" of wrong type string"), g.Run(change, &Options{MaxDuration: 60}).Err)
+}
+
 // Private stuff.
 
 // This set of files passes all the tests.
@@ -199,6 +374,16 @@ func TestFail(t *testing.T) {
 t.Fail()
 }
 `,
+	"go.mod":             "module foo\n\nreplace bar => ../bar\n",
+	"year.go":            "// Copyright 2014 Foo\n\npackage foo\n",
+	"foo.json":           "{",
+	"README.md":          "Foo \n",
+	"Dockerfile":         "FROM debian\nRUN cd /tmp && apt-get install foo\n",
+	"foo.sh":             "#!/bin/sh\necho $1\n",
+	"foo_amd64.s":        "TEXT ·Foo(SB),$0\n    RET\n",
+	"bar/bar.go":         "// Foo\n\npackage bar\n\nimport _ \"example.com/baz/internal/qux\"\n\n//go:embed missing.txt\nvar s string\n",
+	"bar/bar_windows.go": "// Foo\n\n//go:build linux\n\npackage bar\n",
+	"bar/clock.go":       "// Foo\n\npackage bar\n\nimport \"time\"\n\nvar t = time.Now()\n",
 }
 
 func init() {
@@ -211,7 +396,8 @@ func init() {
 			loop = false
 			for _, name := range getKnownChecks() {
 				for _, p := range KnownChecks[name]().GetPrerequisites() {
-					if !p.IsPresent() {
+					// Prerequisites without URL are not installed automatically.
+					if p.URL != "" && !p.IsPresent() {
 						time.Sleep(10 * time.Millisecond)
 						loop = true
 						break
@@ -0,0 +1,132 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// clock enforces time is injected.
+
+package checks
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Clock flags the direct usage of the time package functions reading or
+// waiting on the wall clock, e.g. time.Now() or time.Sleep(), in packages that
+// must use an injected clock so they can be tested deterministically.
+//
+// Tests are not checked.
+type Clock struct {
+	Limits `yaml:",inline"`
+
+	// Packages is the list of glob patterns of the package directories,
+	// relative to the repository root, that require an injected clock. A
+	// pattern without '/' is matched against the directory name. Use "." for
+	// the root package.
+	Packages []string `yaml:"packages"`
+	// Allow is the list of glob patterns of the package directories matching
+	// Packages that may use the time package anyway, e.g. the package
+	// implementing the clock.
+	Allow []string `yaml:"allow"`
+	// Functions is the list of forbidden functions of the time package.
+	// Defaults to Now, Since, Until and Sleep.
+	Functions []string `yaml:"functions"`
+}
+
+// GetDescription implements Check.
+func (c *Clock) GetDescription() string {
+	return "enforces packages use an injected clock"
+}
+
+// GetName implements Check.
+func (c *Clock) GetName() string {
+	return "clock"
+}
+
+// GetPrerequisites implements Check.
+func (c *Clock) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (c *Clock) Run(change scm.Change, options *Options) Result {
+	return newResult(c.run(change, options), ParseIssues)
+}
+
+func (c *Clock) run(change scm.Change, options *Options) error {
+	functions := c.Functions
+	if len(functions) == 0 {
+		functions = []string{"Now", "Since", "Until", "Sleep"}
+	}
+	forbidden := map[string]bool{}
+	for _, f := range functions {
+		forbidden[f] = true
+	}
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		dir := path.Dir(f)
+		if strings.HasSuffix(f, "_test.go") || !c.isEnforced(dir) {
+			continue
+		}
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f, content, 0)
+		if err != nil {
+			bad = append(bad, parseErrorIssues(err)...)
+			continue
+		}
+		name := importName(file, "time")
+		if name == "" {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); ok && x.Name == name && x.Obj == nil && forbidden[sel.Sel.Name] {
+				bad = append(bad, issueAt(fset.Position(sel.Pos()), "time.%s", sel.Sel.Name))
+			}
+			return true
+		})
+	}
+	if len(bad) != 0 {
+		return errors.New("use the injected clock instead of:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// isEnforced returns true if the package in dir requires an injected clock.
+func (c *Clock) isEnforced(dir string) bool {
+	return matchAny(c.Packages, dir) && !matchAny(c.Allow, dir)
+}
+
+// Private stuff.
+
+// importName returns the name under which the package p is imported in file,
+// or an empty string if it is not imported or imported as _ or ".".
+func importName(file *ast.File, p string) string {
+	for _, i := range file.Imports {
+		if v, _ := strconv.Unquote(i.Path.Value); v != p {
+			continue
+		}
+		if i.Name == nil {
+			return path.Base(p)
+		}
+		if i.Name.Name == "_" || i.Name.Name == "." {
+			return ""
+		}
+		return i.Name.Name
+	}
+	return ""
+}
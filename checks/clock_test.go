@@ -0,0 +1,37 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"server/server.go":      "package server\n\nimport \"time\"\n\nfunc f() time.Duration {\n\ttime.Sleep(time.Second)\n\treturn time.Since(time.Now())\n}\n",
+		"server/server_test.go": "package server\n\nimport \"time\"\n\nvar t = time.Now()\n",
+		"server/alias.go":       "package server\n\nimport t \"time\"\n\nvar now = t.Now\n\nfunc g() {\n\ttime := struct{ Now int }{}\n\t_ = time.Now\n}\n",
+		"server/clock/clock.go": "package clock\n\nimport \"time\"\n\nvar t = time.Now()\n",
+		"cmd/main.go":           "package main\n\nimport \"time\"\n\nvar t = time.Now()\n",
+	}
+	change := setup(t, td, files)
+	ut.AssertEqual(t, nil, (&Clock{}).Run(change, &Options{}).Err)
+	c := &Clock{Packages: []string{"server", "server/*"}, Allow: []string{"server/clock"}}
+	expected := "use the injected clock instead of:\n" +
+		"server/alias.go:5:11: time.Now\n" +
+		"server/server.go:6:2: time.Sleep\n" +
+		"server/server.go:7:9: time.Since\n" +
+		"server/server.go:7:20: time.Now"
+	ut.AssertEqual(t, errors.New(expected), c.Run(change, &Options{}).Err)
+	c.Functions = []string{"Sleep"}
+	ut.AssertEqual(t, errors.New("use the injected clock instead of:\nserver/server.go:6:2: time.Sleep"), c.Run(change, &Options{}).Err)
+}
@@ -0,0 +1,137 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// commitmsg validates the commit message.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// CommitMessage validates the commit message against configurable rules.
+//
+// It is meant to be used in mode commit-msg, which is run by the commit-msg
+// git hook. The message is read from Options.CommitMessageFile; the check is
+// skipped when it is not set.
+type CommitMessage struct {
+	Limits `yaml:",inline"`
+
+	// MaxSubjectLength is the maximum length of the first line. 0 means no
+	// limit.
+	MaxSubjectLength int `yaml:"max_subject_length"`
+	// SubjectPattern is a regexp the first line must match, e.g. a conventional
+	// commit prefix like "^(feat|fix|docs|refactor|test|chore)(\(.+\))?!?: ".
+	SubjectPattern string `yaml:"subject_pattern"`
+	// RequiredTrailers is the list of trailers that must be present in the last
+	// paragraph of the message, e.g. "Signed-off-by".
+	RequiredTrailers []string `yaml:"required_trailers"`
+}
+
+// GetDescription implements Check.
+func (c *CommitMessage) GetDescription() string {
+	return "enforces the commit message follows the rules"
+}
+
+// GetName implements Check.
+func (c *CommitMessage) GetName() string {
+	return "commitmsg"
+}
+
+// GetPrerequisites implements Check.
+func (c *CommitMessage) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (c *CommitMessage) Run(change scm.Change, options *Options) Result {
+	return newResult(c.run(change, options), ParseIssues)
+}
+
+func (c *CommitMessage) run(change scm.Change, options *Options) error {
+	if options.CommitMessageFile == "" {
+		log.Printf("commitmsg: no commit message, skipping")
+		return nil
+	}
+	content, err := ioutil.ReadFile(options.CommitMessageFile)
+	if err != nil {
+		return err
+	}
+	return c.validate(cleanCommitMessage(string(content)))
+}
+
+// validate returns an error if msg doesn't follow the rules.
+func (c *CommitMessage) validate(msg string) error {
+	var re *regexp.Regexp
+	if c.SubjectPattern != "" {
+		var err error
+		if re, err = regexp.Compile(c.SubjectPattern); err != nil {
+			return fmt.Errorf("invalid subject_pattern: %s", err)
+		}
+	}
+	if msg == "" {
+		return errors.New("commit message is empty")
+	}
+	lines := strings.Split(msg, "\n")
+	subject := lines[0]
+	var bad []string
+	if l := utf8.RuneCountInString(subject); c.MaxSubjectLength != 0 && l > c.MaxSubjectLength {
+		bad = append(bad, fmt.Sprintf("subject is %d characters > %d", l, c.MaxSubjectLength))
+	}
+	if re != nil && !re.MatchString(subject) {
+		bad = append(bad, fmt.Sprintf("subject doesn't match %q", c.SubjectPattern))
+	}
+	if len(lines) > 1 && lines[1] != "" {
+		bad = append(bad, "the subject must be followed by an empty line")
+	}
+	if len(c.RequiredTrailers) != 0 {
+		// Trailers are in the last paragraph, which can't be the subject.
+		trailers := map[string]bool{}
+		if i := strings.LastIndex(msg, "\n\n"); i != -1 {
+			for _, line := range strings.Split(msg[i+2:], "\n") {
+				if j := strings.Index(line, ":"); j > 0 {
+					trailers[line[:j]] = true
+				}
+			}
+		}
+		for _, t := range c.RequiredTrailers {
+			if !trailers[t] {
+				bad = append(bad, fmt.Sprintf("missing trailer \"%s:\"", t))
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("invalid commit message:\n  " + strings.Join(bad, "\n  "))
+	}
+	return nil
+}
+
+// Private stuff.
+
+// scissors is the line after which git discards the message, as used by
+// "git commit -v".
+const scissors = "# ------------------------ >8 ------------------------"
+
+// cleanCommitMessage removes the comments from the message like git does.
+func cleanCommitMessage(msg string) string {
+	var lines []string
+	for _, line := range strings.Split(msg, "\n") {
+		if line == scissors {
+			break
+		}
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		lines = append(lines, strings.TrimRight(line, " \t\r"))
+	}
+	return strings.Trim(strings.Join(lines, "\n"), "\n")
+}
@@ -0,0 +1,42 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestCommitMessageValidate(t *testing.T) {
+	t.Parallel()
+	c := &CommitMessage{
+		MaxSubjectLength: 20,
+		SubjectPattern:   `^(feat|fix): `,
+		RequiredTrailers: []string{"Signed-off-by"},
+	}
+	data := []struct {
+		msg      string
+		expected error
+	}{
+		{"fix: foo\n\nBody.\n\nSigned-off-by: Foo <foo@example.com>", nil},
+		{"fix: foo\n\nSigned-off-by: Foo <foo@example.com>", nil},
+		{"", errors.New("commit message is empty")},
+		{"fix: foo\nSigned-off-by: Foo <foo@example.com>", errors.New("invalid commit message:\n  the subject must be followed by an empty line\n  missing trailer \"Signed-off-by:\"")},
+		{"Add a very long subject line", errors.New("invalid commit message:\n  subject is 28 characters > 20\n  subject doesn't match \"^(feat|fix): \"\n  missing trailer \"Signed-off-by:\"")},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, c.validate(line.msg))
+	}
+	c.SubjectPattern = "("
+	ut.AssertEqual(t, errors.New("invalid subject_pattern: error parsing regexp: missing closing ): `(`"), c.validate("foo"))
+}
+
+func TestCleanCommitMessage(t *testing.T) {
+	t.Parallel()
+	msg := "Subject  \n\nBody\n# Please enter the commit message\n#\n" + scissors + "\ndiff --git a/foo b/foo\n"
+	ut.AssertEqual(t, "Subject\n\nBody", cleanCommitMessage(msg))
+}
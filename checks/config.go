@@ -7,13 +7,28 @@
 package checks
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/scm"
 )
 
 // Mode is one of the check mode. When running checks, the mode determine what
 // checks are executed.
+//
+// Besides the predefined modes, a configuration can declare its own, e.g.
+// "nightly" or "docs", which are only run when selected explicitly.
 type Mode string
 
 // All predefined modes are executed automatically based on the context, except
@@ -23,10 +38,20 @@ const (
 	PrePush               Mode = "pre-push"
 	ContinuousIntegration Mode = "continuous-integration"
 	Lint                  Mode = "lint"
+	// CommitMsg is run by the commit-msg git hook, once the commit message is
+	// written.
+	CommitMsg Mode = "commit-msg"
 )
 
-// AllModes are all known valid modes that can be used in pre-commit-go.yml.
-var AllModes = []Mode{PreCommit, PrePush, ContinuousIntegration, Lint}
+// AllModes are all predefined modes. Use Config.AllModes() to include the
+// modes declared in pre-commit-go.yml.
+var AllModes = []Mode{PreCommit, PrePush, ContinuousIntegration, Lint, CommitMsg}
+
+// IsValid returns true if the mode is predefined or is a valid custom mode
+// name: lower case letters, digits and dashes, starting with a letter.
+func (m Mode) IsValid() bool {
+	return m.isPredefined() || reModeName.MatchString(string(m))
+}
 
 // UnmarshalYAML implements yaml.Unmarshaler.
 func (m *Mode) UnmarshalYAML(unmarshal func(interface{}) error) error {
@@ -35,13 +60,11 @@ func (m *Mode) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return err
 	}
 	val := Mode(s)
-	for _, known := range AllModes {
-		if val == known {
-			*m = val
-			return nil
-		}
+	if !val.IsValid() {
+		return fmt.Errorf("invalid mode \"%s\"", val)
 	}
-	return fmt.Errorf("invalid mode \"%s\"", val)
+	*m = val
+	return nil
 }
 
 // Config is the serialized form of pre-commit-go.yml.
@@ -49,6 +72,13 @@ type Config struct {
 	// MinVersion is set to the current pcg version. Earlier version will refuse
 	// to load this file.
 	MinVersion string `yaml:"min_version"`
+	// Extends is the path, relative to this file, or the HTTPS URL of a base
+	// configuration. The keys of this file override the ones of the base; a
+	// key set to null removes it. It is optional.
+	Extends string `yaml:"extends,omitempty"`
+	// ExtendsSHA256 pins the hex encoded SHA-256 of the base configuration. A
+	// pinned remote base is fetched once and then read from the cache.
+	ExtendsSHA256 string `yaml:"extends_sha256,omitempty"`
 	// Settings per mode. Settings includes the checks and the maximum allowed
 	// time spent to run them.
 	Modes map[Mode]Settings `yaml:"modes"`
@@ -57,17 +87,141 @@ type Config struct {
 	// []string{".*", "_*"}.  This is a glob that is applied to each path
 	// component of each file.
 	IgnorePatterns []string `yaml:"ignore_patterns"`
+	// Forge is the code hosting service where pull requests are fetched from
+	// when using "pcg run -pr". It is optional.
+	Forge *Forge `yaml:"forge,omitempty"`
+	// Languages maps a language name to the checks to run on files of this
+	// language, e.g. to lint Python scripts shipped in the repository. It is
+	// optional.
+	Languages map[string]*Language `yaml:"languages,omitempty"`
+	// Prerequisites pins the version of the prerequisites, keyed by the tool
+	// name, e.g. "errcheck": "v1.6.3". 'pcg prereq outdated' reports the
+	// installed tools not matching. It is optional.
+	Prerequisites map[string]string `yaml:"prerequisites,omitempty"`
+	// Hooks is the list of git hooks installed by 'pcg install'. Supported
+	// hooks are "pre-commit", "pre-push", "commit-msg", "post-checkout" and
+	// "post-merge". It defaults to all of them.
+	Hooks []string `yaml:"hooks,omitempty"`
+	// Profiles maps a profile name to the options of the checks it overrides,
+	// e.g. "quick". A profile is selected with 'pcg run -p'. It is optional.
+	Profiles map[string]*Profile `yaml:"profiles,omitempty"`
+	// MaxParallel is the maximum number of checks running concurrently in the
+	// modes not setting it. It defaults to the number of CPUs.
+	MaxParallel int `yaml:"max_parallel,omitempty"`
+	// AllowUnknownKeys disables the rejection of unknown keys when the file is
+	// loaded, e.g. for a file shared with newer versions of pcg. It is
+	// optional.
+	AllowUnknownKeys bool `yaml:"allow_unknown_keys,omitempty"`
+	// Notifications are the webhooks receiving a summary of the results after
+	// each run in continuous-integration mode. It is optional.
+	Notifications []*Notification `yaml:"notifications,omitempty"`
+	// Untracked defines what happens to the untracked files when the checkout
+	// is stashed, e.g. to check out the commits being pushed: "exclude" stashes
+	// them too, "include" leaves them in the checkout and "fail" refuses to
+	// run. Defaults to "exclude".
+	Untracked scm.Untracked `yaml:"untracked,omitempty"`
+}
+
+// Language routes checks to the files with specific extensions.
+//
+// The checks of a language are only run when at least one file with one of
+// its extensions is modified, and they only see these files.
+type Language struct {
+	// Extensions is the list of file extensions of this language, including the
+	// leading dot, e.g. ".py".
+	Extensions []string `yaml:"extensions"`
+	// Settings per mode for this language.
+	Modes map[Mode]Settings `yaml:"modes"`
+}
+
+// Forge describes the code hosting service of the repository.
+type Forge struct {
+	// Type is the kind of forge. Only "github" is supported.
+	Type string `yaml:"type"`
+	// Repo is the repository on the forge, in the form "owner/name".
+	Repo string `yaml:"repo"`
+	// Remote is the git remote to fetch pull requests from. Defaults to
+	// "origin".
+	Remote string `yaml:"remote"`
+	// APIURL is the root URL of the forge's API. Defaults to
+	// "https://api.github.com".
+	APIURL string `yaml:"api_url"`
+	// TokenEnv is the environment variable containing the API token. Defaults
+	// to "GITHUB_TOKEN". The token is only required to post results back or to
+	// access private repositories.
+	TokenEnv string `yaml:"token_env"`
+}
+
+// Notification is a webhook receiving a summary of the results of a run.
+type Notification struct {
+	// URL is the webhook URL the summary is POSTed to. Use an environment
+	// variable to keep a secret URL out of the repository, e.g.
+	// "${SLACK_WEBHOOK_URL}".
+	URL string `yaml:"url"`
+	// Type is "json", the default, to post the summary as a JSON document or
+	// "slack" to post it as a message to a Slack incoming webhook.
+	Type string `yaml:"type,omitempty"`
+	// Template is a Go text/template replacing the default payload: the whole
+	// body for "json" or the text of the message for "slack". It is optional.
+	Template string `yaml:"template,omitempty"`
+	// OnlyFailures skips the notification when the run succeeds.
+	OnlyFailures bool `yaml:"only_failures,omitempty"`
+}
+
+// AllModes returns the predefined modes followed by the custom modes declared
+// in the configuration, sorted.
+func (c *Config) AllModes() []Mode {
+	out := append([]Mode{}, AllModes...)
+	var custom []string
+	for mode := range c.Modes {
+		if !mode.isPredefined() {
+			custom = append(custom, string(mode))
+		}
+	}
+	sort.Strings(custom)
+	for _, mode := range custom {
+		out = append(out, Mode(mode))
+	}
+	return out
 }
 
 // EnabledChecks returns all the checks enabled.
 func (c *Config) EnabledChecks(modes []Mode) ([]Check, *Options) {
 	out := []Check{}
 	options := &Options{}
+	languages := make([]string, 0, len(c.Languages))
+	for name := range c.Languages {
+		languages = append(languages, name)
+	}
+	sort.Strings(languages)
+	// The run is only scoped to the modified packages if all the modes are.
+	onlyChanged := len(modes) != 0
 	for _, mode := range modes {
 		for _, checks := range c.Modes[mode].Checks {
-			out = append(out, checks...)
+			for _, check := range checks {
+				if isEnabled(check) {
+					out = append(out, check)
+				}
+			}
 		}
 		options = options.merge(c.Modes[mode].Options)
+		onlyChanged = onlyChanged && c.Modes[mode].Options.OnlyChanged
+		for _, name := range languages {
+			l := c.Languages[name]
+			for _, checks := range l.Modes[mode].Checks {
+				for _, check := range checks {
+					if isEnabled(check) {
+						out = append(out, &LanguageCheck{Language: name, Extensions: l.Extensions, Check: check})
+					}
+				}
+			}
+			options = options.merge(l.Modes[mode].Options)
+		}
+	}
+	options.OnlyChanged = onlyChanged
+	options.testRuns = newTestRuns(out)
+	if options.MaxParallel == 0 {
+		options.MaxParallel = c.MaxParallel
 	}
 	return out, options
 }
@@ -85,15 +239,314 @@ type Options struct {
 	// MaxDuration is the maximum allowed duration to run all the checks in
 	// seconds. If it takes more time than that, it is marked as failed.
 	MaxDuration int `yaml:"max_duration"`
+	// OwnedOnly restricts the issues reported by the lint checks errcheck,
+	// golint and govet to the lines last modified by the current git user, as
+	// determined by git blame. Lines not committed yet are always reported. This
+	// is useful when pairing or in large refactors with mechanical changes.
+	OwnedOnly bool `yaml:"owned_only,omitempty"`
+	// OnlyChanged scopes gofmt, govet and the global coverage inference to the
+	// modified packages and the packages importing them, instead of the whole
+	// tree. build and test are always scoped this way. It is the biggest
+	// speedup available on large repositories.
+	OnlyChanged bool `yaml:"only_changed,omitempty"`
+	// MaxParallel is the maximum number of checks running concurrently, with
+	// each check counting for its weight. 0 means the global max_parallel,
+	// which defaults to the number of CPUs.
+	MaxParallel int `yaml:"max_parallel,omitempty"`
+	// FailFast cancels the checks still running and skips the ones not started
+	// yet as soon as a check fails, instead of reporting all the failures.
+	FailFast bool `yaml:"fail_fast,omitempty"`
+
+	// CommitMessageFile is the path to the file containing the commit message
+	// when run from the commit-msg hook. It is not serialized.
+	CommitMessageFile string `yaml:"-"`
+	// PackageTimings, when set, records how long each package took to process
+	// by the checks working per package. It is not serialized.
+	PackageTimings *PackageTimings `yaml:"-"`
+	// Baseline, when set, holds the lint findings grandfathered in, which are
+	// not reported. It is not serialized.
+	Baseline *Baseline `yaml:"-"`
+	// Shard, when set, restricts test to the packages of one of several CI
+	// jobs. It is not serialized.
+	Shard *Shard `yaml:"-"`
+	// NoCache makes coverage test all the packages instead of reusing the
+	// coverage of the ones whose inputs didn't change. It is not serialized.
+	NoCache bool `yaml:"-"`
+	// Env is applied to the environment of the external commands run by the
+	// checks before the variables of each check, as "KEY=value" items or "KEY"
+	// to remove the variable. It is not serialized.
+	Env []string `yaml:"-"`
+
+	// owners caches the blame attribution for the run when OwnedOnly is set.
+	owners *owners
+	// testRuns shares the go test runs between the test and coverage checks.
+	testRuns *testRuns
+	// ctx, when set, kills the external commands run by the check when done.
+	ctx context.Context
+	// env are the additional "KEY=value" environment variables of the external
+	// commands run by the check.
+	env []string
+	// cwd is the directory of the external commands run by the check, relative
+	// to the repository root.
+	cwd string
+	// wrap is prepended to the external commands run by the check, e.g. nice.
+	wrap []string
+}
+
+// ForCheck returns a copy of the options for running check, with the
+// environment variables and the working directory set in its configuration.
+func (o *Options) ForCheck(check Check) *Options {
+	if lc, ok := check.(*LanguageCheck); ok {
+		check = lc.Check
+	}
+	l, ok := check.(limited)
+	if !ok {
+		return o
+	}
+	limits := l.limits()
+	if len(limits.Env) == 0 && limits.Cwd == "" && limits.Nice <= 0 && limits.CPUs <= 0 && limits.MemoryMB <= 0 {
+		return o
+	}
+	out := Options{}
+	if o != nil {
+		out = *o
+	}
+	out.env = make([]string, 0, len(limits.Env)+2)
+	for k, v := range limits.Env {
+		out.env = append(out.env, k+"="+v)
+	}
+	sort.Strings(out.env)
+	// The resource limits come first so env can override them.
+	var resources []string
+	if limits.CPUs > 0 {
+		resources = append(resources, fmt.Sprintf("GOMAXPROCS=%d", limits.CPUs))
+	}
+	if limits.MemoryMB > 0 {
+		resources = append(resources, fmt.Sprintf("GOMEMLIMIT=%dMiB", limits.MemoryMB))
+	}
+	out.env = append(resources, out.env...)
+	out.cwd = limits.Cwd
+	out.wrap = nil
+	if limits.Nice > 0 && runtime.GOOS != "windows" {
+		out.wrap = []string{"nice", "-n", strconv.Itoa(limits.Nice)}
+	}
+	return &out
+}
+
+// WithContext returns a copy of the options for running a single check. The
+// external commands started by the check are killed when ctx is done, e.g.
+// when its timeout is exceeded.
+func (o *Options) WithContext(ctx context.Context) *Options {
+	out := *o
+	out.ctx = ctx
+	return &out
+}
+
+// context returns the context of the check run, which is never done by
+// default.
+func (o *Options) context() context.Context {
+	if o == nil || o.ctx == nil {
+		return context.Background()
+	}
+	return o.ctx
+}
+
+// procEnv returns Env, env then the environment variables of the check.
+func (o *Options) procEnv(env ...string) []string {
+	if o == nil {
+		return env
+	}
+	return append(append(append([]string{}, o.Env...), env...), o.env...)
+}
+
+// SearchPath returns the $PATH the commands of the check run with, which may
+// not be the one of the process, e.g. in 'pcg daemon'.
+func (o *Options) SearchPath() string {
+	env := o.procEnv()
+	for i := len(env) - 1; i >= 0; i-- {
+		if env[i] == "PATH" {
+			return ""
+		}
+		if strings.HasPrefix(env[i], "PATH=") {
+			return env[i][len("PATH="):]
+		}
+	}
+	return os.Getenv("PATH")
+}
+
+// procArgs returns the command line to run args with the resource limits of
+// the check.
+func (o *Options) procArgs(args []string) []string {
+	if o == nil || len(o.wrap) == 0 {
+		return args
+	}
+	return append(append([]string{}, o.wrap...), args...)
+}
+
+// procDir returns the directory to run the commands of the check in.
+func (o *Options) procDir(root string) string {
+	if o == nil || o.cwd == "" {
+		return root
+	}
+	return filepath.Join(root, filepath.FromSlash(o.cwd))
+}
+
+// Limits are the settings shared by all the checks, inlined in their
+// configuration.
+type Limits struct {
+	// Timeout is the maximum duration of the check in seconds. When exceeded,
+	// the processes started by the check are killed and the check fails. 0
+	// means no limit.
+	Timeout int `yaml:"timeout,omitempty"`
+	// Paths restricts the check to the files matching one of these glob
+	// patterns, e.g. "pkg/**". "**" matches any number of directories and a
+	// pattern without "/" matches the file name. The check is skipped when no
+	// modified file matches. Defaults to all the files.
+	Paths []string `yaml:"paths,omitempty"`
+	// ExcludePaths excludes the files matching one of these glob patterns from
+	// the check, e.g. "**/testdata/**".
+	ExcludePaths []string `yaml:"exclude_paths,omitempty"`
+	// When is the condition to enable the check. Defaults to always.
+	When *Condition `yaml:"when,omitempty"`
+	// Env is the environment variables set for the external commands run by
+	// the check, e.g. DATABASE_URL for integration tests.
+	Env map[string]string `yaml:"env,omitempty"`
+	// Cwd is the directory, relative to the repository root, in which the
+	// external commands run by the check are started. The files and packages
+	// passed to the commands stay relative to the root. Defaults to the root.
+	Cwd string `yaml:"cwd,omitempty"`
+	// Nice is the niceness, from 1 to 19, of the external commands run by the
+	// check, so they yield the CPU to the interactive programs. Ignored on
+	// Windows.
+	Nice int `yaml:"nice,omitempty"`
+	// CPUs limits the number of CPUs used by the go commands and the Go
+	// programs, like the test binaries, run by the check via GOMAXPROCS. 0
+	// means all of them.
+	CPUs int `yaml:"cpus,omitempty"`
+	// MemoryMB is the soft memory limit in MiB of the go commands and the Go
+	// programs run by the check via GOMEMLIMIT; their garbage collector works
+	// harder to stay below it. 0 means no limit.
+	MemoryMB int `yaml:"memory_mb,omitempty"`
+	// DependsOn lists the names of the checks that must complete before this
+	// check starts, e.g. a custom code generation check before build. The
+	// check is skipped if one of them fails. The checks not enabled are
+	// ignored.
+	DependsOn []string `yaml:"depends_on,omitempty"`
+	// Weight is the number of max_parallel slots the check uses while running,
+	// e.g. to not run all the checks running the tests at once. Defaults to 1,
+	// and to half the number of CPUs for test and coverage.
+	Weight int `yaml:"weight,omitempty"`
+	// Severity is "error" or "warning". The failures of a check with severity
+	// "warning" are reported but don't fail the run, e.g. to introduce a new
+	// check gradually. Defaults to "error".
+	Severity Severity `yaml:"severity,omitempty"`
+}
+
+// Severity defines whether the failure of a check fails the run.
+type Severity string
+
+// All predefined severities.
+const (
+	// SeverityError fails the run when the check fails.
+	SeverityError Severity = "error"
+	// SeverityWarning reports the failure without failing the run.
+	SeverityWarning Severity = "warning"
+)
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (s *Severity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	v := ""
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	val := Severity(v)
+	if val != SeverityError && val != SeverityWarning {
+		return fmt.Errorf("invalid severity \"%s\"", val)
+	}
+	*s = val
+	return nil
+}
+
+// Condition is evaluated on the machine running the checks. All its fields
+// must match.
+//
+// In the lists, a value prefixed with "!" must not match; if there's any value
+// without "!", one must match.
+type Condition struct {
+	// GOOS is the list of operating systems, e.g. ["linux", "darwin"] or
+	// ["!windows"].
+	GOOS []string `yaml:"goos,omitempty"`
+	// GOARCH is the list of architectures, e.g. ["amd64", "arm64"].
+	GOARCH []string `yaml:"goarch,omitempty"`
+	// Env is the list of environment variables that must be set, e.g.
+	// ["DOCKER_HOST"] or ["!SKIP_SLOW"].
+	Env []string `yaml:"env,omitempty"`
+	// Executables is the list of executables that must be in PATH, e.g.
+	// ["docker"].
+	Executables []string `yaml:"executables,omitempty"`
+	// CI, when set, requires running on continuous integration or not.
+	CI *bool `yaml:"ci,omitempty"`
+}
+
+// GetTimeout implements Limiter.
+func (l *Limits) GetTimeout() time.Duration {
+	return time.Duration(l.Timeout) * time.Second
+}
+
+// IsEnabled implements Limiter.
+func (l *Limits) IsEnabled() bool {
+	return l.When == nil || l.When.Matches()
+}
+
+// GetDependencies implements Limiter.
+func (l *Limits) GetDependencies() []string {
+	return l.DependsOn
+}
+
+// GetWeight implements Limiter.
+func (l *Limits) GetWeight() int {
+	if l.Weight <= 0 {
+		return 1
+	}
+	return l.Weight
+}
+
+// GetSeverity implements Limiter.
+func (l *Limits) GetSeverity() Severity {
+	if l.Severity == "" {
+		return SeverityError
+	}
+	return l.Severity
+}
+
+// Matches returns true if the condition matches the current machine.
+func (c *Condition) Matches() bool {
+	isGOOS := func(v string) bool { return v == runtime.GOOS }
+	isGOARCH := func(v string) bool { return v == runtime.GOARCH }
+	isSet := func(v string) bool { return os.Getenv(v) != "" }
+	isInPath := func(v string) bool {
+		_, err := exec.LookPath(v)
+		return err == nil
+	}
+	return matchValues(c.GOOS, isGOOS) && matchValues(c.GOARCH, isGOARCH) &&
+		matchValues(c.Env, isSet) && matchValues(c.Executables, isInPath) &&
+		(c.CI == nil || *c.CI == IsContinuousIntegration())
 }
 
 // merge merges two options and returns a result.
 // This is used for multimode runs.
 func (o *Options) merge(r Options) *Options {
-	out := &Options{MaxDuration: o.MaxDuration}
+	out := &Options{MaxDuration: o.MaxDuration, OwnedOnly: o.OwnedOnly || r.OwnedOnly, FailFast: o.FailFast || r.FailFast, MaxParallel: o.MaxParallel, CommitMessageFile: o.CommitMessageFile, PackageTimings: o.PackageTimings, Baseline: o.Baseline, Shard: o.Shard, NoCache: o.NoCache, Env: o.Env}
 	if out.MaxDuration < r.MaxDuration {
 		out.MaxDuration = r.MaxDuration
 	}
+	// The strictest parallelism limit applies.
+	if r.MaxParallel != 0 && (out.MaxParallel == 0 || r.MaxParallel < out.MaxParallel) {
+		out.MaxParallel = r.MaxParallel
+	}
+	if out.OwnedOnly {
+		out.owners = newOwners()
+	}
 	return out
 }
 
@@ -133,7 +586,7 @@ func New(v string) *Config {
 		MinVersion: v,
 		Modes: map[Mode]Settings{
 			PreCommit: {
-				Options: Options{MaxDuration: 5},
+				Options: Options{MaxDuration: 5, OnlyChanged: true},
 				Checks: Checks{
 					"build": {
 						&Build{
@@ -244,3 +697,53 @@ func New(v string) *Config {
 		},
 	}
 }
+
+// Private stuff.
+
+// heavyWeight is the default weight of the checks running the tests, which
+// use about half of the CPUs each.
+func heavyWeight() int {
+	if n := runtime.NumCPU() / 2; n > 1 {
+		return n
+	}
+	return 1
+}
+
+// matchValues returns true if no value prefixed with "!" matches and, if
+// there's any value without "!", one of them matches.
+func matchValues(values []string, match func(v string) bool) bool {
+	positive := false
+	matched := false
+	for _, v := range values {
+		if strings.HasPrefix(v, "!") {
+			if match(v[1:]) {
+				return false
+			}
+			continue
+		}
+		positive = true
+		matched = matched || match(v)
+	}
+	return !positive || matched
+}
+
+// reModeName matches the valid custom mode names.
+var reModeName = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// isEnabled returns false if the condition of the check doesn't match.
+func isEnabled(check Check) bool {
+	if l, ok := check.(Limiter); ok && !l.IsEnabled() {
+		log.Printf("%s: disabled by its condition", check.GetName())
+		return false
+	}
+	return true
+}
+
+func (m Mode) isPredefined() bool {
+	for _, known := range AllModes {
+		if m == known {
+			return true
+		}
+	}
+	return false
+}
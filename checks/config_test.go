@@ -6,10 +6,13 @@ package checks
 
 import (
 	"errors"
+	"runtime"
 	"testing"
+	"time"
 
 	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
 	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/scm"
 )
 
 func TestConfigNew(t *testing.T) {
@@ -21,6 +24,8 @@ func TestConfigNew(t *testing.T) {
 	checks, options := config.EnabledChecks([]Mode{PreCommit, PrePush, ContinuousIntegration, Lint})
 	ut.AssertEqual(t, Options{MaxDuration: 120}, *options)
 	ut.AssertEqual(t, 2+4+5+3, len(checks))
+	_, options = config.EnabledChecks([]Mode{PreCommit})
+	ut.AssertEqual(t, true, options.OnlyChanged)
 }
 
 func TestConfigYAML(t *testing.T) {
@@ -33,9 +38,106 @@ func TestConfigYAML(t *testing.T) {
 }
 
 func TestConfigYAMLBadMode(t *testing.T) {
-	data, err := yaml.Marshal("foo")
+	data, err := yaml.Marshal("Foo Bar")
 	ut.AssertEqual(t, nil, err)
 	v := PreCommit
-	ut.AssertEqual(t, errors.New("invalid mode \"foo\""), yaml.Unmarshal(data, &v))
+	ut.AssertEqual(t, errors.New("invalid mode \"Foo Bar\""), yaml.Unmarshal(data, &v))
 	ut.AssertEqual(t, PreCommit, v)
 }
+
+func TestConfigYAMLTimeout(t *testing.T) {
+	data := []byte("modes:\n  pre-commit:\n    checks:\n      test:\n      - timeout: 30\n        extra_args:\n        - -short\n")
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(data, config))
+	c := config.Modes[PreCommit].Checks["test"][0]
+	ut.AssertEqual(t, &Test{Limits: Limits{Timeout: 30}, ExtraArgs: []string{"-short"}}, c)
+	ut.AssertEqual(t, 30*time.Second, c.(Limiter).GetTimeout())
+	out, err := yaml.Marshal(config.Modes[PreCommit].Checks)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "test:\n- timeout: 30\n  extra_args:\n  - -short\n", string(out))
+}
+
+func TestConfigYAMLSeverity(t *testing.T) {
+	data := []byte("modes:\n  pre-commit:\n    checks:\n      golint:\n      - severity: warning\n      govet:\n      - {}\n")
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(data, config))
+	ut.AssertEqual(t, SeverityWarning, config.Modes[PreCommit].Checks["golint"][0].(Limiter).GetSeverity())
+	ut.AssertEqual(t, SeverityError, config.Modes[PreCommit].Checks["govet"][0].(Limiter).GetSeverity())
+	data = []byte("modes:\n  pre-commit:\n    checks:\n      golint:\n      - severity: info\n")
+	ut.AssertEqual(t, errors.New("invalid severity \"info\""), yaml.Unmarshal(data, &Config{}))
+}
+
+func TestConfigYAMLUntracked(t *testing.T) {
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal([]byte("untracked: include\n"), config))
+	ut.AssertEqual(t, scm.UntrackedInclude, config.Untracked)
+	ut.AssertEqual(t, errors.New("invalid untracked \"ignore\""), yaml.Unmarshal([]byte("untracked: ignore\n"), &Config{}))
+}
+
+func TestConfigMaxParallel(t *testing.T) {
+	config := &Config{
+		Modes: map[Mode]Settings{
+			PreCommit: {Options: Options{MaxParallel: 4}},
+			PrePush:   {Options: Options{MaxParallel: 2}},
+			Lint:      {},
+		},
+		MaxParallel: 3,
+	}
+	_, options := config.EnabledChecks([]Mode{PreCommit, PrePush})
+	ut.AssertEqual(t, 2, options.MaxParallel)
+	_, options = config.EnabledChecks([]Mode{PreCommit, Lint})
+	ut.AssertEqual(t, 4, options.MaxParallel)
+	_, options = config.EnabledChecks([]Mode{Lint})
+	ut.AssertEqual(t, 3, options.MaxParallel)
+	ut.AssertEqual(t, 1, (&Build{}).GetWeight())
+	ut.AssertEqual(t, 3, (&Test{Limits: Limits{Weight: 3}}).GetWeight())
+	ut.AssertEqual(t, heavyWeight(), (&Coverage{}).GetWeight())
+}
+
+func TestConfigCustomModes(t *testing.T) {
+	data := []byte("modes:\n  nightly:\n    checks:\n      test:\n      - extra_args: []\n  docs:\n    checks:\n      markdown:\n      - {}\n")
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(data, config))
+	ut.AssertEqual(t, append(append([]Mode{}, AllModes...), "docs", "nightly"), config.AllModes())
+	enabled, _ := config.EnabledChecks([]Mode{"nightly"})
+	ut.AssertEqual(t, []Check{&Test{ExtraArgs: []string{}}}, enabled)
+	ut.AssertEqual(t, true, Mode("release-2").IsValid())
+	ut.AssertEqual(t, false, Mode("2nd").IsValid())
+}
+
+func TestConditionMatches(t *testing.T) {
+	t.Parallel()
+	yes := true
+	no := false
+	ci := IsContinuousIntegration()
+	data := []struct {
+		c        Condition
+		expected bool
+	}{
+		{Condition{}, true},
+		{Condition{GOOS: []string{runtime.GOOS}}, true},
+		{Condition{GOOS: []string{"plan9", runtime.GOOS}}, true},
+		{Condition{GOOS: []string{"!" + runtime.GOOS}}, false},
+		{Condition{GOARCH: []string{"!" + runtime.GOARCH, runtime.GOARCH}}, false},
+		{Condition{Env: []string{"PATH"}}, true},
+		{Condition{Env: []string{"PRE_COMMIT_GO_UNSET_VARIABLE"}}, false},
+		{Condition{Env: []string{"!PRE_COMMIT_GO_UNSET_VARIABLE"}}, true},
+		{Condition{Executables: []string{"git"}}, true},
+		{Condition{Executables: []string{"pre-commit-go-missing-tool"}}, false},
+		{Condition{CI: &yes}, ci},
+		{Condition{CI: &no}, !ci},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, line.c.Matches())
+	}
+}
+
+func TestConfigWhen(t *testing.T) {
+	t.Parallel()
+	data := []byte("modes:\n  pre-commit:\n    checks:\n      hadolint:\n      - when:\n          executables:\n          - pre-commit-go-missing-tool\n      gofmt:\n      - when:\n          goos:\n          - " + runtime.GOOS + "\n")
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(data, config))
+	enabled, _ := config.EnabledChecks([]Mode{PreCommit})
+	ut.AssertEqual(t, 1, len(enabled))
+	ut.AssertEqual(t, "gofmt", enabled[0].GetName())
+}
@@ -0,0 +1,236 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// configlint validates the syntax of configuration files.
+
+package checks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// ConfigLint validates the syntax of the modified .yml, .yaml, .json and .toml
+// files.
+//
+// pre-commit-go.yml files, and their .json and .toml counterparts, are also
+// validated against the configuration schema, so a broken configuration never
+// lands.
+type ConfigLint struct {
+	Limits `yaml:",inline"`
+
+	// Exclude is a list of glob patterns of files to skip. A pattern without
+	// '/' is matched against the file name, otherwise against the path relative
+	// to the repository root.
+	Exclude []string `yaml:"exclude"`
+}
+
+// GetDescription implements Check.
+func (c *ConfigLint) GetDescription() string {
+	return "enforces .yml, .yaml, .json and .toml files are valid"
+}
+
+// GetName implements Check.
+func (c *ConfigLint) GetName() string {
+	return "configlint"
+}
+
+// GetPrerequisites implements Check.
+func (c *ConfigLint) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (c *ConfigLint) Run(change scm.Change, options *Options) Result {
+	return newResult(c.run(change, options), ParseIssues)
+}
+
+func (c *ConfigLint) run(change scm.Change, options *Options) error {
+	var bad []string
+	for _, f := range change.Filtered().Changed().Files() {
+		if matchAny(c.Exclude, f) {
+			continue
+		}
+		ext := path.Ext(f)
+		if ext != ".yml" && ext != ".yaml" && ext != ".json" && ext != ".toml" {
+			continue
+		}
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		var err error
+		isConfig := strings.TrimSuffix(path.Base(f), ext) == configBaseName && ext != ".yaml"
+		if ext == ".toml" {
+			// Converted to YAML to validate it against the schema.
+			var t map[string]interface{}
+			if t, err = internal.ParseTOML(content); err == nil && isConfig {
+				if content, err = yaml.Marshal(t); err == nil {
+					err = ValidateConfig(content)
+				}
+			}
+		} else if isConfig {
+			err = ValidateConfig(content)
+		} else if ext == ".json" {
+			var v interface{}
+			err = json.Unmarshal(content, &v)
+		} else {
+			var v interface{}
+			err = yaml.Unmarshal(content, &v)
+		}
+		if err != nil {
+			bad = append(bad, fmt.Sprintf("%s: %s", f, strings.Replace(err.Error(), "\n", "\n  ", -1)))
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("invalid configuration files:\n" + strings.Join(bad, "\n"))
+	}
+	return nil
+}
+
+// ValidateConfig validates the content of a pre-commit-go.yml file against the
+// schema of Config.
+//
+// Unlike yaml.Unmarshal, it reports unknown keys, which are otherwise silently
+// ignored.
+func ValidateConfig(content []byte) error {
+	if err := yaml.Unmarshal(content, &Config{}); err != nil {
+		return err
+	}
+	var raw interface{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return err
+	}
+	var errs []string
+	validateSchema(raw, reflect.TypeOf(Config{}), "", &errs)
+	if len(errs) != 0 {
+		sort.Strings(errs)
+		return errors.New(strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+// Private stuff.
+
+// configBaseName is the name of the configuration file without its
+// extension.
+const configBaseName = "pre-commit-go"
+
+var (
+	typeChecks        = reflect.TypeOf(Checks{})
+	typeProfileChecks = reflect.TypeOf(Profile{}.Checks)
+)
+
+// validateSchema appends to errs the keys in raw, as decoded by yaml, that
+// are unknown to the type t.
+//
+// Value types are not verified, yaml.Unmarshal already does it.
+func validateSchema(raw interface{}, t reflect.Type, where string, errs *[]string) {
+	if raw == nil {
+		return
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == typeChecks || t == typeProfileChecks {
+		m, ok := raw.(map[interface{}]interface{})
+		if !ok {
+			return
+		}
+		for k, v := range m {
+			name := fmt.Sprintf("%v", k)
+			factory, ok := KnownChecks[name]
+			if !ok {
+				*errs = append(*errs, fmt.Sprintf("%s: unknown check \"%s\"", schemaPath(where, name), name))
+				continue
+			}
+			if t == typeProfileChecks {
+				// The options of a single instance.
+				validateSchema(v, reflect.TypeOf(factory()), schemaPath(where, name), errs)
+			} else if items, ok := v.([]interface{}); ok {
+				for i, item := range items {
+					validateSchema(item, reflect.TypeOf(factory()), fmt.Sprintf("%s[%d]", schemaPath(where, name), i), errs)
+				}
+			}
+		}
+		return
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		m, ok := raw.(map[interface{}]interface{})
+		if !ok {
+			return
+		}
+		fields := map[string]reflect.Type{}
+		yamlFields(t, fields)
+		for k, v := range m {
+			name := fmt.Sprintf("%v", k)
+			ft, ok := fields[name]
+			if !ok {
+				*errs = append(*errs, fmt.Sprintf("%s: unknown key", schemaPath(where, name)))
+				continue
+			}
+			validateSchema(v, ft, schemaPath(where, name), errs)
+		}
+	case reflect.Map:
+		if m, ok := raw.(map[interface{}]interface{}); ok {
+			for k, v := range m {
+				validateSchema(v, t.Elem(), schemaPath(where, fmt.Sprintf("%v", k)), errs)
+			}
+		}
+	case reflect.Slice:
+		if items, ok := raw.([]interface{}); ok {
+			for i, item := range items {
+				validateSchema(item, t.Elem(), fmt.Sprintf("%s[%d]", where, i), errs)
+			}
+		}
+	}
+}
+
+// yamlFields adds the fields of the struct type t to fields, keyed by their
+// yaml name, as done by yaml.v2.
+func yamlFields(t reflect.Type, fields map[string]reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue
+		}
+		tag := f.Tag.Get("yaml")
+		if tag == "-" {
+			continue
+		}
+		items := strings.Split(tag, ",")
+		inline := false
+		for _, flag := range items[1:] {
+			if flag == "inline" {
+				inline = true
+			}
+		}
+		if inline {
+			yamlFields(f.Type, fields)
+			continue
+		}
+		name := items[0]
+		if name == "" {
+			name = strings.ToLower(f.Name)
+		}
+		fields[name] = f.Type
+	}
+}
+
+func schemaPath(where, key string) string {
+	if where == "" {
+		return key
+	}
+	return where + "." + key
+}
@@ -0,0 +1,60 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+	data, err := yaml.Marshal(New("0.1"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, ValidateConfig(data))
+
+	content := `min_version: 0.1
+foo: bar
+modes:
+  pre-commit:
+    max_duration: 5
+    owned_only: true
+    checks:
+      golint:
+      - blacklist: []
+        whitelist: []
+      gofmt:
+      - {}
+`
+	ut.AssertEqual(t, errors.New("foo: unknown key\nmodes.pre-commit.checks.golint[0].whitelist: unknown key"), ValidateConfig([]byte(content)))
+	ut.AssertEqual(t, errors.New("unknown check \"foo\""), ValidateConfig([]byte("modes:\n  lint:\n    checks:\n      foo:\n      - {}\n")))
+	ut.AssertEqual(t, errors.New("invalid mode \"Foo\""), ValidateConfig([]byte("modes:\n  Foo: {}\n")))
+}
+
+func TestConfigLint(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"a.json":             "{\"a\": 1}",
+		"b.json":             "{\"a\": 1",
+		"c.yml":              "a: [1",
+		"d.yaml":             "a: 1\n",
+		"pre-commit-go.yml":  "languages: {}\nbar: 1\n",
+		"e.txt":              "{",
+		"f.toml":             "a = [1",
+		"g.toml":             "a = 1\n",
+		"pre-commit-go.toml": "bar = 1\n",
+	}
+	change := setup(t, td, files)
+	c := &ConfigLint{}
+	ut.AssertEqual(t, errors.New("invalid configuration files:\nb.json: unexpected end of JSON input\nc.yml: yaml: line 1: did not find expected ',' or ']'\nf.toml: line 1: expected , or ] in array\npre-commit-go.toml: bar: unknown key\npre-commit-go.yml: bar: unknown key"), c.Run(change, &Options{}).Err)
+	c.Exclude = []string{"*.json", "*.toml", "c.yml", "pre-commit-go.yml"}
+	ut.AssertEqual(t, nil, c.Run(change, &Options{}).Err)
+}
@@ -30,6 +30,8 @@ import (
 
 // Coverage runs all tests with coverage.
 type Coverage struct {
+	Limits `yaml:",inline"`
+
 	UseGlobalInference bool                         `yaml:"use_global_inference"`
 	UseCoveralls       bool                         `yaml:"use_coveralls"`
 	Global             CoverageSettings             `yaml:"global"`
@@ -53,19 +55,36 @@ func (c *Coverage) GetName() string {
 	return "coverage"
 }
 
+// GetWeight implements Limiter.
+//
+// The packages are tested concurrently so it defaults to heavyWeight.
+func (c *Coverage) GetWeight() int {
+	if c.Weight <= 0 {
+		return heavyWeight()
+	}
+	return c.Weight
+}
+
 // GetPrerequisites implements Check.
 func (c *Coverage) GetPrerequisites() []CheckPrerequisite {
 	if c.isGoverallsEnabled() {
-		return []CheckPrerequisite{{[]string{"goveralls", "-h"}, 2, "github.com/mattn/goveralls"}}
+		return []CheckPrerequisite{{[]string{"goveralls", "-h"}, 2, "github.com/mattn/goveralls", ""}}
 	}
 	return nil
 }
 
 // Run implements Check.
-func (c *Coverage) Run(change scm.Change, options *Options) error {
+func (c *Coverage) Run(change scm.Change, options *Options) Result {
+	profile, err := c.run(change, options)
+	r := newResult(err, parseGoTestIssues)
+	r.Coverage = profile
+	return r
+}
+
+func (c *Coverage) run(change scm.Change, options *Options) (CoverageProfile, error) {
 	profile, err := c.RunProfile(change, options)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if c.UseGlobalInference {
@@ -74,7 +93,7 @@ func (c *Coverage) Run(change scm.Change, options *Options) error {
 			log.Printf("coverage for %s:\n%s\n", change.Repo().Root(), out)
 		}
 		if err != nil {
-			return fmt.Errorf("coverage for %s: %s", change.Repo().Root(), err)
+			return profile, fmt.Errorf("coverage for %s: %s", change.Repo().Root(), err)
 		}
 	} else {
 		for _, testPkg := range change.Indirect().TestPackages() {
@@ -88,11 +107,11 @@ func (c *Coverage) Run(change scm.Change, options *Options) error {
 				log.Printf("%s:\n%s\n", testPkg, out)
 			}
 			if err != nil {
-				return fmt.Errorf("coverage for %s: %s", testPkg, err)
+				return profile, fmt.Errorf("coverage for %s: %s", testPkg, err)
 			}
 		}
 	}
-	return nil
+	return profile, nil
 }
 
 // RunProfile runs a coverage run according to the settings and return results.
@@ -100,7 +119,7 @@ func (c *Coverage) RunProfile(change scm.Change, options *Options) (profile Cove
 	// go test accepts packages, not files.
 	var testPkgs []string
 	if c.UseGlobalInference {
-		testPkgs = change.All().TestPackages()
+		testPkgs = globalSet(change, options).TestPackages()
 	} else {
 		testPkgs = change.Indirect().TestPackages()
 	}
@@ -132,7 +151,7 @@ func (c *Coverage) RunProfile(change scm.Change, options *Options) (profile Cove
 	if c.isGoverallsEnabled() {
 		// Please send a pull request if the following doesn't work for you on your
 		// favorite CI system.
-		out, _, err2 := capture(change.Repo(), "goveralls", "-coverprofile", filepath.Join(tmpDir, "profile.cov"))
+		out, _, err2 := capture(options, change.Repo(), "goveralls", "-coverprofile", filepath.Join(tmpDir, "profile.cov"))
 		// Don't fail the build.
 		if err2 != nil {
 			fmt.Printf("%s", out)
@@ -147,7 +166,8 @@ func (c *Coverage) RunProfile(change scm.Change, options *Options) (profile Cove
 // outside their own package.
 func (c *Coverage) RunGlobal(change scm.Change, options *Options, tmpDir string) (CoverageProfile, error) {
 	coverPkg := ""
-	for i, p := range change.All().Packages() {
+	all := globalSet(change, options)
+	for i, p := range all.Packages() {
 		if s := c.SettingsForPkg(p); s.MinCoverage != 0 {
 			if i != 0 {
 				coverPkg += ","
@@ -159,15 +179,19 @@ func (c *Coverage) RunGlobal(change scm.Change, options *Options, tmpDir string)
 	// This part is similar to Test.Run() except that it passes a unique
 	// -coverprofile file name, so that all the files can later be merged into a
 	// single file.
-	testPkgs := change.All().TestPackages()
+	testPkgs := all.TestPackages()
 	type result struct {
 		file string
 		err  error
 	}
 	results := make(chan *result)
+	// Up to the weight of the check packages are tested concurrently.
+	workers := make(chan struct{}, c.GetWeight())
 	for index, tp := range testPkgs {
 		f := filepath.Join(tmpDir, fmt.Sprintf("test%d.cov", index))
 		go func(f string, testPkg string) {
+			workers <- struct{}{}
+			defer func() { <-workers }()
 			// Maybe fallback to 'pkg + "/..."' and post process to remove
 			// uninteresting directories. The rationale is that it will eventually
 			// blow up the OS specific command argument length.
@@ -178,8 +202,9 @@ func (c *Coverage) RunGlobal(change scm.Change, options *Options, tmpDir string)
 				testPkg,
 			}
 			start := time.Now()
-			out, exitCode, err := capture(change.Repo(), args...)
+			out, exitCode, err := capture(options, change.Repo(), args...)
 			duration := time.Since(start)
+			options.PackageTimings.Record(c.GetName(), testPkg, duration)
 			if duration > time.Second {
 				log.Printf("%s was slow: %s", args, round(duration, time.Millisecond))
 			}
@@ -229,10 +254,36 @@ func (c *Coverage) RunGlobal(change scm.Change, options *Options, tmpDir string)
 func (c *Coverage) RunLocal(change scm.Change, options *Options, tmpDir string) (CoverageProfile, error) {
 	testPkgs := change.Indirect().TestPackages()
 	type result struct {
-		file string
-		err  error
+		index   int
+		file    string
+		profile []byte
+		counts  map[string]int
+		err     error
+	}
+	// The packages whose inputs didn't change since they last passed are not
+	// tested again.
+	var cache *coverageCache
+	hashes := make([]string, len(testPkgs))
+	cached := make([]map[string]int, len(testPkgs))
+	if !options.NoCache {
+		cache = loadCoverageCache(change.Repo())
+		var covered []string
+		for _, tp := range testPkgs {
+			if c.SettingsForPkg(tp).MinCoverage != 0 {
+				covered = append(covered, tp)
+			}
+		}
+		inputs := newCoverageInputs(change, options, covered)
+		for i, tp := range testPkgs {
+			if c.SettingsForPkg(tp).MinCoverage != 0 {
+				hashes[i] = inputs.hash(tp)
+				cached[i] = cache.lookup(tp, hashes[i])
+			}
+		}
 	}
 	results := make(chan *result)
+	// Up to the weight of the check packages are tested concurrently.
+	workers := make(chan struct{}, c.GetWeight())
 	for i, tp := range testPkgs {
 		go func(index int, testPkg string) {
 			settings := c.SettingsForPkg(testPkg)
@@ -241,17 +292,31 @@ func (c *Coverage) RunLocal(change scm.Change, options *Options, tmpDir string)
 				results <- nil
 				return
 			}
-
-			p := filepath.Join(tmpDir, fmt.Sprintf("test%d.cov", index))
-			args := []string{
-				"go", "test", "-v", "-covermode=count",
-				"-coverprofile", p,
-				"-timeout", fmt.Sprintf("%ds", options.MaxDuration),
-				testPkg,
+			if cached[index] != nil {
+				log.Printf("%s: using the cached coverage", testPkg)
+				results <- &result{index: index, counts: cached[index]}
+				return
 			}
+			workers <- struct{}{}
+			defer func() { <-workers }()
+
+			if options.testRuns != nil {
+				// Shared with the test check.
+				run := options.testRuns.run(testPkg, options, change.Repo())
+				options.PackageTimings.Record(c.GetName(), testPkg, run.duration)
+				if run.exitCode != 0 {
+					results <- &result{err: fmt.Errorf("%s %s failed:\n%s", strings.Join(run.args, " "), testPkg, processStackTrace(run.out))}
+					return
+				}
+				results <- &result{index: index, profile: run.profile}
+				return
+			}
+			p := filepath.Join(tmpDir, fmt.Sprintf("test%d.cov", index))
+			args := c.localArgs(testPkg, p, options)
 			start := time.Now()
-			out, exitCode, _ := capture(change.Repo(), args...)
+			out, exitCode, _ := capture(options, change.Repo(), args...)
 			duration := time.Since(start)
+			options.PackageTimings.Record(c.GetName(), testPkg, duration)
 			if duration > time.Second {
 				log.Printf("%s was slow: %s", args, round(duration, time.Millisecond))
 			}
@@ -259,7 +324,7 @@ func (c *Coverage) RunLocal(change scm.Change, options *Options, tmpDir string)
 				results <- &result{err: fmt.Errorf("%s %s failed:\n%s", strings.Join(args, " "), testPkg, processStackTrace(out))}
 				return
 			}
-			results <- &result{file: p}
+			results <- &result{index: index, file: p}
 		}(i, tp)
 	}
 
@@ -288,18 +353,51 @@ func (c *Coverage) RunLocal(change scm.Change, options *Options, tmpDir string)
 			err = result.err
 			continue
 		}
-		if err2 := loadRawCoverage(result.file, counts); err == nil {
-			// Wait for all tests to complete before returning.
-			err = err2
+		pkgCounts := result.counts
+		if pkgCounts == nil {
+			pkgCounts = map[string]int{}
+			var err2 error
+			if result.profile != nil {
+				err2 = parseRawCoverage("coverage profile", bytes.NewReader(result.profile), pkgCounts)
+			} else {
+				err2 = loadRawCoverage(result.file, pkgCounts)
+			}
+			if err2 != nil {
+				// Wait for all tests to complete before returning.
+				err = err2
+				continue
+			}
+			if cache != nil && hashes[result.index] != "" {
+				cache.Packages[testPkgs[result.index]] = &cachedCoverage{Inputs: hashes[result.index], Counts: pkgCounts}
+			}
+		}
+		for k, v := range pkgCounts {
+			counts[k] += v
 		}
 	}
 	if err != nil {
 		f.Close()
 		return nil, err
 	}
+	if cache != nil {
+		if err := cache.save(change.Repo()); err != nil {
+			log.Printf("failed to save %s: %s", CoverageCacheFile, err)
+		}
+	}
 	return loadMergeAndClose(f, counts, change)
 }
 
+// localArgs returns the go test arguments to run the tests of testPkg with
+// coverage, writing the profile to p.
+func (c *Coverage) localArgs(testPkg, p string, options *Options) []string {
+	return []string{
+		"go", "test", "-v", "-covermode=count",
+		"-coverprofile", p,
+		"-timeout", fmt.Sprintf("%ds", options.MaxDuration),
+		testPkg,
+	}
+}
+
 // SettingsForPkg returns the settings for a particular package.
 //
 // If the PerDir value is set to a null pointer, returns empty coverage.
@@ -494,6 +592,14 @@ type FuncCovered struct {
 
 // Private stuff.
 
+// globalSet returns the packages considered by the global inference.
+func globalSet(change scm.Change, options *Options) scm.Set {
+	if options.OnlyChanged {
+		return change.Indirect()
+	}
+	return change.All()
+}
+
 func pkgToDir(p string) string {
 	if p == "." {
 		return p
@@ -571,7 +677,13 @@ func loadRawCoverage(file string, counts map[string]int) error {
 		return err
 	}
 	defer f.Close()
-	s := bufio.NewScanner(f)
+	return parseRawCoverage(file, f, counts)
+}
+
+// parseRawCoverage is loadRawCoverage for a profile already read.
+func parseRawCoverage(file string, r io.Reader, counts map[string]int) error {
+	var err error
+	s := bufio.NewScanner(r)
 	// Strip the first line.
 	s.Scan()
 	if line := s.Text(); line != "mode: count" {
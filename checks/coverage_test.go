@@ -5,7 +5,6 @@
 package checks
 
 import (
-	"io/ioutil"
 	"os"
 	"testing"
 
@@ -18,13 +17,8 @@ func TestCoverageGlobal(t *testing.T) {
 	if testing.Short() {
 		t.SkipNow()
 	}
-	td, err := ioutil.TempDir("", "pre-commit-go")
-	ut.AssertEqual(t, nil, err)
-	defer func() {
-		if err := internal.RemoveAll(td); err != nil {
-			t.Fail()
-		}
-	}()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
 	change := setup(t, td, coverageFiles)
 
 	c := &Coverage{
@@ -40,7 +34,7 @@ func TestCoverageGlobal(t *testing.T) {
 		},
 		PerDir: map[string]*CoverageSettings{},
 	}
-	profile, err := c.RunProfile(change, &Options{1})
+	profile, err := c.RunProfile(change, &Options{MaxDuration: 1})
 	ut.AssertEqual(t, nil, err)
 	expected := CoverageProfile{
 		{
@@ -122,13 +116,8 @@ func TestCoverageLocal(t *testing.T) {
 	if testing.Short() {
 		t.SkipNow()
 	}
-	td, err := ioutil.TempDir("", "pre-commit-go")
-	ut.AssertEqual(t, nil, err)
-	defer func() {
-		if err := internal.RemoveAll(td); err != nil {
-			t.Fail()
-		}
-	}()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
 	change := setup(t, td, coverageFiles)
 
 	c := &Coverage{
@@ -144,7 +133,7 @@ func TestCoverageLocal(t *testing.T) {
 		},
 		PerDir: map[string]*CoverageSettings{},
 	}
-	profile, err := c.RunProfile(change, &Options{1})
+	profile, err := c.RunProfile(change, &Options{MaxDuration: 1})
 	ut.AssertEqual(t, nil, err)
 	expected := CoverageProfile{
 		{
@@ -206,7 +195,7 @@ func TestCoverageLocal(t *testing.T) {
 	}
 	ut.AssertEqual(t, expected, profile.Subset("bar"))
 
-	ut.AssertEqual(t, nil, c.Run(change, &Options{MaxDuration: 1}))
+	ut.AssertEqual(t, nil, c.Run(change, &Options{MaxDuration: 1}).Err)
 }
 
 var coverageFiles = map[string]string{
@@ -0,0 +1,291 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// coveragecache keeps the coverage of each package between runs.
+
+package checks
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// CoverageCacheFile is the name of the file in the scm directory, e.g. .git/,
+// keeping the coverage of each package measured by the previous runs.
+const CoverageCacheFile = "pre-commit-go-coverage.json"
+
+// coverageCache is the raw coverage of the test packages that passed, so the
+// packages whose inputs didn't change since are not tested again.
+type coverageCache struct {
+	Packages map[string]*cachedCoverage `json:"packages"`
+}
+
+// cachedCoverage is the raw coverage of a test package, as loaded by
+// loadRawCoverage().
+type cachedCoverage struct {
+	// Inputs is the hash returned by coverageInputs.hash().
+	Inputs string         `json:"inputs"`
+	Counts map[string]int `json:"counts"`
+}
+
+// loadCoverageCache loads the cache from the scm directory. It never fails; an
+// empty cache is returned if none is found.
+func loadCoverageCache(repo scm.ReadOnlyRepo) *coverageCache {
+	c := &coverageCache{Packages: map[string]*cachedCoverage{}}
+	p, err := coverageCachePath(repo)
+	if err != nil {
+		return c
+	}
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		return c
+	}
+	if err := json.Unmarshal(content, c); err != nil {
+		log.Printf("ignoring corrupted %s: %s", p, err)
+	}
+	if c.Packages == nil {
+		c.Packages = map[string]*cachedCoverage{}
+	}
+	return c
+}
+
+// save writes the cache in the scm directory.
+func (c *coverageCache) save(repo scm.ReadOnlyRepo) error {
+	p, err := coverageCachePath(repo)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, append(content, '\n'), 0666)
+}
+
+// lookup returns the cached counts of testPkg if its inputs didn't change.
+func (c *coverageCache) lookup(testPkg, inputs string) map[string]int {
+	if e := c.Packages[testPkg]; e != nil && inputs != "" && e.Inputs == inputs {
+		return e.Counts
+	}
+	return nil
+}
+
+// coverageInputs computes what the coverage of a test package depends on: the
+// files compiled in its test binary, including the embedded ones and the
+// vendored packages, the files of its directory and its testdata/, plus the go
+// toolchain and the environment of the check.
+//
+// The packages are enumerated with "go list -deps -test" and the files are
+// read from disk, so the files ignored by the configuration are included.
+type coverageInputs struct {
+	root   string
+	prefix string
+	// pkgs are the packages listed by go list, keyed by import path. nil if go
+	// list failed, in which case nothing is cached.
+	pkgs map[string]*listedPackage
+}
+
+// listedPackage is the subset of the output of "go list -json" used to compute
+// the inputs of a test package.
+type listedPackage struct {
+	ImportPath      string
+	Dir             string
+	Standard        bool
+	Module          *listedModule
+	Deps            []string
+	GoFiles         []string
+	CgoFiles        []string
+	CFiles          []string
+	CXXFiles        []string
+	MFiles          []string
+	HFiles          []string
+	SFiles          []string
+	SysoFiles       []string
+	EmbedFiles      []string
+	TestGoFiles     []string
+	TestEmbedFiles  []string
+	XTestGoFiles    []string
+	XTestEmbedFiles []string
+	Error           *struct{ Err string }
+	DepsErrors      []*struct{ Err string }
+}
+
+// listedModule is the module of a listedPackage.
+type listedModule struct {
+	Path    string
+	Version string
+	Main    bool
+	Replace *listedModule
+}
+
+// newCoverageInputs lists the packages testPkgs depend on.
+func newCoverageInputs(change scm.Change, options *Options, testPkgs []string) *coverageInputs {
+	h := sha256.New()
+	fmt.Fprintf(h, "go\x00%s\x00", goStamp(options.SearchPath()))
+	for _, e := range options.procEnv() {
+		fmt.Fprintf(h, "env\x00%s\x00", e)
+	}
+	c := &coverageInputs{root: change.Repo().Root(), prefix: hex.EncodeToString(h.Sum(nil))}
+	if len(testPkgs) == 0 {
+		return c
+	}
+	out, exitCode, err := capture(options, change.Repo(), append([]string{"go", "list", "-deps", "-test", "-json"}, testPkgs...)...)
+	if exitCode != 0 || err != nil {
+		log.Printf("coverage: not cached, go list failed: %s\n%s", err, out)
+		return c
+	}
+	pkgs := map[string]*listedPackage{}
+	for d := json.NewDecoder(strings.NewReader(out)); ; {
+		p := &listedPackage{}
+		if err := d.Decode(p); err == io.EOF {
+			break
+		} else if err != nil {
+			log.Printf("coverage: not cached, invalid go list output: %s", err)
+			return c
+		}
+		pkgs[p.ImportPath] = p
+	}
+	c.pkgs = pkgs
+	return c
+}
+
+// hash returns the hash of the inputs of testPkg, or an empty string if they
+// can't be determined.
+func (c *coverageInputs) hash(testPkg string) string {
+	dir := filepath.Join(c.root, filepath.FromSlash(pkgToDir(testPkg)))
+	var pkg *listedPackage
+	for _, p := range c.pkgs {
+		if p.Dir == dir && !strings.Contains(p.ImportPath, " ") && !strings.HasSuffix(p.ImportPath, ".test") {
+			pkg = p
+			break
+		}
+	}
+	if pkg == nil || pkg.Error != nil || len(pkg.DepsErrors) != 0 {
+		return ""
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00", c.prefix)
+	// The module versions used.
+	for _, f := range []string{"go.mod", "go.sum"} {
+		if !hashFile(h, c.root, f) {
+			return ""
+		}
+	}
+	// The files of the directory, e.g. the ignored ones the tests read, and its
+	// test data.
+	if !hashTree(h, dir, false) || !hashTree(h, filepath.Join(dir, "testdata"), true) {
+		return ""
+	}
+	// The packages compiled in the test binary; the test files of the
+	// dependencies are not compiled in.
+	deps := pkg.Deps
+	if t := c.pkgs[pkg.ImportPath+".test"]; t != nil {
+		deps = t.Deps
+	}
+	names := append([]string{pkg.ImportPath}, deps...)
+	sort.Strings(names)
+	for _, name := range names {
+		p := c.pkgs[name]
+		if p == nil {
+			return ""
+		}
+		if p.Standard {
+			continue
+		}
+		if m := p.Module; m != nil && !m.Main && (m.Replace == nil || m.Replace.Version != "") {
+			// The module cache is read-only.
+			fmt.Fprintf(h, "module\x00%s\x00%s\x00", m.Path, m.Version)
+			continue
+		}
+		lists := [][]string{p.GoFiles, p.CgoFiles, p.CFiles, p.CXXFiles, p.MFiles, p.HFiles, p.SFiles, p.SysoFiles, p.EmbedFiles}
+		if p.Dir == dir {
+			lists = append(lists, p.TestGoFiles, p.TestEmbedFiles, p.XTestGoFiles, p.XTestEmbedFiles)
+		}
+		fmt.Fprintf(h, "package\x00%s\x00", name)
+		for _, l := range lists {
+			for _, f := range l {
+				if !hashFile(h, p.Dir, f) {
+					return ""
+				}
+			}
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Private stuff.
+
+// hashFile hashes the file f in dir. A missing file is hashed as such. Returns
+// false if the file can't be read.
+func hashFile(h hash.Hash, dir, f string) bool {
+	content, err := ioutil.ReadFile(filepath.Join(dir, f))
+	if os.IsNotExist(err) {
+		fmt.Fprintf(h, "%s\x00missing\x00", f)
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	fmt.Fprintf(h, "%s\x00%x\x00", filepath.ToSlash(f), sha256.Sum256(content))
+	return true
+}
+
+// hashTree hashes the files of dir, and of its subdirectories if recursive,
+// including the ignored and the untracked ones. A missing directory is hashed
+// as empty.
+func hashTree(h hash.Hash, dir string, recursive bool) bool {
+	entries, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return true
+	}
+	if err != nil {
+		return false
+	}
+	for _, e := range entries {
+		p := filepath.Join(dir, e.Name())
+		if e.IsDir() {
+			if recursive && !hashTree(h, p, true) {
+				return false
+			}
+		} else if e.Mode().IsRegular() && !hashFile(h, dir, e.Name()) {
+			return false
+		}
+	}
+	return true
+}
+
+func coverageCachePath(repo scm.ReadOnlyRepo) (string, error) {
+	scmDir, err := repo.ScmDir()
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(scmDir); err != nil {
+		return "", err
+	}
+	return filepath.Join(scmDir, CoverageCacheFile), nil
+}
+
+// goStamp returns the path, size and modification time of the go executable
+// found in path, which change when the toolchain is updated.
+func goStamp(path string) string {
+	if p, err := internal.LookPath("go", path); err == nil {
+		if fi, err := os.Stat(p); err == nil {
+			return fmt.Sprintf("%s %d %d", p, fi.Size(), fi.ModTime().UnixNano())
+		}
+	}
+	return "missing"
+}
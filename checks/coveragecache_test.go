@@ -0,0 +1,92 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestCoverageInputs(t *testing.T) {
+	t.Parallel()
+	files := map[string]string{
+		"a/a.go":                    "package a\nimport (\n_ \"embed\"\n\"foo/b\"\n)\n//go:embed static\nvar s string\nvar A = b.B\n",
+		"a/static/in.txt":           "static\n",
+		"a/a_test.go":               "package a\nimport \"testing\"\nfunc TestA(t *testing.T) {}\n",
+		"a/testdata/in.txt":         "in\n",
+		"b/b.go":                    "package b\nimport \"example.com/v\"\nvar B = v.V\n",
+		"b/b_test.go":               "package b\nimport \"foo/c\"\nvar _ = c.C\n",
+		"c/c.go":                    "package c\nvar C = 1\n",
+		"c/testdata/other.txt":      "other\n",
+		"vendor/example.com/v/v.go": "package v\nconst V = 1\n",
+	}
+	hash := func(overrides map[string]string) string {
+		td, cleanup := internal.TempDir(t)
+		defer cleanup()
+		f := map[string]string{}
+		for k, v := range files {
+			f[k] = v
+		}
+		for k, v := range overrides {
+			f[k] = v
+		}
+		change := setup(t, td, f)
+		return newCoverageInputs(change, &Options{}, []string{"./a"}).hash("./a")
+	}
+	base := hash(nil)
+	ut.AssertEqual(t, true, base != "")
+	ut.AssertEqual(t, base, hash(nil))
+	// The inputs of the package.
+	ut.AssertEqual(t, true, base != hash(map[string]string{"a/a_test.go": "package a\n"}))
+	ut.AssertEqual(t, true, base != hash(map[string]string{"a/testdata/in.txt": "changed\n"}))
+	ut.AssertEqual(t, true, base != hash(map[string]string{"b/b.go": "package b\nconst B = 2\n"}))
+	// The embedded files, the vendored dependencies and the ignored files of the
+	// directory.
+	ut.AssertEqual(t, true, base != hash(map[string]string{"a/static/in.txt": "changed\n"}))
+	ut.AssertEqual(t, true, base != hash(map[string]string{"vendor/example.com/v/v.go": "package v\nconst V = 2\n"}))
+	ut.AssertEqual(t, true, base != hash(map[string]string{"a/.golden": "new\n"}))
+	// The tests of the dependencies and what only they import are not.
+	ut.AssertEqual(t, base, hash(map[string]string{"b/b_test.go": "package b\n"}))
+	ut.AssertEqual(t, base, hash(map[string]string{"c/c.go": "package c\nvar C = 2\n"}))
+	ut.AssertEqual(t, base, hash(map[string]string{"c/testdata/other.txt": "changed\n"}))
+}
+
+func TestCoverageCache(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.SkipNow()
+	}
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, coverageFiles)
+
+	c := &Coverage{PerDirDefault: CoverageSettings{MinCoverage: 50, MaxCoverage: 100}}
+	options := &Options{MaxDuration: 10}
+	r := c.Run(change, options)
+	ut.AssertEqual(t, nil, r.Err)
+	ut.AssertEqual(t, 60., r.Coverage.CoveragePercent())
+	cache := loadCoverageCache(change.Repo())
+	ut.AssertEqual(t, 2, len(cache.Packages))
+
+	// Mark every statement as covered in the cache; the packages are not tested
+	// again since their inputs didn't change.
+	for _, e := range cache.Packages {
+		for k := range e.Counts {
+			e.Counts[k] = 1
+		}
+	}
+	ut.AssertEqual(t, nil, cache.save(change.Repo()))
+	r = c.Run(change, options)
+	ut.AssertEqual(t, nil, r.Err)
+	ut.AssertEqual(t, 100., r.Coverage.CoveragePercent())
+
+	// Unless the cache is disabled.
+	options.NoCache = true
+	r = c.Run(change, options)
+	ut.AssertEqual(t, nil, r.Err)
+	ut.AssertEqual(t, 60., r.Coverage.CoveragePercent())
+}
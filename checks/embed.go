@@ -0,0 +1,196 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// embed validates //go:embed directives.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Embed enforces the patterns of the //go:embed directives in the modified Go
+// files match files tracked in the repository.
+//
+// Broken embeds only surface at build time, often on someone else's machine
+// when the embedded file was never added to git.
+type Embed struct {
+	Limits `yaml:",inline"`
+}
+
+// GetDescription implements Check.
+func (e *Embed) GetDescription() string {
+	return "enforces //go:embed patterns match tracked files"
+}
+
+// GetName implements Check.
+func (e *Embed) GetName() string {
+	return "embed"
+}
+
+// GetPrerequisites implements Check.
+func (e *Embed) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (e *Embed) Run(change scm.Change, options *Options) Result {
+	return newResult(e.run(change, options), ParseIssues)
+}
+
+func (e *Embed) run(change scm.Change, options *Options) error {
+	tracked := change.All().Files()
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f, content, parser.ParseComments)
+		if err != nil {
+			bad = append(bad, parseErrorIssues(err)...)
+			continue
+		}
+		dir := path.Dir(f)
+		for _, g := range file.Comments {
+			for _, c := range g.List {
+				if !strings.HasPrefix(c.Text, "//go:embed") {
+					continue
+				}
+				pos := fset.Position(c.Pos())
+				patterns, err := parseEmbed(strings.TrimPrefix(c.Text, "//go:embed"))
+				if err != nil {
+					bad = append(bad, issueAt(pos, "%s", err))
+					continue
+				}
+				for _, p := range patterns {
+					if err := checkEmbedPattern(change.Repo().Root(), dir, p, tracked); err != nil {
+						bad = append(bad, issueAt(pos, "%s", err))
+					}
+				}
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("invalid //go:embed directives:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// Private stuff.
+
+// parseEmbed returns the patterns of a //go:embed directive. Patterns are
+// separated by spaces and may be quoted with Go string syntax.
+func parseEmbed(args string) ([]string, error) {
+	if args != "" && args[0] != ' ' && args[0] != '\t' {
+		return nil, errors.New("invalid //go:embed directive")
+	}
+	var out []string
+	args = strings.TrimSpace(args)
+	for args != "" {
+		var p string
+		switch args[0] {
+		case '"', '`':
+			end := -1
+			for i := 1; i < len(args); i++ {
+				if args[0] == '"' && args[i] == '\\' {
+					i++
+				} else if args[i] == args[0] {
+					end = i
+					break
+				}
+			}
+			if end == -1 {
+				return nil, fmt.Errorf("invalid quoted string in //go:embed: %s", args)
+			}
+			var err error
+			if p, err = strconv.Unquote(args[:end+1]); err != nil {
+				return nil, fmt.Errorf("invalid quoted string in //go:embed: %s", args[:end+1])
+			}
+			args = args[end+1:]
+			if args != "" && args[0] != ' ' && args[0] != '\t' {
+				return nil, fmt.Errorf("invalid quoted string in //go:embed: %s", p)
+			}
+		default:
+			i := strings.IndexAny(args, " \t")
+			if i == -1 {
+				i = len(args)
+			}
+			p, args = args[:i], args[i:]
+		}
+		out = append(out, p)
+		args = strings.TrimSpace(args)
+	}
+	if len(out) == 0 {
+		return nil, errors.New("//go:embed requires at least one pattern")
+	}
+	return out, nil
+}
+
+// checkEmbedPattern returns an error if the pattern p in the directory dir
+// doesn't match any tracked file.
+//
+// A pattern matching a directory embeds the files in it. Files starting with
+// '.' or '_' in such a directory are only embedded with the "all:" prefix.
+func checkEmbedPattern(root, dir, p string, tracked []string) error {
+	all := strings.HasPrefix(p, "all:")
+	p = strings.TrimPrefix(p, "all:")
+	if p == "" || strings.HasPrefix(p, "/") || strings.HasSuffix(p, "/") || strings.Contains(p, "\\") {
+		return fmt.Errorf("invalid pattern \"%s\"", p)
+	}
+	for _, e := range strings.Split(p, "/") {
+		if e == "." || e == ".." || e == "" {
+			return fmt.Errorf("invalid pattern \"%s\"", p)
+		}
+	}
+	if _, err := path.Match(p, ""); err != nil {
+		return fmt.Errorf("invalid pattern \"%s\": %s", p, err)
+	}
+	prefix := ""
+	if dir != "." {
+		prefix = dir + "/"
+	}
+	for _, f := range tracked {
+		if !strings.HasPrefix(f, prefix) {
+			continue
+		}
+		elems := strings.Split(f[len(prefix):], "/")
+		for i := range elems {
+			if ok, _ := path.Match(p, strings.Join(elems[:i+1], "/")); !ok {
+				continue
+			}
+			if i+1 == len(elems) {
+				return nil
+			}
+			// p matched a directory, the file is embedded unless it is hidden.
+			hidden := false
+			for _, e := range elems[i+1:] {
+				if strings.HasPrefix(e, ".") || strings.HasPrefix(e, "_") {
+					hidden = true
+				}
+			}
+			if all || !hidden {
+				return nil
+			}
+		}
+	}
+	matches, _ := filepath.Glob(filepath.Join(root, filepath.FromSlash(prefix+p)))
+	for _, m := range matches {
+		if fi, err := os.Stat(m); err == nil && !fi.IsDir() {
+			return fmt.Errorf("pattern \"%s\" matches files not tracked in git", p)
+		}
+	}
+	return fmt.Errorf("pattern \"%s\" matches no file", p)
+}
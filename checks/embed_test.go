@@ -0,0 +1,60 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestParseEmbed(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		in       string
+		expected []string
+	}{
+		{" a.txt", []string{"a.txt"}},
+		{" a.txt\t b/*.html  all:c", []string{"a.txt", "b/*.html", "all:c"}},
+		{` "a b.txt" ` + "`c d`", []string{"a b.txt", "c d"}},
+		{` "a\"b"`, []string{`a"b`}},
+	}
+	for i, line := range data {
+		actual, err := parseEmbed(line.in)
+		ut.AssertEqualIndex(t, i, nil, err)
+		ut.AssertEqualIndex(t, i, line.expected, actual)
+	}
+	for i, in := range []string{"", " ", "foo", ` "a`, ` "a"b`} {
+		_, err := parseEmbed(in)
+		ut.AssertEqualIndex(t, i, true, err != nil)
+	}
+}
+
+func TestEmbed(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"foo/foo.go":               "package foo\n\nimport _ \"embed\"\n\n//go:embed a.txt static hidden all:hidden2\nvar s string\n",
+		"foo/a.txt":                "a",
+		"foo/static/css/site.css":  "b",
+		"foo/hidden/.keep":         "",
+		"foo/hidden2/_keep":        "",
+		"bar/bar.go":               "package bar\n\n//go:embed *.tmpl untracked.txt ../foo/a.txt\nvar s string\n",
+		"bar/templates/index.tmpl": "",
+	}
+	change := setup(t, td, files)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "src", "foo", "bar", "untracked.txt"), []byte("c"), 0600))
+	expected := "invalid //go:embed directives:\n" +
+		"bar/bar.go:3:1: invalid pattern \"../foo/a.txt\"\n" +
+		"bar/bar.go:3:1: pattern \"*.tmpl\" matches no file\n" +
+		"bar/bar.go:3:1: pattern \"untracked.txt\" matches files not tracked in git\n" +
+		"foo/foo.go:5:1: pattern \"hidden\" matches no file"
+	ut.AssertEqual(t, errors.New(expected), (&Embed{}).Run(change, &Options{}).Err)
+}
@@ -0,0 +1,85 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Environment variable interpolation.
+
+package checks
+
+import (
+	"reflect"
+	"regexp"
+)
+
+// ExpandEnv replaces "${VAR}" and "${VAR:-default}" in all the string fields
+// of the configuration, including the options of the checks. lookup is
+// usually os.LookupEnv.
+//
+// An unset variable without default is replaced with an empty string. The
+// default is used when the variable is unset or empty. "$${" is replaced with
+// a literal "${". Other "$" are left as is, e.g. "$1" in a command.
+func (c *Config) ExpandEnv(lookup func(key string) (string, bool)) {
+	expandValue(reflect.ValueOf(c), lookup)
+}
+
+// Private stuff.
+
+var reEnvVar = regexp.MustCompile(`\$\$\{|\$\{([A-Za-z_][A-Za-z0-9_]*)(?::-([^}]*))?\}`)
+
+// expandEnv expands the variables in s.
+func expandEnv(s string, lookup func(key string) (string, bool)) string {
+	return reEnvVar.ReplaceAllStringFunc(s, func(m string) string {
+		if m == "$${" {
+			return "${"
+		}
+		items := reEnvVar.FindStringSubmatch(m)
+		if v, ok := lookup(items[1]); ok && v != "" {
+			return v
+		}
+		return items[2]
+	})
+}
+
+// expandValue expands the strings in v recursively.
+func expandValue(v reflect.Value, lookup func(key string) (string, bool)) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			expandValue(v.Elem(), lookup)
+		}
+	case reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if e := v.Elem(); e.Kind() == reflect.Ptr {
+			expandValue(e, lookup)
+		} else if v.CanSet() {
+			// Values stored in an interface are not addressable.
+			c := reflect.New(e.Type()).Elem()
+			c.Set(e)
+			expandValue(c, lookup)
+			v.Set(c)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if f := v.Field(i); f.CanSet() {
+				expandValue(f, lookup)
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			expandValue(v.Index(i), lookup)
+		}
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			c := reflect.New(v.Type().Elem()).Elem()
+			c.Set(v.MapIndex(k))
+			expandValue(c, lookup)
+			v.SetMapIndex(k, c)
+		}
+	case reflect.String:
+		if v.CanSet() {
+			v.SetString(expandEnv(v.String(), lookup))
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestExpandEnv(t *testing.T) {
+	t.Parallel()
+	env := map[string]string{"PROXY": "https://proxy", "EMPTY": ""}
+	lookup := func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+	data := []struct {
+		in       string
+		expected string
+	}{
+		{"GOPROXY=${PROXY}", "GOPROXY=https://proxy"},
+		{"${MISSING}", ""},
+		{"${MISSING:-direct}", "direct"},
+		{"${EMPTY:-direct}", "direct"},
+		{"${PROXY:-direct}/${PROXY}", "https://proxy/https://proxy"},
+		{"$${PROXY} $1 $PROXY", "${PROXY} $1 $PROXY"},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, expandEnv(line.in, lookup))
+	}
+}
+
+func TestConfigExpandEnv(t *testing.T) {
+	t.Parallel()
+	lookup := func(key string) (string, bool) {
+		if key == "DIR" {
+			return "/opt", true
+		}
+		return "", false
+	}
+	config := &Config{
+		Modes: map[Mode]Settings{
+			PreCommit: {
+				Checks: Checks{
+					"custom": {&Custom{Command: []string{"${DIR}/lint", "$1"}}},
+					"test":   {&Test{Limits: Limits{Paths: []string{"${DIR:-pkg}/**"}}, ExtraArgs: []string{"-tags=${TAGS:-ci}"}}},
+				},
+			},
+		},
+		IgnorePatterns: []string{"${DIR}"},
+		Prerequisites:  map[string]string{"errcheck": "${ERRCHECK:-v1}"},
+	}
+	config.ExpandEnv(lookup)
+	ut.AssertEqual(t, &Custom{Command: []string{"/opt/lint", "$1"}}, config.Modes[PreCommit].Checks["custom"][0])
+	ut.AssertEqual(t, &Test{Limits: Limits{Paths: []string{"/opt/**"}}, ExtraArgs: []string{"-tags=ci"}}, config.Modes[PreCommit].Checks["test"][0])
+	ut.AssertEqual(t, []string{"/opt"}, config.IgnorePatterns)
+	ut.AssertEqual(t, map[string]string{"errcheck": "v1"}, config.Prerequisites)
+}
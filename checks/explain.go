@@ -0,0 +1,91 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// explain describes how the checks run and how to fix their failures.
+
+package checks
+
+import (
+	"os/exec"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+// Remediation returns how to fix the usual failures of the check named name.
+func Remediation(name string) string {
+	if r, ok := remediations[name]; ok {
+		return r
+	}
+	return "read the error message; the options of the check are documented in CONFIGURATION.md"
+}
+
+// Version returns the version of the installed prerequisite, "" if it is not
+// installed. For tools installed with `go get`, it is the version of the
+// module recorded in the binary, otherwise the first line of HelpCommand
+// mentioning a version, otherwise "installed".
+func (c *CheckPrerequisite) Version() string {
+	out, exitCode, _ := internal.Capture(cwd, nil, c.HelpCommand...)
+	if exitCode != c.ExpectedExitCode {
+		return ""
+	}
+	if len(c.HelpCommand) == 0 {
+		return "installed"
+	}
+	if p, err := exec.LookPath(c.HelpCommand[0]); err == nil {
+		if info, code, _ := internal.Capture(cwd, nil, "go", "version", "-m", p); code == 0 {
+			for _, line := range strings.Split(info, "\n") {
+				// "\tmod\tgithub.com/kisielk/errcheck\tv1.6.3\th1:..."
+				if items := strings.Fields(line); len(items) >= 3 && items[0] == "mod" {
+					return items[2]
+				}
+			}
+		}
+	}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(strings.ToLower(line), "version") {
+			return strings.TrimSpace(line)
+		}
+	}
+	return "installed"
+}
+
+// Private stuff.
+
+// remediations is the common fix for the failures of each check.
+var remediations = map[string]string{
+	"asmfmt":        "run 'pcg fix' or 'asmfmt -w' on the files listed",
+	"astrule":       "rewrite the code matching the rule as suggested by its message",
+	"boundaries":    "move the code or the import so the layering rules are respected, or update the rules",
+	"build":         "fix the compilation errors; the command above reproduces them",
+	"buildtags":     "make //go:build and // +build lines agree, e.g. with 'go fix'",
+	"clock":         "take a clock as a parameter instead of calling time.Now() directly",
+	"commitmsg":     "amend the commit message with 'git commit --amend'",
+	"configlint":    "fix the syntax error at the position reported",
+	"copyright":     "add the configured header at the top of the files listed",
+	"copyrightyear": "update the year of the copyright header of the files listed",
+	"coverage":      "add tests for the functions listed or lower min_coverage",
+	"custom":        "see the documentation of the custom command",
+	"embed":         "fix the //go:embed patterns or track the files with 'git add'",
+	"errcheck":      "handle the returned errors, or assign them to _ explicitly",
+	"generated":     "run 'go generate' and commit the result",
+	"godirective":   "use the same go directive in all the go.mod files",
+	"gofmt":         "run 'pcg fix' or 'gofmt -w -s' on the files listed",
+	"goimports":     "run 'pcg fix' or 'goimports -w' on the files listed",
+	"golangci-lint": "fix the issues listed or tune the linters in the golangci-lint configuration",
+	"golint":        "fix the issues listed or add the message to blacklist",
+	"gosum":         "run 'go mod tidy' and commit go.sum",
+	"govet":         "fix the issues listed or add the message to blacklist",
+	"hadolint":      "fix the issues listed or add the rule to ignore",
+	"length":        "split the long lines, functions or files listed",
+	"markdown":      "fix the style issues listed",
+	"modreplace":    "remove the replace directives pointing to local paths",
+	"naming":        "rename the files or packages listed",
+	"shellcheck":    "fix the issues listed or disable them with a '# shellcheck disable=' comment",
+	"spelling":      "fix the typos or add the words to the dictionary",
+	"sqlvet":        "fix the SQL queries listed",
+	"stalebranch":   "rebase on the upstream branch",
+	"test":          "fix the failing tests; -v on the command above prints more details",
+	"testhygiene":   "follow the test conventions listed",
+}
@@ -0,0 +1,40 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestCommands(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{
+		"foo.go":      "package foo\n",
+		"foo_test.go": "package foo\n",
+		"run.sh":      "#!/bin/sh\n",
+	})
+	options := &Options{MaxDuration: 5}
+	ut.AssertEqual(t, [][]string{{"go", "build", "-o", os.DevNull, "-race", "."}}, (&Build{ExtraArgs: []string{"-race"}}).Commands(change, options))
+	ut.AssertEqual(t, [][]string{{"go", "test", "-timeout", "5s", "-short", "."}}, (&Test{ExtraArgs: []string{"-short"}}).Commands(change, options))
+	ut.AssertEqual(t, [][]string{{"golint", "."}}, (&Golint{}).Commands(change, options))
+	ut.AssertEqual(t, [][]string{{"shellcheck", "run.sh"}}, (&Shellcheck{}).Commands(change, options))
+	ut.AssertEqual(t, [][]string(nil), (&Hadolint{}).Commands(change, options))
+	ut.AssertEqual(t, [][]string{{"foo", "bar"}}, (&Custom{Command: []string{"foo", "bar"}}).Commands(change, options))
+}
+
+func TestRemediation(t *testing.T) {
+	t.Parallel()
+	for name := range KnownChecks {
+		ut.AssertEqualf(t, true, remediations[name] != "", "%s", name)
+	}
+	ut.AssertEqual(t, true, strings.Contains(Remediation("foo"), "CONFIGURATION.md"))
+}
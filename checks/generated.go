@@ -0,0 +1,219 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// generated verifies committed generated files are up to date.
+
+package checks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Generated reruns code generators in a temporary copy of the tree and fails
+// when the committed generated files do not match their output.
+type Generated struct {
+	Limits `yaml:",inline"`
+
+	Generators []Generator `yaml:"generators"`
+}
+
+// Generator is a code generator used by Generated, e.g. protoc, stringer or
+// mockgen.
+type Generator struct {
+	// Name is the generator name, used in error messages.
+	Name string `yaml:"name"`
+	// Command is the command to run from the root of the temporary tree,
+	// required. GOPATH is set so the temporary tree is at the same import path
+	// as the repository.
+	Command []string `yaml:"command"`
+	// Outputs is the list of glob patterns of the generated files, e.g.
+	// "*.pb.go", required. A pattern without '/' is matched against the file
+	// name, otherwise against the path relative to the repository root. These
+	// files are deleted from the temporary tree before running Command.
+	Outputs []string `yaml:"outputs"`
+	// Inputs is the list of glob patterns of the source files, e.g. "*.proto".
+	// When set, the generator is only run when a file matching Inputs or
+	// Outputs is modified. Otherwise it is always run.
+	Inputs []string `yaml:"inputs"`
+	// Prerequisites are the generator's prerequisite packages to install first.
+	Prerequisites []CheckPrerequisite `yaml:"prerequisites"`
+}
+
+// GetDescription implements Check.
+func (g *Generated) GetDescription() string {
+	return "enforces generated files are up to date"
+}
+
+// GetName implements Check.
+func (g *Generated) GetName() string {
+	return "generated"
+}
+
+// GetPrerequisites implements Check.
+func (g *Generated) GetPrerequisites() []CheckPrerequisite {
+	var out []CheckPrerequisite
+	for _, gen := range g.Generators {
+		out = append(out, gen.Prerequisites...)
+	}
+	return out
+}
+
+// Run implements Check.
+func (g *Generated) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), ParseIssues)
+}
+
+func (g *Generated) run(change scm.Change, options *Options) (err error) {
+	var generators []*Generator
+	for i := range g.Generators {
+		gen := &g.Generators[i]
+		if len(gen.Command) == 0 || len(gen.Outputs) == 0 {
+			return fmt.Errorf("generator \"%s\" requires command and outputs", gen.Name)
+		}
+		if gen.isAffected(change) {
+			generators = append(generators, gen)
+		}
+	}
+	if len(generators) == 0 {
+		return nil
+	}
+	isOutput := func(f string) bool {
+		for _, gen := range generators {
+			if matchAny(gen.Outputs, f) {
+				return true
+			}
+		}
+		return false
+	}
+
+	tmpDir, err := ioutil.TempDir("", "pre-commit-go")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err2 := internal.RemoveAll(tmpDir); err == nil {
+			err = err2
+		}
+	}()
+	root, gopath := tempTree(tmpDir, change.Repo())
+
+	// Copy the tree without the generated files.
+	var committed []string
+	for _, f := range change.All().Files() {
+		if isOutput(f) {
+			committed = append(committed, f)
+			continue
+		}
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		p := filepath.Join(root, filepath.FromSlash(f))
+		if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(p, content, 0600); err != nil {
+			return err
+		}
+	}
+
+	var bad []string
+	for _, gen := range generators {
+		out, exitCode, err := internal.CaptureContext(options.context(), options.procDir(root), options.procEnv("GOPATH="+gopath), options.procArgs(gen.Command)...)
+		if exitCode != 0 || err != nil {
+			bad = append(bad, fmt.Sprintf("generator \"%s\" failed with code %d: %v\n%s", gen.Name, exitCode, err, out))
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New(strings.Join(bad, "\n"))
+	}
+
+	generated := map[string]bool{}
+	err = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		f := filepath.ToSlash(rel)
+		if !isOutput(f) {
+			return nil
+		}
+		generated[f] = true
+		actual, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		if expected := change.Content(f); expected == nil {
+			bad = append(bad, fmt.Sprintf("%s: generated but not committed", f))
+		} else if !bytes.Equal(expected, actual) {
+			bad = append(bad, fmt.Sprintf("%s: out of date", f))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, f := range committed {
+		if !generated[f] {
+			bad = append(bad, fmt.Sprintf("%s: committed but not generated anymore", f))
+		}
+	}
+	if len(bad) != 0 {
+		sort.Strings(bad)
+		return errors.New("generated files do not match the generators output, please regenerate them:\n" + strings.Join(bad, "\n"))
+	}
+	return nil
+}
+
+// isAffected returns true if the generator must be run for this change.
+func (g *Generator) isAffected(change scm.Change) bool {
+	if len(g.Inputs) == 0 {
+		return true
+	}
+	for _, f := range change.Changed().Files() {
+		if matchAny(g.Inputs, f) || matchAny(g.Outputs, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// Private stuff.
+
+// matchAny returns true if f matches one of the glob patterns, as defined by
+// matchPattern.
+func matchAny(patterns []string, f string) bool {
+	for _, pattern := range patterns {
+		if matchPattern(pattern, f) {
+			return true
+		}
+	}
+	return false
+}
+
+// tempTree returns the directory in tmpDir where to copy the repository and
+// the GOPATH to use. When the repository is inside GOPATH, the copy keeps the
+// same import path.
+func tempTree(tmpDir string, repo scm.ReadOnlyRepo) (string, string) {
+	for _, gopath := range filepath.SplitList(repo.GOPATH()) {
+		rel, err := filepath.Rel(filepath.Join(gopath, "src"), repo.Root())
+		if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return filepath.Join(tmpDir, "src", rel), tmpDir + string(filepath.ListSeparator) + repo.GOPATH()
+		}
+	}
+	return filepath.Join(tmpDir, "tree"), repo.GOPATH()
+}
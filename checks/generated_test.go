@@ -0,0 +1,74 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestGenerated(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		files    map[string]string
+		expected error
+	}{
+		{
+			map[string]string{"a.in": "a", "a.gen": "a"},
+			nil,
+		},
+		{
+			map[string]string{"a.in": "a", "a.gen": "b"},
+			errors.New("generated files do not match the generators output, please regenerate them:\na.gen: out of date"),
+		},
+		{
+			map[string]string{"a.in": "a"},
+			errors.New("generated files do not match the generators output, please regenerate them:\na.gen: generated but not committed"),
+		},
+		{
+			map[string]string{"a.in": "a", "a.gen": "a", "b.gen": "b"},
+			errors.New("generated files do not match the generators output, please regenerate them:\nb.gen: committed but not generated anymore"),
+		},
+	}
+	for i, line := range data {
+		td, cleanup := internal.TempDir(t)
+		change := setup(t, td, line.files)
+		g := &Generated{
+			Generators: []Generator{
+				{Name: "copy", Command: []string{"cp", "a.in", "a.gen"}, Outputs: []string{"*.gen"}},
+			},
+		}
+		ut.AssertEqualIndex(t, i, line.expected, g.Run(change, &Options{}).Err)
+		cleanup()
+	}
+}
+
+func TestGeneratedInputs(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{"foo.go": "package foo\n"})
+	// Not run since no input is modified.
+	g := &Generated{
+		Generators: []Generator{
+			{Name: "fail", Command: []string{"go", "invalid"}, Outputs: []string{"*.pb.go"}, Inputs: []string{"*.proto"}},
+		},
+	}
+	ut.AssertEqual(t, nil, g.Run(change, &Options{}).Err)
+}
+
+func TestTempTree(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, map[string]string{"foo.go": "package foo\n"})
+	root, gopath := tempTree("tmp", change.Repo())
+	ut.AssertEqual(t, filepath.Join("tmp", "src", "foo"), root)
+	ut.AssertEqual(t, "tmp"+string(filepath.ListSeparator)+td, gopath)
+}
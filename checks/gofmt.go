@@ -0,0 +1,361 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// In process formatting of Go sources, like 'gofmt -s'.
+
+package checks
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// formatSource returns src formatted like 'gofmt -s' does.
+func formatSource(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, err
+	}
+	removeEmptyDecls(f)
+	ast.Walk(simplifier{}, f)
+	b := &bytes.Buffer{}
+	if err := format.Node(b, fset, f); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// formattedFile is a file which content is not formatted.
+type formattedFile struct {
+	name      string
+	src       []byte
+	formatted []byte
+}
+
+// formatFiles returns the files in root not formatted, and the errors of the
+// files that can't be read or parsed, keeping the order of files.
+//
+// The files are read from the disk instead of scm.Change.Content() since it
+// caches the content, which is stale once fixed.
+func formatFiles(root string, files []string) ([]formattedFile, []string) {
+	type outcome struct {
+		src       []byte
+		formatted []byte
+		err       error
+	}
+	outcomes := make([]outcome, len(files))
+	c := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range c {
+				src, err := ioutil.ReadFile(filepath.Join(root, files[j]))
+				if err != nil {
+					outcomes[j].err = err
+					continue
+				}
+				out, err := formatSource(src)
+				if err == nil && !bytes.Equal(src, out) {
+					outcomes[j].src = src
+					outcomes[j].formatted = out
+				}
+				outcomes[j].err = err
+			}
+		}()
+	}
+	for i := range files {
+		c <- i
+	}
+	close(c)
+	wg.Wait()
+	var out []formattedFile
+	var errs []string
+	for i, o := range outcomes {
+		if o.err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", files[i], o.err))
+		} else if o.formatted != nil {
+			out = append(out, formattedFile{files[i], o.src, o.formatted})
+		}
+	}
+	return out, errs
+}
+
+// Private stuff.
+
+// removeEmptyDecls removes the empty declaration groups without comments,
+// e.g. "var ()".
+func removeEmptyDecls(f *ast.File) {
+	decls := f.Decls[:0]
+	for _, d := range f.Decls {
+		if g, ok := d.(*ast.GenDecl); ok && g.Doc == nil && len(g.Specs) == 0 && !hasComment(f, g) {
+			continue
+		}
+		decls = append(decls, d)
+	}
+	f.Decls = decls
+}
+
+// hasComment returns true if a comment is within n.
+func hasComment(f *ast.File, n ast.Node) bool {
+	for _, c := range f.Comments {
+		if n.Pos() <= c.Pos() && c.End() <= n.End() {
+			return true
+		}
+	}
+	return false
+}
+
+// simplifier applies the code simplifications of 'gofmt -s'.
+type simplifier struct{}
+
+func (s simplifier) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.CompositeLit:
+		// The type of the elements of an array, slice or map literal is implied:
+		// []T{T{}} becomes []T{{}} and []*T{&T{}} becomes []*T{{}}.
+		var keyType, eltType ast.Expr
+		switch t := n.Type.(type) {
+		case *ast.ArrayType:
+			eltType = t.Elt
+		case *ast.MapType:
+			keyType = t.Key
+			eltType = t.Value
+		}
+		if eltType == nil {
+			return s
+		}
+		if n.Type != nil {
+			ast.Walk(s, n.Type)
+		}
+		for i, x := range n.Elts {
+			px := &n.Elts[i]
+			if kv, ok := x.(*ast.KeyValueExpr); ok {
+				if keyType != nil {
+					s.simplifyElement(keyType, kv.Key, &kv.Key)
+				} else {
+					ast.Walk(s, kv.Key)
+				}
+				x, px = kv.Value, &kv.Value
+			}
+			s.simplifyElement(eltType, x, px)
+		}
+		// The elements were already walked.
+		return nil
+
+	case *ast.SliceExpr:
+		// s[a:len(s)] becomes s[a:]. Only identifiers are simplified, since
+		// evaluating another expression twice may have side effects. A redeclared
+		// len is not detected, like gofmt.
+		if n.Max != nil {
+			return s
+		}
+		if id, ok := n.X.(*ast.Ident); ok {
+			if call, ok := n.High.(*ast.CallExpr); ok && len(call.Args) == 1 && !call.Ellipsis.IsValid() {
+				fun, ok1 := call.Fun.(*ast.Ident)
+				arg, ok2 := call.Args[0].(*ast.Ident)
+				if ok1 && ok2 && fun.Name == "len" && arg.Name == id.Name {
+					n.High = nil
+				}
+			}
+		}
+
+	case *ast.RangeStmt:
+		// for x, _ = range v becomes for x = range v and for _ = range v becomes
+		// for range v.
+		if isBlank(n.Value) {
+			n.Value = nil
+		}
+		if isBlank(n.Key) && n.Value == nil {
+			n.Key = nil
+		}
+	}
+	return s
+}
+
+// simplifyElement simplifies the element x of a composite literal, which
+// type is typ, then removes its type if implied. px points to x.
+func (s simplifier) simplifyElement(typ, x ast.Expr, px *ast.Expr) {
+	ast.Walk(s, x)
+	if lit, ok := x.(*ast.CompositeLit); ok && sameType(lit.Type, typ) {
+		lit.Type = nil
+		return
+	}
+	if star, ok := typ.(*ast.StarExpr); ok {
+		if addr, ok := x.(*ast.UnaryExpr); ok && addr.Op == token.AND {
+			if lit, ok := addr.X.(*ast.CompositeLit); ok && sameType(lit.Type, star.X) {
+				lit.Type = nil
+				*px = lit
+			}
+		}
+	}
+}
+
+// sameType returns true if the type expressions a and b are written the same.
+func sameType(a, b ast.Expr) bool {
+	return a != nil && b != nil && types.ExprString(a) == types.ExprString(b)
+}
+
+func isBlank(x ast.Expr) bool {
+	id, ok := x.(*ast.Ident)
+	return ok && id.Name == "_"
+}
+
+// diffContext is the number of unmodified lines around the changes in a
+// diff.
+const diffContext = 3
+
+// maxDiffCells bounds the memory used to diff the part of two files that
+// differs; above it, the whole part is printed as replaced.
+const maxDiffCells = 4 << 20
+
+// unifiedDiff returns the diff of the content of name from a to b, in the
+// format of 'gofmt -d'.
+func unifiedDiff(name string, a, b []byte) string {
+	x := splitLines(a)
+	y := splitLines(b)
+	// Only diff the part that differs; formatting changes are usually local.
+	pre := 0
+	for pre < len(x) && pre < len(y) && x[pre] == y[pre] {
+		pre++
+	}
+	suf := 0
+	for suf < len(x)-pre && suf < len(y)-pre && x[len(x)-1-suf] == y[len(y)-1-suf] {
+		suf++
+	}
+	// ops is the edit script: ' ' kept, '-' removed from a, '+' added from b.
+	type op struct {
+		kind byte
+		line string
+	}
+	var ops []op
+	for _, l := range x[:pre] {
+		ops = append(ops, op{' ', l})
+	}
+	mx, my := x[pre:len(x)-suf], y[pre:len(y)-suf]
+	if len(mx)*len(my) > maxDiffCells {
+		for _, l := range mx {
+			ops = append(ops, op{'-', l})
+		}
+		for _, l := range my {
+			ops = append(ops, op{'+', l})
+		}
+	} else {
+		// lcs[i][j] is the length of the longest common subsequence of mx[i:]
+		// and my[j:].
+		lcs := make([][]int, len(mx)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(my)+1)
+		}
+		for i := len(mx) - 1; i >= 0; i-- {
+			for j := len(my) - 1; j >= 0; j-- {
+				if mx[i] == my[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else if lcs[i+1][j] >= lcs[i][j+1] {
+					lcs[i][j] = lcs[i+1][j]
+				} else {
+					lcs[i][j] = lcs[i][j+1]
+				}
+			}
+		}
+		i, j := 0, 0
+		for i < len(mx) || j < len(my) {
+			switch {
+			case i < len(mx) && j < len(my) && mx[i] == my[j]:
+				ops = append(ops, op{' ', mx[i]})
+				i++
+				j++
+			case j == len(my) || (i < len(mx) && lcs[i+1][j] >= lcs[i][j+1]):
+				ops = append(ops, op{'-', mx[i]})
+				i++
+			default:
+				ops = append(ops, op{'+', my[j]})
+				j++
+			}
+		}
+	}
+	for _, l := range x[len(x)-suf:] {
+		ops = append(ops, op{' ', l})
+	}
+
+	out := &bytes.Buffer{}
+	fmt.Fprintf(out, "diff %s.orig %s\n--- %s.orig\n+++ %s\n", name, name, name, name)
+	// Group the changes closer than twice the context in hunks.
+	for start := 0; start < len(ops); {
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		end := start
+		for k := start; k < len(ops); k++ {
+			if ops[k].kind != ' ' {
+				end = k + 1
+			} else if k-end >= 2*diffContext {
+				break
+			}
+		}
+		from := start - diffContext
+		if from < 0 {
+			from = 0
+		}
+		to := end + diffContext
+		if to > len(ops) {
+			to = len(ops)
+		}
+		// Line numbers of the first line of the hunk in a and b, 1-based.
+		la, lb := 1, 1
+		for _, o := range ops[:from] {
+			if o.kind != '+' {
+				la++
+			}
+			if o.kind != '-' {
+				lb++
+			}
+		}
+		na, nb := 0, 0
+		for _, o := range ops[from:to] {
+			if o.kind != '+' {
+				na++
+			}
+			if o.kind != '-' {
+				nb++
+			}
+		}
+		if na == 0 {
+			la--
+		}
+		if nb == 0 {
+			lb--
+		}
+		fmt.Fprintf(out, "@@ -%d,%d +%d,%d @@\n", la, na, lb, nb)
+		for _, o := range ops[from:to] {
+			fmt.Fprintf(out, "%c%s\n", o.kind, o.line)
+		}
+		start = to
+	}
+	return out.String()
+}
+
+// splitLines splits b in lines, without the line terminators.
+func splitLines(b []byte) []string {
+	s := string(b)
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
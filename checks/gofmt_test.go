@@ -0,0 +1,79 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestFormatSource(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		in       string
+		expected string
+	}{
+		{"package foo\n", "package foo\n"},
+		{"package foo\nfunc  f() {\n}\n", "package foo\n\nfunc f() {\n}\n"},
+		{
+			"package foo\n\nvar a = []T{T{1}, T{2}}\n",
+			"package foo\n\nvar a = []T{{1}, {2}}\n",
+		},
+		{
+			"package foo\n\nvar a = []*T{&T{1}}\n",
+			"package foo\n\nvar a = []*T{{1}}\n",
+		},
+		{
+			"package foo\n\nvar a = map[K]V{K{1}: V{2}}\n",
+			"package foo\n\nvar a = map[K]V{{1}: {2}}\n",
+		},
+		{
+			"package foo\n\nvar a = [][]T{[]T{T{1}}}\n",
+			"package foo\n\nvar a = [][]T{{{1}}}\n",
+		},
+		{
+			"package foo\n\nvar ()\n\nconst (\n// Kept.\n)\n",
+			"package foo\n\nconst (\n// Kept.\n)\n",
+		},
+		{
+			// Different types are kept.
+			"package foo\n\nvar a = []I{T{1}}\n",
+			"package foo\n\nvar a = []I{T{1}}\n",
+		},
+		{
+			"package foo\n\nfunc f(s []int) []int {\n\treturn s[1:len(s)]\n}\n",
+			"package foo\n\nfunc f(s []int) []int {\n\treturn s[1:]\n}\n",
+		},
+		{
+			"package foo\n\nfunc f(s, t []int) []int {\n\treturn s[1:len(t)]\n}\n",
+			"package foo\n\nfunc f(s, t []int) []int {\n\treturn s[1:len(t)]\n}\n",
+		},
+		{
+			"package foo\n\nfunc f(s []int) {\n\tfor i, _ := range s {\n\t\t_ = i\n\t}\n\tfor _ = range s {\n\t}\n}\n",
+			"package foo\n\nfunc f(s []int) {\n\tfor i := range s {\n\t\t_ = i\n\t}\n\tfor range s {\n\t}\n}\n",
+		},
+	}
+	for i, line := range data {
+		out, err := formatSource([]byte(line.in))
+		ut.AssertEqualIndex(t, i, nil, err)
+		ut.AssertEqualIndex(t, i, line.expected, string(out))
+	}
+	_, err := formatSource([]byte("package foo\nfunc {\n"))
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	t.Parallel()
+	a := "package foo\n\n1\n2\n3\n4\nfunc  f() {\n}\n5\n6\n7\n8\n9\n10\n11\n12\nfunc  g() {\n}\n"
+	b := "package foo\n\n1\n2\n3\n4\nfunc f() {\n}\n5\n6\n7\n8\n9\n10\n11\n12\nfunc g() {\n}\n"
+	expected := "diff a.go.orig a.go\n--- a.go.orig\n+++ a.go\n" +
+		"@@ -4,7 +4,7 @@\n 2\n 3\n 4\n-func  f() {\n+func f() {\n }\n 5\n 6\n" +
+		"@@ -14,5 +14,5 @@\n 10\n 11\n 12\n-func  g() {\n+func g() {\n }\n"
+	ut.AssertEqual(t, expected, unifiedDiff("a.go", []byte(a), []byte(b)))
+
+	expected = "diff a.go.orig a.go\n--- a.go.orig\n+++ a.go\n@@ -1,2 +1,3 @@\n package foo\n+\n func f()\n"
+	ut.AssertEqual(t, expected, unifiedDiff("a.go", []byte("package foo\nfunc f()\n"), []byte("package foo\n\nfunc f()\n")))
+}
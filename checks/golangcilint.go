@@ -0,0 +1,158 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// golangcilint wraps golangci-lint.
+
+package checks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// GolangciLint runs golangci-lint with the project's existing configuration,
+// usually .golangci.yml, and reports its findings on the modified files.
+//
+// It lets teams already invested in golangci-lint use pre-commit-go only as
+// the hook and orchestration layer.
+type GolangciLint struct {
+	Limits `yaml:",inline"`
+
+	// Config is the path of the golangci-lint configuration file, relative to
+	// the repository root. Defaults to golangci-lint's own lookup, e.g.
+	// .golangci.yml.
+	Config string `yaml:"config"`
+	// ExtraArgs are passed to "golangci-lint run", e.g.
+	// []string{"--enable", "gosec"}.
+	ExtraArgs []string `yaml:"extra_args"`
+}
+
+// GetDescription implements Check.
+func (g *GolangciLint) GetDescription() string {
+	return "enforces all .go sources pass 'golangci-lint run'"
+}
+
+// GetName implements Check.
+func (g *GolangciLint) GetName() string {
+	return "golangci-lint"
+}
+
+// GetPrerequisites implements Check.
+func (g *GolangciLint) GetPrerequisites() []CheckPrerequisite {
+	return []CheckPrerequisite{
+		{[]string{"golangci-lint", "--version"}, 0, "", "see https://golangci-lint.run/welcome/install/, e.g. brew install golangci-lint"},
+	}
+}
+
+// Run implements Check.
+func (g *GolangciLint) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), ParseIssues)
+}
+
+func (g *GolangciLint) run(change scm.Change, options *Options) error {
+	pkgs := change.Changed().Packages()
+	if len(pkgs) == 0 {
+		return nil
+	}
+	version, _, err := capture(options, change.Repo(), "golangci-lint", "--version")
+	if err != nil {
+		return fmt.Errorf("golangci-lint failed: %s", err)
+	}
+	args := g.args(version, pkgs)
+	// Returns 1 when issues are found.
+	out, exitCode, err := capture(options, change.Repo(), args...)
+	if err != nil {
+		return fmt.Errorf("golangci-lint failed: %s", err)
+	}
+	issues, err := parseGolangciLint(out)
+	if err != nil {
+		if exitCode != 0 {
+			return fmt.Errorf("golangci-lint failed with code %d:\n%s", exitCode, out)
+		}
+		return err
+	}
+	files := map[string]bool{}
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		files[f] = true
+	}
+	var bad []string
+	for _, i := range issues {
+		f := i.Pos.Filename
+		if !files[f] || !options.isOwned(change, f, i.Pos.Line) {
+			continue
+		}
+		msg := fmt.Sprintf("%s (%s)", i.Text, i.FromLinter)
+		if options.isBaselined(g, f, msg) {
+			continue
+		}
+		bad = append(bad, fmt.Sprintf("%s:%d:%d: %s", f, i.Pos.Line, i.Pos.Column, msg))
+	}
+	if len(bad) != 0 {
+		sort.Strings(bad)
+		return errors.New("golangci-lint failed:\n" + strings.Join(bad, "\n"))
+	}
+	if exitCode > 1 {
+		return fmt.Errorf("golangci-lint failed with code %d:\n%s", exitCode, out)
+	}
+	return nil
+}
+
+// Commands implements Commander.
+func (g *GolangciLint) Commands(change scm.Change, options *Options) [][]string {
+	pkgs := change.Changed().Packages()
+	if len(pkgs) == 0 {
+		return nil
+	}
+	version, _, _ := capture(options, change.Repo(), "golangci-lint", "--version")
+	return [][]string{g.args(version, pkgs)}
+}
+
+// args returns the command line to run golangci-lint version on pkgs.
+func (g *GolangciLint) args(version string, pkgs []string) []string {
+	// The flag to select the output format changed in v2.
+	args := []string{"golangci-lint", "run", "--out-format=json"}
+	if strings.Contains(version, "version 2.") {
+		args = []string{"golangci-lint", "run", "--output.json.path=stdout", "--output.text.path=stderr"}
+	}
+	if g.Config != "" {
+		args = append(args, "--config", g.Config)
+	}
+	return append(append(args, g.ExtraArgs...), pkgs...)
+}
+
+// Private stuff.
+
+// golangciIssue is an issue in golangci-lint's JSON output.
+type golangciIssue struct {
+	FromLinter string
+	Text       string
+	Pos        struct {
+		Filename string
+		Line     int
+		Column   int
+	}
+}
+
+// parseGolangciLint returns the issues in golangci-lint's output. The JSON
+// report is on a single line, which may be mixed with log lines.
+func parseGolangciLint(out string) ([]golangciIssue, error) {
+	for _, line := range strings.Split(out, "\n") {
+		if !strings.HasPrefix(line, "{") {
+			continue
+		}
+		var report struct {
+			Issues []golangciIssue
+		}
+		if err := json.Unmarshal([]byte(line), &report); err != nil {
+			return nil, fmt.Errorf("failed to parse golangci-lint output: %s", err)
+		}
+		return report.Issues, nil
+	}
+	return nil, errors.New("failed to find golangci-lint JSON report")
+}
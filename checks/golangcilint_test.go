@@ -0,0 +1,32 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestParseGolangciLint(t *testing.T) {
+	t.Parallel()
+	out := "level=warning msg=\"[runner] foo\"\n" +
+		`{"Issues":[{"FromLinter":"errcheck","Text":"Error return value is not checked","Pos":{"Filename":"foo/foo.go","Offset":10,"Line":3,"Column":2}}],"Report":{}}` + "\n"
+	issues, err := parseGolangciLint(out)
+	ut.AssertEqual(t, nil, err)
+	expected := golangciIssue{FromLinter: "errcheck", Text: "Error return value is not checked"}
+	expected.Pos.Filename = "foo/foo.go"
+	expected.Pos.Line = 3
+	expected.Pos.Column = 2
+	ut.AssertEqual(t, []golangciIssue{expected}, issues)
+
+	issues, err = parseGolangciLint(`{"Issues":null}`)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []golangciIssue(nil), issues)
+
+	_, err = parseGolangciLint("Error: can't load config\n")
+	ut.AssertEqual(t, errors.New("failed to find golangci-lint JSON report"), err)
+}
@@ -0,0 +1,472 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Checks on go.mod files.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// ModReplace enforces go.mod files do not contain replace directives pointing
+// to a local path.
+//
+// These are routinely committed by accident and break the build for everyone
+// else.
+type ModReplace struct {
+	Limits `yaml:",inline"`
+
+	// Allow is the list of module paths that may be replaced with a local path.
+	Allow []string `yaml:"allow"`
+}
+
+// GetDescription implements Check.
+func (m *ModReplace) GetDescription() string {
+	return "enforces go.mod files have no replace directive to a local path"
+}
+
+// GetName implements Check.
+func (m *ModReplace) GetName() string {
+	return "modreplace"
+}
+
+// GetPrerequisites implements Check.
+func (m *ModReplace) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (m *ModReplace) Run(change scm.Change, options *Options) Result {
+	return newResult(m.run(change, options), ParseIssues)
+}
+
+func (m *ModReplace) run(change scm.Change, options *Options) error {
+	allowed := map[string]bool{}
+	for _, a := range m.Allow {
+		allowed[a] = true
+	}
+	var bad []string
+	for _, f := range goModFiles(change.Changed().Files()) {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		mod, err := parseGoMod(content)
+		if err != nil {
+			bad = append(bad, fmt.Sprintf("%s: %s", f, err))
+			continue
+		}
+		for _, r := range mod.replaces {
+			if isLocalModPath(r.newPath) && !allowed[r.oldPath] {
+				bad = append(bad, fmt.Sprintf("%s:%d: %s => %s", f, r.line, r.oldPath, r.newPath))
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("go.mod files have replace directives to local paths:\n" + strings.Join(bad, "\n"))
+	}
+	return nil
+}
+
+// GoSum enforces the go.sum files are complete and match the module cache.
+//
+// It runs "go mod verify" and loads the packages with -mod=readonly in each
+// module containing a modified file, which catches tampered or hand edited
+// sums and missing entries before they land.
+type GoSum struct {
+	Limits `yaml:",inline"`
+}
+
+// GetDescription implements Check.
+func (g *GoSum) GetDescription() string {
+	return "enforces go.sum files are complete and match the module cache"
+}
+
+// GetName implements Check.
+func (g *GoSum) GetName() string {
+	return "gosum"
+}
+
+// GetPrerequisites implements Check.
+func (g *GoSum) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (g *GoSum) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), ParseIssues)
+}
+
+func (g *GoSum) run(change scm.Change, options *Options) error {
+	all := change.All().Files()
+	hasFile := map[string]bool{}
+	for _, f := range all {
+		hasFile[f] = true
+	}
+	var bad []string
+	env := []string{"GO111MODULE=on", "GOFLAGS=-mod=readonly"}
+	for _, f := range affectedGoModFiles(goModFiles(all), change.Changed().Files()) {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		mod, err := parseGoMod(content)
+		if err != nil {
+			bad = append(bad, fmt.Sprintf("%s: %s", f, err))
+			continue
+		}
+		sum := path.Join(path.Dir(f), "go.sum")
+		if len(mod.requires) != 0 && !hasFile[sum] {
+			bad = append(bad, fmt.Sprintf("%s: missing", sum))
+			continue
+		}
+		wd := filepath.Join(change.Repo().Root(), filepath.FromSlash(path.Dir(f)))
+		for _, args := range [][]string{{"go", "mod", "verify"}, {"go", "list", "-deps", "-test", "./..."}} {
+			out, exitCode, err := internal.CaptureContext(options.context(), wd, options.procEnv(env...), options.procArgs(args)...)
+			if exitCode != 0 || err != nil {
+				bad = append(bad, fmt.Sprintf("%s: %s failed: %v\n%s", f, strings.Join(args, " "), err, strings.TrimSpace(out)))
+				break
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("go.sum verification failed:\n" + strings.Join(bad, "\n"))
+	}
+	return nil
+}
+
+// GoDirective enforces the go directive of all the go.mod files in the
+// repository is within a version range and matches the Go version used on CI.
+type GoDirective struct {
+	Limits `yaml:",inline"`
+
+	// MinVersion is the minimum accepted version, e.g. "1.20". Optional.
+	MinVersion string `yaml:"min_version"`
+	// MaxVersion is the maximum accepted version, e.g. "1.22". Optional.
+	MaxVersion string `yaml:"max_version"`
+	// Consistent requires all go.mod files in the repository to have the same
+	// go directive.
+	Consistent bool `yaml:"consistent"`
+	// CIFiles are files, relative to the repository root, that declare the Go
+	// version used on CI, e.g. ".github/workflows/test.yml". The major and minor
+	// versions found must match the go directive.
+	CIFiles []string `yaml:"ci_files"`
+	// CIPattern is the regexp used to find the Go version in CIFiles. The first
+	// group must be the version. Defaults to a pattern matching "go: 1.21",
+	// "go-version: '1.21'" and "golang:1.21".
+	CIPattern string `yaml:"ci_pattern"`
+}
+
+// GetDescription implements Check.
+func (g *GoDirective) GetDescription() string {
+	return "enforces the go directive in go.mod files is consistent"
+}
+
+// GetName implements Check.
+func (g *GoDirective) GetName() string {
+	return "godirective"
+}
+
+// GetPrerequisites implements Check.
+func (g *GoDirective) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (g *GoDirective) Run(change scm.Change, options *Options) Result {
+	return newResult(g.run(change, options), ParseIssues)
+}
+
+func (g *GoDirective) run(change scm.Change, options *Options) error {
+	pattern := defaultCIPattern
+	if g.CIPattern != "" {
+		var err error
+		if pattern, err = regexp.Compile(g.CIPattern); err != nil {
+			return fmt.Errorf("invalid ci_pattern: %s", err)
+		}
+	}
+	var bad []string
+	// Map of <go directive> : <first go.mod using it>
+	versions := map[string]string{}
+	first := ""
+	for _, f := range goModFiles(change.All().Files()) {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		mod, err := parseGoMod(content)
+		if err != nil {
+			bad = append(bad, fmt.Sprintf("%s: %s", f, err))
+			continue
+		}
+		if mod.goVersion == "" {
+			if g.MinVersion != "" || g.MaxVersion != "" || g.Consistent || len(g.CIFiles) != 0 {
+				bad = append(bad, fmt.Sprintf("%s: missing go directive", f))
+			}
+			continue
+		}
+		if g.MinVersion != "" && compareGoVersions(mod.goVersion, g.MinVersion) < 0 {
+			bad = append(bad, fmt.Sprintf("%s:%d: go %s < %s (min)", f, mod.goLine, mod.goVersion, g.MinVersion))
+		}
+		if g.MaxVersion != "" && compareGoVersions(mod.goVersion, g.MaxVersion) > 0 {
+			bad = append(bad, fmt.Sprintf("%s:%d: go %s > %s (max)", f, mod.goLine, mod.goVersion, g.MaxVersion))
+		}
+		if _, ok := versions[mod.goVersion]; !ok {
+			versions[mod.goVersion] = f
+		}
+		if first == "" {
+			first = mod.goVersion
+		}
+	}
+	if g.Consistent && len(versions) > 1 {
+		lines := make([]string, 0, len(versions))
+		for v, f := range versions {
+			lines = append(lines, fmt.Sprintf("go %s in %s", v, f))
+		}
+		sort.Strings(lines)
+		bad = append(bad, "inconsistent go directives: "+strings.Join(lines, ", "))
+	}
+	for _, f := range g.CIFiles {
+		content := change.Content(f)
+		if content == nil {
+			bad = append(bad, fmt.Sprintf("%s: not found", f))
+			continue
+		}
+		for _, m := range pattern.FindAllSubmatch(content, -1) {
+			if len(m) < 2 {
+				continue
+			}
+			v := string(m[1])
+			if first != "" && !sameGoMinor(v, first) {
+				bad = append(bad, fmt.Sprintf("%s: uses go %s but go.mod declares go %s", f, v, first))
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("go directive check failed:\n" + strings.Join(bad, "\n"))
+	}
+	return nil
+}
+
+// Private stuff.
+
+var defaultCIPattern = regexp.MustCompile(`(?:\bgo(?:-version)?:|golang:)\s*\[?\s*['"]?v?(\d+\.\d+(?:\.\d+)?)`)
+
+// parseGoVersion converts "1.21.3" or "1.21rc1" into []int{1, 21, 3}. Any
+// suffix is ignored.
+func parseGoVersion(v string) []int {
+	var out []int
+	for _, i := range strings.Split(strings.TrimPrefix(v, "go"), ".") {
+		n := 0
+		j := 0
+		for ; j < len(i) && i[j] >= '0' && i[j] <= '9'; j++ {
+			n = n*10 + int(i[j]-'0')
+		}
+		out = append(out, n)
+		if j != len(i) {
+			break
+		}
+	}
+	return out
+}
+
+// compareGoVersions returns -1, 0 or 1. Missing components are considered 0.
+func compareGoVersions(a, b string) int {
+	va := parseGoVersion(a)
+	vb := parseGoVersion(b)
+	for i := 0; i < len(va) || i < len(vb); i++ {
+		x, y := 0, 0
+		if i < len(va) {
+			x = va[i]
+		}
+		if i < len(vb) {
+			y = vb[i]
+		}
+		if x < y {
+			return -1
+		}
+		if x > y {
+			return 1
+		}
+	}
+	return 0
+}
+
+// sameGoMinor returns true if both versions have the same major and minor
+// versions.
+func sameGoMinor(a, b string) bool {
+	va := parseGoVersion(a)
+	vb := parseGoVersion(b)
+	for len(va) < 2 {
+		va = append(va, 0)
+	}
+	for len(vb) < 2 {
+		vb = append(vb, 0)
+	}
+	return va[0] == vb[0] && va[1] == vb[1]
+}
+
+// goMod is the subset of a go.mod file that is used by the checks.
+type goMod struct {
+	module    string
+	goVersion string
+	goLine    int
+	toolchain string
+	requires  []goModRequire
+	replaces  []goModReplace
+}
+
+// goModRequire is a require directive.
+type goModRequire struct {
+	path    string
+	version string
+	line    int
+}
+
+// goModReplace is a replace directive.
+type goModReplace struct {
+	oldPath    string
+	oldVersion string
+	newPath    string
+	newVersion string
+	line       int
+}
+
+// parseGoMod parses the directives of a go.mod file that are relevant to the
+// checks. Unknown directives are ignored.
+func parseGoMod(content []byte) (*goMod, error) {
+	out := &goMod{}
+	block := ""
+	for i, line := range strings.Split(string(content), "\n") {
+		lineNum := i + 1
+		if j := strings.Index(line, "//"); j != -1 {
+			line = line[:j]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if block != "" {
+			if fields[0] == ")" {
+				block = ""
+				continue
+			}
+			fields = append([]string{block}, fields...)
+		} else if len(fields) == 2 && fields[1] == "(" {
+			block = fields[0]
+			continue
+		}
+		args := fields[1:]
+		for k := range args {
+			args[k] = strings.Trim(args[k], "\"`")
+		}
+		switch fields[0] {
+		case "module":
+			if len(args) != 1 {
+				return nil, fmt.Errorf("line %d: invalid module directive", lineNum)
+			}
+			out.module = args[0]
+		case "go":
+			if len(args) != 1 {
+				return nil, fmt.Errorf("line %d: invalid go directive", lineNum)
+			}
+			out.goVersion = args[0]
+			out.goLine = lineNum
+		case "toolchain":
+			if len(args) != 1 {
+				return nil, fmt.Errorf("line %d: invalid toolchain directive", lineNum)
+			}
+			out.toolchain = args[0]
+		case "require":
+			if len(args) != 2 {
+				return nil, fmt.Errorf("line %d: invalid require directive", lineNum)
+			}
+			out.requires = append(out.requires, goModRequire{args[0], args[1], lineNum})
+		case "replace":
+			r := goModReplace{line: lineNum}
+			arrow := -1
+			for k, a := range args {
+				if a == "=>" {
+					arrow = k
+				}
+			}
+			switch arrow {
+			case 1:
+				r.oldPath = args[0]
+			case 2:
+				r.oldPath, r.oldVersion = args[0], args[1]
+			default:
+				return nil, fmt.Errorf("line %d: invalid replace directive", lineNum)
+			}
+			switch len(args) - arrow - 1 {
+			case 1:
+				r.newPath = args[arrow+1]
+			case 2:
+				r.newPath, r.newVersion = args[arrow+1], args[arrow+2]
+			default:
+				return nil, fmt.Errorf("line %d: invalid replace directive", lineNum)
+			}
+			out.replaces = append(out.replaces, r)
+		}
+	}
+	if block != "" {
+		return nil, fmt.Errorf("unterminated %s block", block)
+	}
+	return out, nil
+}
+
+// isLocalModPath returns true if a replacement path is a local directory, as
+// defined by the go tool.
+func isLocalModPath(p string) bool {
+	return strings.HasPrefix(p, "./") || strings.HasPrefix(p, "../") || strings.HasPrefix(p, "/") ||
+		p == "." || p == ".." || (len(p) >= 2 && p[1] == ':') || strings.HasPrefix(p, ".\\") || strings.HasPrefix(p, "..\\")
+}
+
+// affectedGoModFiles returns the go.mod files in mods of the modules
+// containing at least one of the files in changed. A file belongs to the module
+// of the nearest go.mod in its parent directories.
+func affectedGoModFiles(mods, changed []string) []string {
+	affected := map[string]bool{}
+	for _, f := range changed {
+		best := ""
+		for _, m := range mods {
+			dir := path.Dir(m)
+			if (dir == "." || strings.HasPrefix(f, dir+"/")) && len(m) > len(best) {
+				best = m
+			}
+		}
+		if best != "" {
+			affected[best] = true
+		}
+	}
+	var out []string
+	for _, m := range mods {
+		if affected[m] {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// goModFiles returns the go.mod files in files, sorted.
+func goModFiles(files []string) []string {
+	var out []string
+	for _, f := range files {
+		if path.Base(strings.Replace(f, "\\", "/", -1)) == "go.mod" {
+			out = append(out, f)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
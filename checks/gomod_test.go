@@ -0,0 +1,127 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestParseGoMod(t *testing.T) {
+	t.Parallel()
+	content := `module example.com/foo // comment
+
+go 1.21
+
+require (
+	example.com/bar v1.0.0
+	example.com/baz v0.1.0 // indirect
+)
+
+replace example.com/bar => ../bar
+replace (
+	example.com/baz v0.1.0 => example.com/fork/baz v0.2.0
+)
+`
+	mod, err := parseGoMod([]byte(content))
+	ut.AssertEqual(t, nil, err)
+	expected := &goMod{
+		module:    "example.com/foo",
+		goVersion: "1.21",
+		goLine:    3,
+		requires: []goModRequire{
+			{"example.com/bar", "v1.0.0", 6},
+			{"example.com/baz", "v0.1.0", 7},
+		},
+		replaces: []goModReplace{
+			{"example.com/bar", "", "../bar", "", 10},
+			{"example.com/baz", "v0.1.0", "example.com/fork/baz", "v0.2.0", 12},
+		},
+	}
+	ut.AssertEqual(t, expected, mod)
+}
+
+func TestParseGoModInvalid(t *testing.T) {
+	t.Parallel()
+	_, err := parseGoMod([]byte("replace foo\n"))
+	ut.AssertEqual(t, errors.New("line 1: invalid replace directive"), err)
+	_, err = parseGoMod([]byte("require (\nfoo v1\n"))
+	ut.AssertEqual(t, errors.New("unterminated require block"), err)
+}
+
+func TestIsLocalModPath(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, true, isLocalModPath("../foo"))
+	ut.AssertEqual(t, true, isLocalModPath("./foo"))
+	ut.AssertEqual(t, true, isLocalModPath("/foo"))
+	ut.AssertEqual(t, true, isLocalModPath("C:\\foo"))
+	ut.AssertEqual(t, false, isLocalModPath("example.com/foo"))
+}
+
+func TestCompareGoVersions(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.21", "1.21.0", 0},
+		{"1.21.1", "1.21", 1},
+		{"1.9", "1.10", -1},
+		{"go1.22rc1", "1.22", 0},
+		{"2", "1.30", 1},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, compareGoVersions(line.a, line.b))
+	}
+	ut.AssertEqual(t, true, sameGoMinor("1.21.3", "1.21"))
+	ut.AssertEqual(t, false, sameGoMinor("1.20", "1.21"))
+}
+
+func TestDefaultCIPattern(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		in       string
+		expected string
+	}{
+		{"    go-version: '1.21'\n", "1.21"},
+		{"go: 1.4.2\n", "1.4.2"},
+		{"image: golang:1.22-alpine\n", "1.22"},
+		{"go-version: [\"1.20\"]", "1.20"},
+	}
+	for i, line := range data {
+		m := defaultCIPattern.FindStringSubmatch(line.in)
+		ut.AssertEqualIndex(t, i, 2, len(m))
+		ut.AssertEqualIndex(t, i, line.expected, m[1])
+	}
+}
+
+func TestAffectedGoModFiles(t *testing.T) {
+	t.Parallel()
+	mods := []string{"a/go.mod", "a/b/go.mod", "go.mod"}
+	ut.AssertEqual(t, []string(nil), affectedGoModFiles(mods, nil))
+	ut.AssertEqual(t, []string{"a/b/go.mod"}, affectedGoModFiles(mods, []string{"a/b/c/foo.go"}))
+	ut.AssertEqual(t, []string{"a/go.mod", "go.mod"}, affectedGoModFiles(mods, []string{"a/go.sum", "ab/foo.go"}))
+	ut.AssertEqual(t, []string(nil), affectedGoModFiles([]string{"a/go.mod"}, []string{"foo.go"}))
+}
+
+func TestGoSum(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.SkipNow()
+	}
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"a/go.mod": "module example.com/a\n\ngo 1.20\n",
+		"a/a.go":   "package a\n",
+		"b/go.mod": "module example.com/b\n\ngo 1.20\n\nrequire example.com/c v1.0.0\n",
+		"b/b.go":   "package b\n",
+	}
+	change := setup(t, td, files)
+	ut.AssertEqual(t, errors.New("go.sum verification failed:\nb/go.sum: missing"), (&GoSum{}).Run(change, &Options{}).Err)
+}
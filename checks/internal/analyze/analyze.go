@@ -0,0 +1,444 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Package analyze runs golang.org/x/tools/go/analysis analyzers in process on
+// packages loaded once, instead of running one vet process per directory.
+//
+// The packages are described by the output of 'go list', which also builds
+// the export data of their dependencies. Only the analyzed packages are
+// parsed and type checked; the dependencies are loaded from their export
+// data. The facts of an analyzer are only shared within a package, so the
+// analyzers relying on the facts of dependencies, e.g. printf wrappers
+// declared in another package, report a bit less than 'go vet'.
+package analyze
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/golang.org/x/tools/go/analysis"
+)
+
+// Package is a package as printed by 'go list -json'.
+type Package struct {
+	Dir            string
+	ImportPath     string
+	Name           string
+	Export         string
+	GoFiles        []string
+	CgoFiles       []string
+	IgnoredGoFiles []string
+	CFiles         []string
+	HFiles         []string
+	SFiles         []string
+	ImportMap      map[string]string
+	DepOnly        bool
+	ForTest        string
+	Module         *struct {
+		Path      string
+		Version   string
+		GoVersion string
+	}
+	Error *struct {
+		// Pos is relative to the directory 'go list' ran in.
+		Pos string
+		Err string
+	}
+}
+
+// Diagnostic is a finding of an analyzer.
+type Diagnostic struct {
+	Analyzer string
+	Position token.Position
+	Message  string
+}
+
+// ListArgs returns the command line listing pkgs and their dependencies with
+// their test variants, which output is expected by Run.
+func ListArgs(pkgs []string) []string {
+	return append([]string{"go", "list", "-e", "-json", "-export", "-deps", "-test"}, pkgs...)
+}
+
+// TypeCheck is the Analyzer of the diagnostics reporting the packages that
+// fail to load or type check, like 'go vet' does.
+const TypeCheck = "typecheck"
+
+// Run runs analyzers on the packages listed in list that are not only
+// dependencies, in parallel, and returns the diagnostics sorted by position.
+//
+// The errors of the packages that fail to load or type check are returned as
+// TypeCheck diagnostics instead of running the analyzers on them.
+func Run(list []byte, analyzers []*analysis.Analyzer) ([]Diagnostic, error) {
+	pkgs, err := parseList(list)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[string]*Package{}
+	for _, p := range pkgs {
+		byID[p.ImportPath] = p
+	}
+	var targets []*Package
+	for _, p := range pkgs {
+		if p.DepOnly || (p.ForTest == "" && strings.HasSuffix(p.ImportPath, ".test")) {
+			continue
+		}
+		// The test variant contains all the files of the package.
+		if _, ok := byID[p.ImportPath+" ["+p.ImportPath+".test]"]; ok {
+			continue
+		}
+		targets = append(targets, p)
+	}
+
+	fset := token.NewFileSet()
+	var lock sync.Mutex
+	var out []Diagnostic
+	var errs []string
+	c := make(chan *Package)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for p := range c {
+				diags, err := analyzePackage(fset, p, byID, analyzers)
+				lock.Lock()
+				out = append(out, diags...)
+				if err != nil {
+					errs = append(errs, err.Error())
+				}
+				lock.Unlock()
+			}
+		}()
+	}
+	for _, p := range targets {
+		c <- p
+	}
+	close(c)
+	wg.Wait()
+	if len(errs) != 0 {
+		sort.Strings(errs)
+		return nil, fmt.Errorf("analysis failed:\n%s", strings.Join(errs, "\n"))
+	}
+	sort.Sort(byPosition(out))
+	return dedupe(out), nil
+}
+
+// Private stuff.
+
+// parseList parses the JSON objects printed by 'go list -json', each starting
+// with a line "{" and ending with a line "}". The other lines, e.g. warnings
+// printed on stderr, are ignored.
+func parseList(list []byte) ([]*Package, error) {
+	var out []*Package
+	var obj []byte
+	for _, line := range bytes.SplitAfter(list, []byte("\n")) {
+		trimmed := string(bytes.TrimRight(line, "\r\n"))
+		if obj == nil {
+			if trimmed == "{" {
+				obj = append([]byte{}, line...)
+			}
+			continue
+		}
+		obj = append(obj, line...)
+		if trimmed == "}" {
+			p := &Package{}
+			if err := json.Unmarshal(obj, p); err != nil {
+				return nil, fmt.Errorf("failed to parse go list output: %s", err)
+			}
+			out = append(out, p)
+			obj = nil
+		}
+	}
+	return out, nil
+}
+
+// loadError returns the diagnostic of the error 'go list' reported on p.
+func loadError(p *Package) Diagnostic {
+	d := Diagnostic{Analyzer: TypeCheck, Position: token.Position{Filename: p.Dir}, Message: p.Error.Err}
+	if items := strings.Split(p.Error.Pos, ":"); len(items) >= 2 {
+		// The file is in the package, e.g. the one with a bad import.
+		d.Position.Filename = filepath.Join(p.Dir, filepath.Base(items[0]))
+		d.Position.Line, _ = strconv.Atoi(items[1])
+		if len(items) >= 3 {
+			d.Position.Column, _ = strconv.Atoi(items[2])
+		}
+	}
+	return d
+}
+
+// loadPackage parses and type checks p, loading its imports from the export
+// data of the packages in byID. The errors are returned as TypeCheck
+// diagnostics.
+func loadPackage(fset *token.FileSet, p *Package, byID map[string]*Package) ([]*ast.File, *types.Package, *types.Info, []Diagnostic) {
+	var files []*ast.File
+	var errs []Diagnostic
+	for _, name := range append(append([]string{}, p.GoFiles...), p.CgoFiles...) {
+		if !filepath.IsAbs(name) {
+			name = filepath.Join(p.Dir, name)
+		}
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments)
+		if list, ok := err.(scanner.ErrorList); ok {
+			for _, e := range list {
+				errs = append(errs, Diagnostic{Analyzer: TypeCheck, Position: e.Pos, Message: e.Msg})
+			}
+		} else if err != nil {
+			errs = append(errs, Diagnostic{Analyzer: TypeCheck, Position: token.Position{Filename: name}, Message: err.Error()})
+		}
+		files = append(files, f)
+	}
+	if len(errs) != 0 {
+		return nil, nil, nil, errs
+	}
+	lookup := func(path string) (io.ReadCloser, error) {
+		dep, ok := byID[path]
+		if !ok || dep.Export == "" {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(dep.Export)
+	}
+	imp := importer.ForCompiler(fset, "gc", lookup)
+	conf := &types.Config{
+		// ImportMap resolves the import paths, e.g. to the test variant of a
+		// package.
+		Importer: importerFunc(func(path string) (*types.Package, error) {
+			if id, ok := p.ImportMap[path]; ok {
+				path = id
+			}
+			return imp.Import(path)
+		}),
+		FakeImportC: true,
+		Sizes:       types.SizesFor("gc", runtime.GOARCH),
+		Error: func(err error) {
+			if e, ok := err.(types.Error); ok {
+				errs = append(errs, Diagnostic{Analyzer: TypeCheck, Position: e.Fset.Position(e.Pos), Message: e.Msg})
+			} else {
+				errs = append(errs, Diagnostic{Analyzer: TypeCheck, Position: token.Position{Filename: p.Dir}, Message: err.Error()})
+			}
+		},
+	}
+	if p.Module != nil && p.Module.GoVersion != "" {
+		conf.GoVersion = "go" + p.Module.GoVersion
+	}
+	info := &types.Info{
+		Types:        map[ast.Expr]types.TypeAndValue{},
+		Instances:    map[*ast.Ident]types.Instance{},
+		Defs:         map[*ast.Ident]types.Object{},
+		Uses:         map[*ast.Ident]types.Object{},
+		Implicits:    map[ast.Node]types.Object{},
+		Selections:   map[*ast.SelectorExpr]*types.Selection{},
+		Scopes:       map[ast.Node]*types.Scope{},
+		FileVersions: map[*ast.File]string{},
+	}
+	path := p.ImportPath
+	if i := strings.IndexByte(path, ' '); i != -1 {
+		// Strip the test variant suffix, e.g. " [foo.test]".
+		path = path[:i]
+	}
+	pkg, _ := conf.Check(path, fset, files, info)
+	if len(errs) != 0 {
+		return nil, nil, nil, errs
+	}
+	return files, pkg, info, nil
+}
+
+// analyzePackage runs analyzers and their requirements on p.
+func analyzePackage(fset *token.FileSet, p *Package, byID map[string]*Package, analyzers []*analysis.Analyzer) ([]Diagnostic, error) {
+	if p.Error != nil {
+		if len(p.GoFiles) == 0 && len(p.CgoFiles) == 0 {
+			// E.g. build constraints exclude all the files.
+			return nil, nil
+		}
+		return []Diagnostic{loadError(p)}, nil
+	}
+	files, pkg, info, errs := loadPackage(fset, p, byID)
+	if len(errs) != 0 {
+		return errs, nil
+	}
+	var other []string
+	for _, l := range [][]string{p.CFiles, p.HFiles, p.SFiles} {
+		for _, name := range l {
+			other = append(other, filepath.Join(p.Dir, name))
+		}
+	}
+	var ignored []string
+	for _, name := range p.IgnoredGoFiles {
+		ignored = append(ignored, filepath.Join(p.Dir, name))
+	}
+	var module *analysis.Module
+	if p.Module != nil {
+		module = &analysis.Module{Path: p.Module.Path, Version: p.Module.Version, GoVersion: p.Module.GoVersion}
+	}
+
+	var diags []Diagnostic
+	results := map[*analysis.Analyzer]interface{}{}
+	done := map[*analysis.Analyzer]bool{}
+	var run func(a *analysis.Analyzer) error
+	run = func(a *analysis.Analyzer) error {
+		if done[a] {
+			return nil
+		}
+		done[a] = true
+		for _, req := range a.Requires {
+			if err := run(req); err != nil {
+				return err
+			}
+		}
+		resultOf := map[*analysis.Analyzer]interface{}{}
+		for _, req := range a.Requires {
+			resultOf[req] = results[req]
+		}
+		facts := newFactStore(pkg)
+		pass := &analysis.Pass{
+			Analyzer:     a,
+			Fset:         fset,
+			Files:        files,
+			OtherFiles:   other,
+			IgnoredFiles: ignored,
+			Pkg:          pkg,
+			TypesInfo:    info,
+			TypesSizes:   types.SizesFor("gc", runtime.GOARCH),
+			Module:       module,
+			ResultOf:     resultOf,
+			ReadFile:     ioutil.ReadFile,
+			Report: func(d analysis.Diagnostic) {
+				diags = append(diags, Diagnostic{Analyzer: a.Name, Position: fset.Position(d.Pos), Message: d.Message})
+			},
+			ImportObjectFact:  facts.importObject,
+			ExportObjectFact:  facts.exportObject,
+			ImportPackageFact: facts.importPackage,
+			ExportPackageFact: facts.exportPackage,
+			AllObjectFacts:    facts.allObjects,
+			AllPackageFacts:   facts.allPackages,
+		}
+		result, err := a.Run(pass)
+		if err != nil {
+			return fmt.Errorf("%s: %s: %s", p.ImportPath, a.Name, err)
+		}
+		results[a] = result
+		return nil
+	}
+	for _, a := range analyzers {
+		if err := run(a); err != nil {
+			return diags, err
+		}
+	}
+	return diags, nil
+}
+
+// importerFunc implements types.Importer.
+type importerFunc func(path string) (*types.Package, error)
+
+func (i importerFunc) Import(path string) (*types.Package, error) {
+	return i(path)
+}
+
+// factStore holds the facts exported by an analyzer on a package.
+type factStore struct {
+	pkg      *types.Package
+	objects  map[objectFactKey]analysis.Fact
+	packages map[reflect.Type]analysis.Fact
+}
+
+type objectFactKey struct {
+	obj types.Object
+	t   reflect.Type
+}
+
+func newFactStore(pkg *types.Package) *factStore {
+	return &factStore{pkg: pkg, objects: map[objectFactKey]analysis.Fact{}, packages: map[reflect.Type]analysis.Fact{}}
+}
+
+func (f *factStore) importObject(obj types.Object, fact analysis.Fact) bool {
+	if v, ok := f.objects[objectFactKey{obj, reflect.TypeOf(fact)}]; ok {
+		reflect.ValueOf(fact).Elem().Set(reflect.ValueOf(v).Elem())
+		return true
+	}
+	return false
+}
+
+func (f *factStore) exportObject(obj types.Object, fact analysis.Fact) {
+	if obj.Pkg() != f.pkg {
+		panic(fmt.Sprintf("fact %T on %s exported from %s", fact, obj, f.pkg.Path()))
+	}
+	f.objects[objectFactKey{obj, reflect.TypeOf(fact)}] = fact
+}
+
+func (f *factStore) importPackage(pkg *types.Package, fact analysis.Fact) bool {
+	if pkg != f.pkg {
+		return false
+	}
+	if v, ok := f.packages[reflect.TypeOf(fact)]; ok {
+		reflect.ValueOf(fact).Elem().Set(reflect.ValueOf(v).Elem())
+		return true
+	}
+	return false
+}
+
+func (f *factStore) exportPackage(fact analysis.Fact) {
+	f.packages[reflect.TypeOf(fact)] = fact
+}
+
+func (f *factStore) allObjects() []analysis.ObjectFact {
+	var out []analysis.ObjectFact
+	for k, v := range f.objects {
+		out = append(out, analysis.ObjectFact{Object: k.obj, Fact: v})
+	}
+	return out
+}
+
+func (f *factStore) allPackages() []analysis.PackageFact {
+	var out []analysis.PackageFact
+	for _, v := range f.packages {
+		out = append(out, analysis.PackageFact{Package: f.pkg, Fact: v})
+	}
+	return out
+}
+
+// byPosition sorts the diagnostics by file, line, column then message.
+type byPosition []Diagnostic
+
+func (b byPosition) Len() int      { return len(b) }
+func (b byPosition) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byPosition) Less(i, j int) bool {
+	x, y := b[i].Position, b[j].Position
+	if x.Filename != y.Filename {
+		return x.Filename < y.Filename
+	}
+	if x.Line != y.Line {
+		return x.Line < y.Line
+	}
+	if x.Column != y.Column {
+		return x.Column < y.Column
+	}
+	return b[i].Message < b[j].Message
+}
+
+// dedupe removes the consecutive duplicates from sorted diagnostics, e.g.
+// reported on a package and its external test package.
+func dedupe(diags []Diagnostic) []Diagnostic {
+	var out []Diagnostic
+	for i, d := range diags {
+		if i == 0 || d.Position != diags[i-1].Position || d.Message != diags[i-1].Message {
+			out = append(out, d)
+		}
+	}
+	return out
+}
@@ -0,0 +1,101 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package analyze
+
+import (
+	"encoding/json"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/golang.org/x/tools/go/analysis"
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/golang.org/x/tools/go/analysis/passes/assign"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestParseList(t *testing.T) {
+	t.Parallel()
+	list := "go: warning: ignoring go.mod\n{\n\t\"ImportPath\": \"foo\",\n\t\"GoFiles\": [\n\t\t\"foo.go\"\n\t]\n}\n{\n\t\"ImportPath\": \"bar\",\n\t\"DepOnly\": true\n}\n"
+	pkgs, err := parseList([]byte(list))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []*Package{{ImportPath: "foo", GoFiles: []string{"foo.go"}}, {ImportPath: "bar", DepOnly: true}}, pkgs)
+	_, err = parseList([]byte("{\n\"ImportPath\": 1\n}\n"))
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	src := "package foo\n\nfunc f() {\n\tx := 1\n\tx = x\n}\n"
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "foo.go"), []byte(src), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "foo_test.go"), []byte("package foo\n"), 0600))
+	// The test variant contains all the files of the package, so the package
+	// itself is skipped. The dependencies are not analyzed.
+	var list []byte
+	for _, p := range []*Package{
+		{Dir: td, ImportPath: "foo", GoFiles: []string{"foo.go"}},
+		{Dir: td, ImportPath: "foo [foo.test]", ForTest: "foo", GoFiles: []string{"foo.go", "foo_test.go"}},
+		{Dir: td, ImportPath: "foo.test", GoFiles: []string{"_testmain.go"}},
+		{Dir: td, ImportPath: "bar", DepOnly: true, GoFiles: []string{"bar.go"}},
+	} {
+		b, err := json.MarshalIndent(p, "", "\t")
+		ut.AssertEqual(t, nil, err)
+		list = append(append(list, b...), '\n')
+	}
+	diags, err := Run(list, []*analysis.Analyzer{assign.Analyzer})
+	ut.AssertEqual(t, nil, err)
+	expected := []Diagnostic{
+		{
+			Analyzer: "assign",
+			Position: token.Position{Filename: filepath.Join(td, "foo.go"), Offset: 33, Line: 5, Column: 2},
+			Message:  "self-assignment of x",
+		},
+	}
+	ut.AssertEqual(t, expected, diags)
+}
+
+func TestRunTypeCheck(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	src := "package foo\n\nfunc f() {\n\treturn 1\n}\n"
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "foo.go"), []byte(src), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "bar.go"), []byte("package bar\n\nfunc {\n"), 0600))
+	var list []byte
+	for _, p := range []*Package{
+		{Dir: td, ImportPath: "foo", GoFiles: []string{"foo.go"}},
+		{Dir: td, ImportPath: "bar", GoFiles: []string{"bar.go"}},
+		{Dir: filepath.Join(td, "baz"), ImportPath: "baz", GoFiles: []string{"baz.go"}, Error: &struct {
+			Pos string
+			Err string
+		}{"baz/baz.go:3:8", "package nope is not in std"}},
+		{Dir: filepath.Join(td, "empty"), ImportPath: "empty", Error: &struct {
+			Pos string
+			Err string
+		}{"", "build constraints exclude all Go files"}},
+	} {
+		b, err := json.MarshalIndent(p, "", "\t")
+		ut.AssertEqual(t, nil, err)
+		list = append(append(list, b...), '\n')
+	}
+	diags, err := Run(list, []*analysis.Analyzer{assign.Analyzer})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 3, len(diags))
+	ut.AssertEqual(t, TypeCheck, diags[0].Analyzer)
+	ut.AssertEqual(t, filepath.Join(td, "bar.go"), diags[0].Position.Filename)
+	ut.AssertEqual(t, Diagnostic{Analyzer: TypeCheck, Position: token.Position{Filename: filepath.Join(td, "baz", "baz.go"), Line: 3, Column: 8}, Message: "package nope is not in std"}, diags[1])
+	ut.AssertEqual(t, Diagnostic{Analyzer: TypeCheck, Position: token.Position{Filename: filepath.Join(td, "foo.go"), Offset: 32, Line: 4, Column: 9}, Message: "too many return values\n\thave (number)\n\twant ()"}, diags[2])
+}
+
+func TestDedupe(t *testing.T) {
+	t.Parallel()
+	a := Diagnostic{Position: token.Position{Filename: "a.go", Line: 1}, Message: "a"}
+	b := Diagnostic{Position: token.Position{Filename: "a.go", Line: 2}, Message: "a"}
+	ut.AssertEqual(t, []Diagnostic{a, b}, dedupe([]Diagnostic{a, a, b}))
+	ut.AssertEqual(t, []Diagnostic(nil), dedupe(nil))
+}
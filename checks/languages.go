@@ -0,0 +1,193 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Language routing.
+
+package checks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// LanguageCheck wraps a Check defined in a Language so it is only run on the
+// files of this language.
+type LanguageCheck struct {
+	Language   string
+	Extensions []string
+	Check      Check
+}
+
+// GetDescription implements Check.
+func (l *LanguageCheck) GetDescription() string {
+	return fmt.Sprintf("%s (%s files)", l.Check.GetDescription(), l.Language)
+}
+
+// GetName implements Check.
+func (l *LanguageCheck) GetName() string {
+	return l.Check.GetName()
+}
+
+// GetPrerequisites implements Check.
+func (l *LanguageCheck) GetPrerequisites() []CheckPrerequisite {
+	return l.Check.GetPrerequisites()
+}
+
+// GetTimeout implements Limiter.
+func (l *LanguageCheck) GetTimeout() time.Duration {
+	if limiter, ok := l.Check.(Limiter); ok {
+		return limiter.GetTimeout()
+	}
+	return 0
+}
+
+// IsEnabled implements Limiter.
+func (l *LanguageCheck) IsEnabled() bool {
+	return isEnabled(l.Check)
+}
+
+// GetWeight implements Limiter.
+func (l *LanguageCheck) GetWeight() int {
+	if limiter, ok := l.Check.(Limiter); ok {
+		return limiter.GetWeight()
+	}
+	return 1
+}
+
+// GetDependencies implements Limiter.
+func (l *LanguageCheck) GetDependencies() []string {
+	if limiter, ok := l.Check.(Limiter); ok {
+		return limiter.GetDependencies()
+	}
+	return nil
+}
+
+// GetSeverity implements Limiter.
+func (l *LanguageCheck) GetSeverity() Severity {
+	if limiter, ok := l.Check.(Limiter); ok {
+		return limiter.GetSeverity()
+	}
+	return SeverityError
+}
+
+// Scope implements Limiter.
+func (l *LanguageCheck) Scope(change scm.Change) scm.Change {
+	if limiter, ok := l.Check.(Limiter); ok {
+		return limiter.Scope(change)
+	}
+	return change
+}
+
+// Run implements Check.
+//
+// It is a no-op if no file of this language was modified.
+func (l *LanguageCheck) Run(change scm.Change, options *Options) Result {
+	changed := l.filter(change.Changed().Files())
+	if len(changed) == 0 {
+		return Result{}
+	}
+	c := &languageChange{
+		Change:  change,
+		changed: languageSet{changed},
+		all:     languageSet{l.filter(change.All().Files())},
+	}
+	return l.Check.Run(c, options)
+}
+
+// Commands implements Commander. It returns nil if the wrapped check doesn't
+// implement Commander.
+func (l *LanguageCheck) Commands(change scm.Change, options *Options) [][]string {
+	cmder, ok := l.Check.(Commander)
+	changed := l.filter(change.Changed().Files())
+	if !ok || len(changed) == 0 {
+		return nil
+	}
+	c := &languageChange{
+		Change:  change,
+		changed: languageSet{changed},
+		all:     languageSet{l.filter(change.All().Files())},
+	}
+	return cmder.Commands(c, options)
+}
+
+// Matches returns true if the file is part of this language.
+func (l *LanguageCheck) Matches(f string) bool {
+	for _, ext := range l.Extensions {
+		if strings.HasSuffix(f, ext) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *LanguageCheck) filter(files []string) []string {
+	var out []string
+	for _, f := range files {
+		if l.Matches(f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// languageChange is a scm.Change that only exposes the files of a language.
+type languageChange struct {
+	scm.Change
+	changed languageSet
+	all     languageSet
+}
+
+func (l *languageChange) Changed() scm.Set {
+	return &l.changed
+}
+
+func (l *languageChange) Indirect() scm.Set {
+	return &l.changed
+}
+
+func (l *languageChange) All() scm.Set {
+	return &l.all
+}
+
+func (l *languageChange) Filtered() scm.Change {
+	return &languageChange{
+		Change:  l.Change.Filtered(),
+		changed: languageSet{l.unignored(l.changed.files)},
+		all:     languageSet{l.unignored(l.all.files)},
+	}
+}
+
+func (l *languageChange) unignored(files []string) []string {
+	var out []string
+	for _, f := range files {
+		if !l.IsIgnored(f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// languageSet implements scm.Set with no Go file.
+type languageSet struct {
+	files []string
+}
+
+func (l *languageSet) Files() []string {
+	return l.files
+}
+
+func (l *languageSet) GoFiles() []string {
+	return nil
+}
+
+func (l *languageSet) Packages() []string {
+	return nil
+}
+
+func (l *languageSet) TestPackages() []string {
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestLanguageCheck(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{"foo.go": "package foo\n", "a.py": "print 1\n", "b/c.py": "print 2\n"}
+	change := setup(t, td, files)
+
+	r := &recordCheck{}
+	l := &LanguageCheck{Language: "python", Extensions: []string{".py"}, Check: r}
+	ut.AssertEqual(t, "record (python files)", l.GetDescription())
+	ut.AssertEqual(t, "record", l.GetName())
+	ut.AssertEqual(t, nil, l.Run(change, &Options{}).Err)
+	ut.AssertEqual(t, []string{"a.py", "b/c.py"}, r.files)
+	ut.AssertEqual(t, []string(nil), r.goFiles)
+
+	r = &recordCheck{}
+	l = &LanguageCheck{Language: "js", Extensions: []string{".js"}, Check: r}
+	ut.AssertEqual(t, nil, l.Run(change, &Options{}).Err)
+	ut.AssertEqual(t, []string(nil), r.files)
+}
+
+func TestConfigLanguages(t *testing.T) {
+	t.Parallel()
+	config := &Config{
+		Languages: map[string]*Language{
+			"python": {
+				Extensions: []string{".py"},
+				Modes: map[Mode]Settings{
+					PreCommit: {
+						Options: Options{MaxDuration: 10},
+						Checks:  Checks{"custom": {&Custom{Command: []string{"flake8"}, PassFiles: true}}},
+					},
+				},
+			},
+		},
+	}
+	checks, options := config.EnabledChecks([]Mode{PreCommit})
+	ut.AssertEqual(t, 1, len(checks))
+	ut.AssertEqual(t, "python", checks[0].(*LanguageCheck).Language)
+	ut.AssertEqual(t, 10, options.MaxDuration)
+	checks, _ = config.EnabledChecks([]Mode{PrePush})
+	ut.AssertEqual(t, 0, len(checks))
+}
+
+// Private stuff.
+
+// recordCheck records the files it was run on.
+type recordCheck struct {
+	files   []string
+	goFiles []string
+}
+
+func (r *recordCheck) GetDescription() string                { return "record" }
+func (r *recordCheck) GetName() string                       { return "record" }
+func (r *recordCheck) GetPrerequisites() []CheckPrerequisite { return nil }
+func (r *recordCheck) Run(change scm.Change, options *Options) Result {
+	r.files = change.Changed().Files()
+	r.goFiles = change.Changed().GoFiles()
+	return Result{}
+}
@@ -0,0 +1,121 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// length enforces readability limits on Go source files.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Length enforces maximum line, function and file lengths on Go source files.
+//
+// A limit of 0 disables the corresponding verification.
+type Length struct {
+	Limits `yaml:",inline"`
+
+	// MaxLineLength is the maximum number of characters on a line.
+	MaxLineLength int `yaml:"max_line_length"`
+	// TabWidth is the number of characters a tab counts for. Defaults to 1.
+	TabWidth int `yaml:"tab_width"`
+	// MaxFunctionLength is the maximum number of lines in a function body.
+	MaxFunctionLength int `yaml:"max_function_length"`
+	// MaxFileLength is the maximum number of lines in a file.
+	MaxFileLength int `yaml:"max_file_length"`
+	// Exclude is a list of glob patterns of files to skip. A pattern without
+	// '/' is matched against the file name, otherwise against the path relative
+	// to the repository root.
+	Exclude []string `yaml:"exclude"`
+}
+
+// GetDescription implements Check.
+func (l *Length) GetDescription() string {
+	return "enforces maximum line, function and file lengths"
+}
+
+// GetName implements Check.
+func (l *Length) GetName() string {
+	return "length"
+}
+
+// GetPrerequisites implements Check.
+func (l *Length) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (l *Length) Run(change scm.Change, options *Options) Result {
+	return newResult(l.run(change, options), ParseIssues)
+}
+
+func (l *Length) run(change scm.Change, options *Options) error {
+	tabWidth := l.TabWidth
+	if tabWidth < 1 {
+		tabWidth = 1
+	}
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		if matchAny(l.Exclude, f) {
+			continue
+		}
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		lines := strings.Split(strings.TrimSuffix(string(content), "\n"), "\n")
+		if l.MaxFileLength != 0 && len(lines) > l.MaxFileLength {
+			bad = append(bad, Issue{File: f, Message: fmt.Sprintf("%d lines > %d", len(lines), l.MaxFileLength)})
+		}
+		if l.MaxLineLength != 0 {
+			for i, line := range lines {
+				n := utf8.RuneCountInString(line) + strings.Count(line, "\t")*(tabWidth-1)
+				if n > l.MaxLineLength {
+					bad = append(bad, Issue{File: f, Line: i + 1, Message: fmt.Sprintf("line is %d characters > %d", n, l.MaxLineLength)})
+				}
+			}
+		}
+		if l.MaxFunctionLength != 0 {
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, f, content, 0)
+			if err != nil {
+				bad = append(bad, parseErrorIssues(err)...)
+				continue
+			}
+			ast.Inspect(file, func(n ast.Node) bool {
+				var body *ast.BlockStmt
+				name := "func literal"
+				switch fn := n.(type) {
+				case *ast.FuncDecl:
+					body = fn.Body
+					name = fn.Name.Name
+				case *ast.FuncLit:
+					body = fn.Body
+				}
+				if body == nil {
+					return true
+				}
+				// Count the lines between the braces.
+				start := fset.Position(body.Lbrace)
+				end := fset.Position(body.Rbrace)
+				if length := end.Line - start.Line - 1; length > l.MaxFunctionLength {
+					bad = append(bad, issueAt(start, "%s is %d lines > %d", name, length, l.MaxFunctionLength))
+				}
+				return true
+			})
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("length limits exceeded:\n" + formatIssues(bad))
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestLength(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"foo.go":         "package foo\n\nfunc Foo() int {\n\tx := 1\n\treturn x\n}\n",
+		"gen/foo_gen.go": "package gen\n\nvar a = \"this line is way too long\"\n",
+	}
+	change := setup(t, td, files)
+
+	l := &Length{MaxLineLength: 20, MaxFunctionLength: 2, MaxFileLength: 6, Exclude: []string{"*_gen.go"}}
+	ut.AssertEqual(t, nil, l.Run(change, &Options{}).Err)
+
+	l = &Length{MaxLineLength: 11, TabWidth: 8, Exclude: []string{"gen/*"}}
+	ut.AssertEqual(t, errors.New("length limits exceeded:\nfoo.go:3: line is 16 characters > 11\nfoo.go:4: line is 14 characters > 11\nfoo.go:5: line is 16 characters > 11"), l.Run(change, &Options{}).Err)
+
+	l = &Length{MaxFunctionLength: 1, MaxFileLength: 5, Exclude: []string{"gen/*"}}
+	ut.AssertEqual(t, errors.New("length limits exceeded:\nfoo.go: 6 lines > 5\nfoo.go:3:16: Foo is 2 lines > 1"), l.Run(change, &Options{}).Err)
+}
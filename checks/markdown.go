@@ -0,0 +1,153 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// markdown enforces documentation style.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Markdown enforces style rules on markdown files.
+//
+// Each rule is disabled by default.
+type Markdown struct {
+	Limits `yaml:",inline"`
+
+	// Files is the list of glob patterns of the files to check. A pattern
+	// without '/' is matched against the file name, otherwise against the path
+	// relative to the repository root. Defaults to []string{"*.md"}.
+	Files []string `yaml:"files"`
+	// MaxLineLength is the maximum number of characters on a line. Code blocks,
+	// tables and lines containing a URL are not checked.
+	MaxLineLength int `yaml:"max_line_length"`
+	// TrailingSpaces forbids trailing whitespace.
+	TrailingSpaces bool `yaml:"trailing_spaces"`
+	// HardTabs forbids tabs outside of code blocks.
+	HardTabs bool `yaml:"hard_tabs"`
+	// HeadingStyle is either "atx" (# Title) or "setext" (Title underlined with
+	// === or ---). With "setext", only level 1 and 2 headings are enforced since
+	// setext doesn't support other levels.
+	HeadingStyle string `yaml:"heading_style"`
+	// FinalNewline requires files to end with exactly one new line.
+	FinalNewline bool `yaml:"final_newline"`
+}
+
+// GetDescription implements Check.
+func (m *Markdown) GetDescription() string {
+	return "enforces markdown files style"
+}
+
+// GetName implements Check.
+func (m *Markdown) GetName() string {
+	return "markdown"
+}
+
+// GetPrerequisites implements Check.
+func (m *Markdown) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (m *Markdown) Run(change scm.Change, options *Options) Result {
+	return newResult(m.run(change, options), ParseIssues)
+}
+
+func (m *Markdown) run(change scm.Change, options *Options) error {
+	if m.HeadingStyle != "" && m.HeadingStyle != "atx" && m.HeadingStyle != "setext" {
+		return fmt.Errorf("invalid heading_style \"%s\"", m.HeadingStyle)
+	}
+	patterns := m.Files
+	if len(patterns) == 0 {
+		patterns = []string{"*.md"}
+	}
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().Files() {
+		if !matchAny(patterns, f) {
+			continue
+		}
+		if content := change.Content(f); content != nil {
+			for _, issue := range m.lint(string(content)) {
+				issue.File = f
+				bad = append(bad, issue)
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("markdown style failed:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// lint returns the issues found in content, without their file.
+func (m *Markdown) lint(content string) []Issue {
+	var out []Issue
+	add := func(line int, format string, a ...interface{}) {
+		out = append(out, Issue{Line: line, Message: fmt.Sprintf(format, a...)})
+	}
+	if m.FinalNewline && content != "" && (!strings.HasSuffix(content, "\n") || strings.HasSuffix(content, "\n\n")) {
+		add(strings.Count(content, "\n")+1, "file must end with exactly one new line")
+	}
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+	fence := ""
+	for i, line := range lines {
+		n := i + 1
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if m.TrailingSpaces && strings.TrimRight(line, " \t") != line {
+			add(n, "trailing whitespace")
+		}
+		if strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t") {
+			// Indented code block or list continuation.
+			continue
+		}
+		if m.HardTabs && strings.Contains(line, "\t") {
+			add(n, "hard tab")
+		}
+		if m.MaxLineLength != 0 && !strings.HasPrefix(trimmed, "|") && !strings.Contains(line, "://") {
+			if l := utf8.RuneCountInString(line); l > m.MaxLineLength {
+				add(n, "line is %d characters > %d", l, m.MaxLineLength)
+			}
+		}
+		if m.HeadingStyle == "setext" {
+			if h := reATXHeading.FindStringSubmatch(line); h != nil && len(h[1]) <= 2 {
+				add(n, "use setext heading style")
+			}
+		} else if m.HeadingStyle == "atx" && i > 0 && reSetextUnderline.MatchString(line) && isParagraph(lines[i-1]) {
+			add(n-1, "use atx heading style")
+		}
+	}
+	return out
+}
+
+// Private stuff.
+
+var (
+	reATXHeading      = regexp.MustCompile(`^(#{1,6})(\s|$)`)
+	reSetextUnderline = regexp.MustCompile(`^(=+|-+)\s*$`)
+)
+
+// isParagraph returns true if line can be a setext heading text.
+func isParagraph(line string) bool {
+	t := strings.TrimSpace(line)
+	return t != "" && !strings.HasPrefix(line, "    ") && !strings.HasPrefix(line, "\t") &&
+		!reATXHeading.MatchString(t) && !strings.HasPrefix(t, "- ") && !strings.HasPrefix(t, "* ") &&
+		!strings.HasPrefix(t, "```") && !reSetextUnderline.MatchString(t)
+}
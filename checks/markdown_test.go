@@ -0,0 +1,53 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestMarkdownLint(t *testing.T) {
+	t.Parallel()
+	content := "Title\n=====\n\n## Sub \n\nA\tb and a long line.\n\n```\n\tcode is ignored, even long lines \n```\n\n    indented\tcode\n\nSee http://example.com/a/very/long/url\n\n"
+	data := []struct {
+		m        Markdown
+		expected []Issue
+	}{
+		{Markdown{}, nil},
+		{Markdown{TrailingSpaces: true}, []Issue{{Line: 4, Message: "trailing whitespace"}}},
+		{Markdown{HardTabs: true}, []Issue{{Line: 6, Message: "hard tab"}}},
+		{Markdown{MaxLineLength: 15}, []Issue{{Line: 6, Message: "line is 20 characters > 15"}}},
+		{Markdown{HeadingStyle: "atx"}, []Issue{{Line: 1, Message: "use atx heading style"}}},
+		{Markdown{HeadingStyle: "setext"}, []Issue{{Line: 4, Message: "use setext heading style"}}},
+		{Markdown{FinalNewline: true}, []Issue{{Line: 16, Message: "file must end with exactly one new line"}}},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, line.m.lint(content))
+	}
+	// Horizontal rules are not headings.
+	ut.AssertEqual(t, []Issue(nil), (&Markdown{HeadingStyle: "atx"}).lint("- a\n---\n\nfoo\n\n---\n"))
+}
+
+func TestMarkdown(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"README.md":     "Foo \n",
+		"docs/guide.md": "Bar \n",
+		"notes.txt":     "Baz \n",
+	}
+	change := setup(t, td, files)
+	m := &Markdown{TrailingSpaces: true}
+	ut.AssertEqual(t, errors.New("markdown style failed:\nREADME.md:1: trailing whitespace\ndocs/guide.md:1: trailing whitespace"), m.Run(change, &Options{}).Err)
+	m.Files = []string{"docs/*.md", "*.txt"}
+	ut.AssertEqual(t, errors.New("markdown style failed:\ndocs/guide.md:1: trailing whitespace\nnotes.txt:1: trailing whitespace"), m.Run(change, &Options{}).Err)
+	m.HeadingStyle = "foo"
+	ut.AssertEqual(t, errors.New("invalid heading_style \"foo\""), m.Run(change, &Options{}).Err)
+}
@@ -0,0 +1,103 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// migrate upgrades configuration files written for older versions.
+
+package checks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+// MigrateConfig upgrades the content of a pre-commit-go.yml written for an
+// older version of pcg to the current format and sets min_version to v.
+//
+// It returns the new content and a description of each change done. The
+// comments at the top of the file are preserved, the other ones are lost.
+func MigrateConfig(content []byte, v string) ([]byte, []string, error) {
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(content, &doc); err != nil {
+		return nil, nil, err
+	}
+	var changes []string
+	out := yaml.MapSlice{{Key: "min_version", Value: v}}
+	hasMinVersion := false
+	for _, item := range doc {
+		switch item.Key {
+		case "version":
+			// The integer version was replaced with min_version.
+			changes = append(changes, fmt.Sprintf("removed version %v", item.Value))
+			continue
+		case "runlevels":
+			modes, err := migrateRunLevels(item.Value)
+			if err != nil {
+				return nil, nil, err
+			}
+			item = yaml.MapItem{Key: "modes", Value: modes}
+			changes = append(changes, "converted runlevels to modes")
+		case "min_version":
+			hasMinVersion = true
+			if fmt.Sprintf("%v", item.Value) != v {
+				changes = append(changes, fmt.Sprintf("bumped min_version from %v to %s", item.Value, v))
+			}
+			out[0].Value = v
+			continue
+		}
+		out = append(out, item)
+	}
+	if !hasMinVersion {
+		changes = append(changes, "added min_version "+v)
+	}
+	data, err := yaml.Marshal(out)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(headerComments(content), data...), changes, nil
+}
+
+// Private stuff.
+
+// runLevelModes maps the run levels of the format used before modes were
+// introduced to the equivalent modes.
+var runLevelModes = map[int]Mode{
+	0: Lint,
+	1: PreCommit,
+	2: PrePush,
+	3: ContinuousIntegration,
+}
+
+// migrateRunLevels converts the runlevels value to the modes value. The
+// settings of a run level and of a mode have the same format.
+func migrateRunLevels(value interface{}) (yaml.MapSlice, error) {
+	levels, ok := value.(yaml.MapSlice)
+	if !ok {
+		return nil, fmt.Errorf("invalid runlevels %v", value)
+	}
+	out := make(yaml.MapSlice, 0, len(levels))
+	for _, l := range levels {
+		level, ok := l.Key.(int)
+		mode, known := runLevelModes[level]
+		if !ok || !known {
+			return nil, fmt.Errorf("invalid run level %v", l.Key)
+		}
+		out = append(out, yaml.MapItem{Key: string(mode), Value: l.Value})
+	}
+	return out, nil
+}
+
+// headerComments returns the comment lines at the top of content, including
+// the empty line following them.
+func headerComments(content []byte) []byte {
+	lines := strings.SplitAfter(string(content), "\n")
+	i := 0
+	for ; i < len(lines); i++ {
+		if !strings.HasPrefix(lines[i], "#") && strings.TrimSpace(lines[i]) != "" {
+			break
+		}
+	}
+	return []byte(strings.Join(lines[:i], ""))
+}
@@ -0,0 +1,76 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+func TestMigrateConfigRunLevels(t *testing.T) {
+	t.Parallel()
+	content := `# Project configuration.
+
+version: 1
+runlevels:
+  1:
+    max_duration: 5
+    checks:
+      gofmt:
+      - {}
+  3:
+    checks:
+      build:
+      - build_all: true
+ignore_patterns:
+- .*
+`
+	data, changes, err := MigrateConfig([]byte(content), "0.4.7")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"removed version 1", "converted runlevels to modes", "added min_version 0.4.7"}, changes)
+	expected := `# Project configuration.
+
+min_version: 0.4.7
+modes:
+  pre-commit:
+    max_duration: 5
+    checks:
+      gofmt:
+      - {}
+  continuous-integration:
+    checks:
+      build:
+      - build_all: true
+ignore_patterns:
+- .*
+`
+	ut.AssertEqual(t, expected, string(data))
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(data, config))
+	ut.AssertEqual(t, 5, config.Modes[PreCommit].Options.MaxDuration)
+	ut.AssertEqual(t, &Build{BuildAll: true}, config.Modes[ContinuousIntegration].Checks["build"][0])
+}
+
+func TestMigrateConfigMinVersion(t *testing.T) {
+	t.Parallel()
+	data, changes, err := MigrateConfig([]byte("modes: {}\nmin_version: 0.4.0\n"), "0.4.7")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"bumped min_version from 0.4.0 to 0.4.7"}, changes)
+	ut.AssertEqual(t, "min_version: 0.4.7\nmodes: {}\n", string(data))
+	_, changes, err = MigrateConfig(data, "0.4.7")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string(nil), changes)
+}
+
+func TestMigrateConfigErrors(t *testing.T) {
+	t.Parallel()
+	_, _, err := MigrateConfig([]byte("runlevels:\n  7: {}\n"), "0.4.7")
+	ut.AssertEqual(t, errors.New("invalid run level 7"), err)
+	_, _, err = MigrateConfig([]byte("runlevels: foo\n"), "0.4.7")
+	ut.AssertEqual(t, errors.New("invalid runlevels foo"), err)
+}
@@ -0,0 +1,124 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// naming enforces file and package naming conventions.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Naming enforces file and package naming conventions on the modified Go
+// files.
+//
+// Each rule is disabled by default.
+type Naming struct {
+	Limits `yaml:",inline"`
+
+	// LowercaseFiles forbids uppercase characters and dashes in .go file names.
+	LowercaseFiles bool `yaml:"lowercase_files"`
+	// TestHelpers forbids non-test files from importing "testing", so test
+	// helpers live in *_test.go files. Packages whose name ends with "test",
+	// like httptest, are exempted.
+	TestHelpers bool `yaml:"test_helpers"`
+	// PackageName requires the package name to match the directory name.
+	// Package main and external test packages (suffix _test) are exempted.
+	PackageName bool `yaml:"package_name"`
+	// Banned is a list of forbidden package names, e.g. "util" or "common".
+	Banned []string `yaml:"banned"`
+}
+
+// GetDescription implements Check.
+func (n *Naming) GetDescription() string {
+	return "enforces file and package naming conventions"
+}
+
+// GetName implements Check.
+func (n *Naming) GetName() string {
+	return "naming"
+}
+
+// GetPrerequisites implements Check.
+func (n *Naming) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (n *Naming) Run(change scm.Change, options *Options) Result {
+	return newResult(n.run(change, options), ParseIssues)
+}
+
+func (n *Naming) run(change scm.Change, options *Options) error {
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		bad = append(bad, n.lintFileName(f)...)
+		if !n.TestHelpers && !n.PackageName && len(n.Banned) == 0 {
+			continue
+		}
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f, content, parser.ImportsOnly)
+		if err != nil {
+			bad = append(bad, parseErrorIssues(err)...)
+			continue
+		}
+		dir := path.Base(path.Dir(f))
+		if dir == "." {
+			dir = filepath.Base(change.Repo().Root())
+		}
+		bad = append(bad, n.lintPackage(f, file.Name.Name, dir)...)
+		if n.TestHelpers && !strings.HasSuffix(f, "_test.go") && !strings.HasSuffix(file.Name.Name, "test") {
+			for _, i := range file.Imports {
+				if p, _ := strconv.Unquote(i.Path.Value); p == "testing" {
+					bad = append(bad, issueAt(fset.Position(i.Pos()), "imports \"testing\"; move test helpers to a _test.go file"))
+				}
+			}
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("naming conventions not followed:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// lintFileName returns the issues with the file name of f.
+func (n *Naming) lintFileName(f string) []Issue {
+	if !n.LowercaseFiles {
+		return nil
+	}
+	base := path.Base(f)
+	if base != strings.ToLower(base) || strings.Contains(base, "-") {
+		return []Issue{{File: f, Message: "file name must be lowercase without dash"}}
+	}
+	return nil
+}
+
+// lintPackage returns the issues with the package name pkg of file f in
+// directory dir.
+func (n *Naming) lintPackage(f, pkg, dir string) []Issue {
+	var out []Issue
+	name := strings.TrimSuffix(pkg, "_test")
+	for _, b := range n.Banned {
+		if name == b {
+			out = append(out, Issue{File: f, Message: fmt.Sprintf("package name \"%s\" is not allowed", pkg)})
+		}
+	}
+	if n.PackageName && pkg != "main" && !strings.HasSuffix(pkg, "_test") && pkg != dir {
+		out = append(out, Issue{File: f, Message: fmt.Sprintf("package \"%s\" doesn't match directory \"%s\"", pkg, dir)})
+	}
+	return out
+}
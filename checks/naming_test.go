@@ -0,0 +1,48 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestNamingLintPackage(t *testing.T) {
+	t.Parallel()
+	n := &Naming{PackageName: true, Banned: []string{"util"}}
+	ut.AssertEqual(t, []Issue(nil), n.lintPackage("foo/a.go", "foo", "foo"))
+	ut.AssertEqual(t, []Issue(nil), n.lintPackage("foo/a.go", "main", "foo"))
+	ut.AssertEqual(t, []Issue(nil), n.lintPackage("foo/a_test.go", "foo_test", "foo"))
+	ut.AssertEqual(t, []Issue{{File: "foo/a.go", Message: "package \"bar\" doesn't match directory \"foo\""}}, n.lintPackage("foo/a.go", "bar", "foo"))
+	ut.AssertEqual(t, []Issue{{File: "util/a_test.go", Message: "package name \"util_test\" is not allowed"}}, n.lintPackage("util/a_test.go", "util_test", "util"))
+	ut.AssertEqual(t, []Issue(nil), (&Naming{}).lintPackage("foo/a.go", "bar", "foo"))
+}
+
+func TestNaming(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"foo.go":             "package foo\n",
+		"Foo-Bar.go":         "package foo\n",
+		"helper.go":          "package foo\n\nimport \"testing\"\n\nvar _ testing.T\n",
+		"helper_test.go":     "package foo\n\nimport \"testing\"\n\nvar _ testing.T\n",
+		"common/common.go":   "package common\n",
+		"footest/footest.go": "package footest\n\nimport \"testing\"\n\nvar _ testing.T\n",
+		"bar/bar.go":         "package baz\n",
+	}
+	change := setup(t, td, files)
+	ut.AssertEqual(t, nil, (&Naming{}).Run(change, &Options{}).Err)
+	n := &Naming{LowercaseFiles: true, TestHelpers: true, PackageName: true, Banned: []string{"common"}}
+	expected := "naming conventions not followed:\n" +
+		"Foo-Bar.go: file name must be lowercase without dash\n" +
+		"bar/bar.go: package \"baz\" doesn't match directory \"bar\"\n" +
+		"common/common.go: package name \"common\" is not allowed\n" +
+		"helper.go:3:8: imports \"testing\"; move test helpers to a _test.go file"
+	ut.AssertEqual(t, errors.New(expected), n.Run(change, &Options{}).Err)
+}
@@ -0,0 +1,146 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Configurations nested in subdirectories.
+
+package checks
+
+import (
+	"sort"
+	"strings"
+)
+
+// Nest returns a configuration running the checks of c on the files not in
+// the subdirectories of nested, and the checks of each nested configuration on
+// the files of its subdirectory, excluding its own nested subdirectories.
+//
+// nested maps a subdirectory, relative to the root and using "/", to its
+// configuration, usually merged over c. The paths and exclude_paths of the
+// checks of a nested configuration are relative to its subdirectory. The
+// options of each mode and the other settings are the ones of c.
+func (c *Config) Nest(nested map[string]*Config) *Config {
+	if len(nested) == 0 {
+		return c
+	}
+	dirs := make([]string, 0, len(nested))
+	for dir := range nested {
+		dirs = append(dirs, dir)
+	}
+	sort.Strings(dirs)
+	out := *c
+	out.Modes = map[Mode]Settings{}
+	out.Languages = map[string]*Language{}
+	addConfig(&out, c, "", subdirs("", dirs))
+	for _, dir := range dirs {
+		addConfig(&out, nested[dir], dir, subdirs(dir, dirs))
+	}
+	return &out
+}
+
+// Private stuff.
+
+// limited is implemented by the checks embedding Limits.
+type limited interface {
+	limits() *Limits
+}
+
+func (l *Limits) limits() *Limits {
+	return l
+}
+
+// addConfig adds the checks of src to dst, scoped to dir without the
+// subdirectories excluded.
+func addConfig(dst, src *Config, dir string, excluded []string) {
+	for mode, settings := range src.Modes {
+		s := dst.Modes[mode]
+		if s.Checks == nil {
+			s.Checks = Checks{}
+			if dir == "" {
+				s.Options = settings.Options
+			}
+		}
+		for name, checks := range settings.Checks {
+			for _, check := range checks {
+				scopeCheck(check, dir, excluded)
+				s.Checks[name] = append(s.Checks[name], check)
+			}
+		}
+		dst.Modes[mode] = s
+	}
+	for name, l := range src.Languages {
+		for _, settings := range l.Modes {
+			for _, checks := range settings.Checks {
+				for _, check := range checks {
+					scopeCheck(check, dir, excluded)
+				}
+			}
+		}
+		if dir != "" {
+			name += " in " + dir
+		}
+		dst.Languages[name] = l
+	}
+}
+
+// scopeCheck restricts the check to the files in dir, minus the excluded
+// subdirectories. The paths of the check are made relative to the root.
+func scopeCheck(check Check, dir string, excluded []string) {
+	l, ok := check.(limited)
+	if !ok {
+		return
+	}
+	limits := l.limits()
+	if dir != "" {
+		if len(limits.Paths) == 0 {
+			limits.Paths = []string{dir + "/**"}
+		} else {
+			limits.Paths = prefixPatterns(dir, limits.Paths)
+		}
+		limits.ExcludePaths = prefixPatterns(dir, limits.ExcludePaths)
+	}
+	for _, e := range excluded {
+		limits.ExcludePaths = append(limits.ExcludePaths, e+"/**")
+	}
+}
+
+// prefixPatterns makes the patterns relative to dir relative to the root. A
+// pattern without "/" matches the file name in any subdirectory of dir.
+func prefixPatterns(dir string, patterns []string) []string {
+	var out []string
+	for _, p := range patterns {
+		if strings.Contains(p, "/") {
+			out = append(out, dir+"/"+p)
+		} else {
+			out = append(out, dir+"/**/"+p)
+		}
+	}
+	return out
+}
+
+// subdirs returns the directories of dirs directly nested in dir, i.e. not
+// nested in another one of dirs.
+func subdirs(dir string, dirs []string) []string {
+	var out []string
+	for _, d := range dirs {
+		if d == dir || (dir != "" && !strings.HasPrefix(d, dir+"/")) {
+			continue
+		}
+		out = append(out, d)
+	}
+	// Remove the ones inside another one.
+	var direct []string
+	for _, d := range out {
+		inner := false
+		for _, o := range out {
+			if strings.HasPrefix(d, o+"/") {
+				inner = true
+				break
+			}
+		}
+		if !inner {
+			direct = append(direct, d)
+		}
+	}
+	return direct
+}
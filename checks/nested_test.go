@@ -0,0 +1,53 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestConfigNest(t *testing.T) {
+	t.Parallel()
+	root := &Config{
+		Modes: map[Mode]Settings{
+			PreCommit: {Options: Options{MaxDuration: 5}, Checks: Checks{"gofmt": {&Gofmt{}}}},
+		},
+		IgnorePatterns: []string{".*"},
+	}
+	ut.AssertEqual(t, root, root.Nest(nil))
+
+	a := &Config{
+		Modes: map[Mode]Settings{
+			PreCommit: {Options: Options{MaxDuration: 100}, Checks: Checks{
+				"gofmt":  {&Gofmt{}},
+				"golint": {&Golint{Limits: Limits{Paths: []string{"*.go", "pkg/*.go"}}}},
+			}},
+		},
+	}
+	ab := &Config{
+		Modes: map[Mode]Settings{
+			PrePush: {Checks: Checks{"gofmt": {&Gofmt{}}}},
+		},
+	}
+	out := root.Nest(map[string]*Config{"a": a, "a/b": ab})
+	expected := map[Mode]Settings{
+		PreCommit: {Options: Options{MaxDuration: 5}, Checks: Checks{
+			"gofmt": {
+				&Gofmt{Limits: Limits{ExcludePaths: []string{"a/**"}}},
+				&Gofmt{Limits: Limits{Paths: []string{"a/**"}, ExcludePaths: []string{"a/b/**"}}},
+			},
+			"golint": {
+				&Golint{Limits: Limits{Paths: []string{"a/**/*.go", "a/pkg/*.go"}, ExcludePaths: []string{"a/b/**"}}},
+			},
+		}},
+		PrePush: {Checks: Checks{
+			"gofmt": {&Gofmt{Limits: Limits{Paths: []string{"a/b/**"}}}},
+		}},
+	}
+	ut.AssertEqual(t, expected, out.Modes)
+	ut.AssertEqual(t, []string{".*"}, out.IgnorePatterns)
+}
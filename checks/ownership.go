@@ -0,0 +1,97 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Ownership based filtering of lint results.
+
+package checks
+
+import (
+	"log"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// owners caches the blame based attribution of files for a run.
+type owners struct {
+	lock   sync.Mutex
+	email  string
+	blames map[string][]string
+}
+
+func newOwners() *owners {
+	return &owners{blames: map[string][]string{}}
+}
+
+// isOwned returns true if line of file was last modified by the current user
+// or is not committed yet. line is 1 based; 0 means any line of the file.
+//
+// Errors are logged and the line is considered owned, so that an issue is
+// never silently dropped.
+func (o *owners) isOwned(repo scm.ReadOnlyRepo, file string, line int) bool {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+	if o.email == "" {
+		if o.email = repo.UserEmail(); o.email == "" {
+			log.Printf("owned_only: no user.email configured")
+			return true
+		}
+	}
+	authors, ok := o.blames[file]
+	if !ok {
+		var err error
+		if authors, err = repo.Blame(file); err != nil {
+			log.Printf("owned_only: %s", err)
+		}
+		o.blames[file] = authors
+	}
+	if authors == nil {
+		return true
+	}
+	if line == 0 {
+		for _, a := range authors {
+			if a == o.email || a == scm.NotCommittedYet {
+				return true
+			}
+		}
+		return false
+	}
+	if line > len(authors) {
+		return true
+	}
+	a := authors[line-1]
+	return a == o.email || a == scm.NotCommittedYet
+}
+
+// isOwned returns true if a lint result on file at line must be reported.
+//
+// It is always true unless OwnedOnly is set.
+func (o *Options) isOwned(change scm.Change, file string, line int) bool {
+	if !o.OwnedOnly || o.owners == nil {
+		return true
+	}
+	return o.owners.isOwned(change.Repo(), file, line)
+}
+
+// parseLintLine returns the file and line from an output line in the form
+// "file:line:col: message". The file is made relative to root. line is 0 if
+// it couldn't be parsed.
+func parseLintLine(root, l string) (string, int) {
+	// TODO(maruel): Will fail with files with ':' in their name.
+	items := strings.SplitN(l, ":", 3)
+	file := items[0]
+	if filepath.IsAbs(file) {
+		if rel, err := filepath.Rel(root, file); err == nil {
+			file = filepath.ToSlash(rel)
+		}
+	}
+	line := 0
+	if len(items) > 1 {
+		line, _ = strconv.Atoi(items[1])
+	}
+	return file, line
+}
@@ -0,0 +1,80 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestOwners(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	git := func(env []string, args ...string) {
+		out, code, err := internal.Capture(td, env, append([]string{"git"}, args...)...)
+		ut.AssertEqualf(t, 0, code, "%s", out)
+		ut.AssertEqual(t, nil, err)
+	}
+	git(nil, "init")
+	git(nil, "config", "user.email", "me@localhost")
+	git(nil, "config", "user.name", "me")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n\nvar a int\n"), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "b.go"), []byte("package a\n"), 0600))
+	git(nil, "add", ".")
+	git([]string{"GIT_AUTHOR_NAME=other", "GIT_AUTHOR_EMAIL=other@localhost"}, "commit", "-q", "-m", "other")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n\nvar a int\nvar b int\n"), 0600))
+	git(nil, "commit", "-q", "-a", "-m", "me")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "b.go"), []byte("package a\n\nvar c int\n"), 0600))
+
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	o := newOwners()
+	ut.AssertEqual(t, false, o.isOwned(repo, "a.go", 1))
+	ut.AssertEqual(t, false, o.isOwned(repo, "a.go", 3))
+	ut.AssertEqual(t, true, o.isOwned(repo, "a.go", 4))
+	ut.AssertEqual(t, true, o.isOwned(repo, "a.go", 0))
+	ut.AssertEqual(t, false, o.isOwned(repo, "b.go", 1))
+	ut.AssertEqual(t, true, o.isOwned(repo, "b.go", 3))
+	// Errors are never filtered out.
+	ut.AssertEqual(t, true, o.isOwned(repo, "missing.go", 1))
+	ut.AssertEqual(t, 3, len(o.blames))
+}
+
+func TestParseLintLine(t *testing.T) {
+	t.Parallel()
+	root, err := filepath.Abs("root")
+	ut.AssertEqual(t, nil, err)
+	data := []struct {
+		in   string
+		file string
+		line int
+	}{
+		{"foo.go:12:3: exported func Foo", "foo.go", 12},
+		{filepath.Join(root, "a", "b.go") + ":4:2\tb.Close()", "a/b.go", 4},
+		{"foo.go: something", "foo.go", 0},
+	}
+	for i, line := range data {
+		file, l := parseLintLine(root, line.in)
+		ut.AssertEqualIndex(t, i, line.file, file)
+		ut.AssertEqualIndex(t, i, line.line, l)
+	}
+}
+
+func TestOptionsMergeOwnedOnly(t *testing.T) {
+	t.Parallel()
+	o := (&Options{}).merge(Options{MaxDuration: 1})
+	ut.AssertEqual(t, false, o.OwnedOnly)
+	ut.AssertEqual(t, true, o.owners == nil)
+	o = o.merge(Options{OwnedOnly: true})
+	ut.AssertEqual(t, true, o.OwnedOnly)
+	ut.AssertEqual(t, true, o.owners != nil)
+	ut.AssertEqual(t, 1, o.MaxDuration)
+}
@@ -0,0 +1,184 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Profiles adjusting the options of the checks, layered over the modes.
+
+package checks
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+// Profile overrides some options of the checks in all the modes, e.g. to run
+// the tests with -short in a "quick" profile.
+type Profile struct {
+	// Checks maps a check type to the options overriding the ones of every
+	// instance of this type, as in a mode. The options not listed are kept.
+	Checks map[string]map[interface{}]interface{} `yaml:"checks"`
+}
+
+// ApplyProfile overrides the options of the checks with the ones of the
+// profile named name, in all the modes and languages.
+//
+// The options are validated by ValidateConfig.
+func (c *Config) ApplyProfile(name string) error {
+	p, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("unknown profile \"%s\"", name)
+	}
+	for n, override := range p.Checks {
+		if _, err := c.overrideChecks(n, override); err != nil {
+			return fmt.Errorf("profiles.%s.checks.%s: %s", name, n, err)
+		}
+	}
+	return nil
+}
+
+// SetOption overrides an option of every instance of a check, in all the
+// modes and languages. set is in the form "<check>.<option>=<value>", where
+// option can be a path like "global.min_coverage" and value is decoded as
+// YAML. A single value set on a list option is wrapped in a list, e.g.
+// "test.extra_args=-short". An empty value resets the option to its default.
+func (c *Config) SetOption(set string) error {
+	i := strings.Index(set, "=")
+	if i <= 0 || !strings.Contains(set[:i], ".") {
+		return fmt.Errorf("invalid option \"%s\", expected <check>.<option>=<value>", set)
+	}
+	keys := strings.Split(set[:i], ".")
+	factory, ok := KnownChecks[keys[0]]
+	if !ok {
+		return fmt.Errorf("unknown check \"%s\"", keys[0])
+	}
+	t, err := optionType(reflect.TypeOf(factory()), keys)
+	if err != nil {
+		return err
+	}
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(set[i+1:]), &v); err != nil {
+		return fmt.Errorf("%s: %s", set[:i], err)
+	}
+	if _, ok := v.([]interface{}); !ok && v != nil && t.Kind() == reflect.Slice {
+		v = []interface{}{v}
+	}
+	override := map[interface{}]interface{}{keys[len(keys)-1]: v}
+	for j := len(keys) - 2; j > 0; j-- {
+		override = map[interface{}]interface{}{keys[j]: override}
+	}
+	n, err := c.overrideChecks(keys[0], override)
+	if err != nil {
+		return fmt.Errorf("%s: %s", set[:i], err)
+	}
+	if n == 0 {
+		return fmt.Errorf("%s: %s is not enabled in any mode", set[:i], keys[0])
+	}
+	return nil
+}
+
+// MergeYAML returns base with the keys of override applied recursively, as
+// decoded by yaml. Lists and values are replaced; a nil value removes the key.
+func MergeYAML(base, override map[interface{}]interface{}) map[interface{}]interface{} {
+	out := make(map[interface{}]interface{}, len(base)+len(override))
+	for k, v := range base {
+		out[k] = v
+	}
+	for k, v := range override {
+		if v == nil {
+			delete(out, k)
+			continue
+		}
+		b, ok1 := out[k].(map[interface{}]interface{})
+		o, ok2 := v.(map[interface{}]interface{})
+		if ok1 && ok2 {
+			out[k] = MergeYAML(b, o)
+		} else {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+// Private stuff.
+
+// overrideChecks applies override to every instance of the check named name
+// and returns the number of instances overridden.
+func (c *Config) overrideChecks(name string, override map[interface{}]interface{}) (int, error) {
+	n := 0
+	apply := func(settings Settings) error {
+		for i, check := range settings.Checks[name] {
+			out, err := overrideCheck(check, override)
+			if err != nil {
+				return err
+			}
+			settings.Checks[name][i] = out
+			n++
+		}
+		return nil
+	}
+	for _, settings := range c.Modes {
+		if err := apply(settings); err != nil {
+			return n, err
+		}
+	}
+	for _, l := range c.Languages {
+		for _, settings := range l.Modes {
+			if err := apply(settings); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// optionType returns the type of the option at keys[1:] of the check type t,
+// keys[0] being the check name.
+func optionType(t reflect.Type, keys []string) (reflect.Type, error) {
+	for i, k := range keys[1:] {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			fields := map[string]reflect.Type{}
+			yamlFields(t, fields)
+			ft, ok := fields[k]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown key", strings.Join(keys[:i+2], "."))
+			}
+			t = ft
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return nil, fmt.Errorf("%s: unknown key", strings.Join(keys[:i+2], "."))
+		}
+	}
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t, nil
+}
+
+// overrideCheck returns a new instance of check with the options in override
+// applied.
+func overrideCheck(check Check, override map[interface{}]interface{}) (Check, error) {
+	content, err := yaml.Marshal(check)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[interface{}]interface{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+	if content, err = yaml.Marshal(MergeYAML(raw, override)); err != nil {
+		return nil, err
+	}
+	out := KnownChecks[check.GetName()]()
+	if err := yaml.Unmarshal(content, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
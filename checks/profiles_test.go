@@ -0,0 +1,103 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+func TestApplyProfile(t *testing.T) {
+	t.Parallel()
+	data := []byte(`modes:
+  pre-commit:
+    checks:
+      test:
+      - extra_args:
+        - -v
+        timeout: 60
+      coverage:
+      - global:
+          min_coverage: 50
+          max_coverage: 100
+  pre-push:
+    checks:
+      test:
+      - extra_args: []
+profiles:
+  quick:
+    checks:
+      test:
+        extra_args:
+        - -short
+      coverage:
+        global:
+          min_coverage: 20
+`)
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(data, config))
+	ut.AssertEqual(t, nil, ValidateConfig(data))
+	ut.AssertEqual(t, nil, config.ApplyProfile("quick"))
+	ut.AssertEqual(t, Checks{"test": {&Test{Limits: Limits{Timeout: 60}, ExtraArgs: []string{"-short"}}}, "coverage": {&Coverage{Global: CoverageSettings{MinCoverage: 20, MaxCoverage: 100}, PerDir: map[string]*CoverageSettings{}}}}, config.Modes[PreCommit].Checks)
+	ut.AssertEqual(t, Checks{"test": {&Test{ExtraArgs: []string{"-short"}}}}, config.Modes[PrePush].Checks)
+	ut.AssertEqual(t, errors.New("unknown profile \"slow\""), config.ApplyProfile("slow"))
+}
+
+func TestSetOption(t *testing.T) {
+	t.Parallel()
+	data := []byte(`modes:
+  pre-commit:
+    checks:
+      test:
+      - extra_args:
+        - -v
+        timeout: 60
+      coverage:
+      - global:
+          min_coverage: 50
+          max_coverage: 100
+`)
+	config := &Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(data, config))
+	ut.AssertEqual(t, nil, config.SetOption("coverage.global.min_coverage=70"))
+	ut.AssertEqual(t, nil, config.SetOption("test.extra_args=-run=TestFoo"))
+	ut.AssertEqual(t, nil, config.SetOption("test.timeout="))
+	ut.AssertEqual(t, Checks{"test": {&Test{ExtraArgs: []string{"-run=TestFoo"}}}, "coverage": {&Coverage{Global: CoverageSettings{MinCoverage: 70, MaxCoverage: 100}, PerDir: map[string]*CoverageSettings{}}}}, config.Modes[PreCommit].Checks)
+	ut.AssertEqual(t, nil, config.SetOption("test.extra_args=[-v, -short]"))
+	ut.AssertEqual(t, []string{"-v", "-short"}, config.Modes[PreCommit].Checks["test"][0].(*Test).ExtraArgs)
+
+	data2 := []struct {
+		set      string
+		expected error
+	}{
+		{"coverage", errors.New("invalid option \"coverage\", expected <check>.<option>=<value>")},
+		{"coverage=1", errors.New("invalid option \"coverage=1\", expected <check>.<option>=<value>")},
+		{"foo.bar=1", errors.New("unknown check \"foo\"")},
+		{"coverage.global.min=1", errors.New("coverage.global.min: unknown key")},
+		{"coverage.global.min_coverage.x=1", errors.New("coverage.global.min_coverage.x: unknown key")},
+		{"coverage.global.min_coverage=abc", errors.New("coverage.global.min_coverage: yaml: unmarshal errors:\n  line 3: cannot unmarshal !!str `abc` into float64")},
+		{"golint.blacklist=a", errors.New("golint.blacklist: golint is not enabled in any mode")},
+	}
+	for i, item := range data2 {
+		ut.AssertEqualIndex(t, i, item.expected, config.SetOption(item.set))
+	}
+}
+
+func TestValidateConfigProfile(t *testing.T) {
+	t.Parallel()
+	data := []byte("profiles:\n  quick:\n    checks:\n      test:\n        extra_arg: []\n      foo: {}\n")
+	ut.AssertEqual(t, errors.New("profiles.quick.checks.foo: unknown check \"foo\"\nprofiles.quick.checks.test.extra_arg: unknown key"), ValidateConfig(data))
+}
+
+func TestMergeYAML(t *testing.T) {
+	t.Parallel()
+	base := map[interface{}]interface{}{"a": 1, "b": map[interface{}]interface{}{"c": 2, "d": 3}, "e": []interface{}{1}}
+	override := map[interface{}]interface{}{"b": map[interface{}]interface{}{"c": 4, "d": nil}, "e": []interface{}{2}}
+	expected := map[interface{}]interface{}{"a": 1, "b": map[interface{}]interface{}{"c": 4}, "e": []interface{}{2}}
+	ut.AssertEqual(t, expected, MergeYAML(base, override))
+}
@@ -0,0 +1,195 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Detection of the changes no check cares about.
+
+package checks
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Relevant returns true if a modification of one of files, relative to root,
+// may change the outcome of one of the checks in enabled. It doesn't enumerate
+// the packages so it is cheap enough to skip the checks altogether, e.g. for a
+// documentation only commit.
+//
+// It errs on the side of true: the custom checks and the ones not limited to
+// some kind of files are affected by any file, the checks reading a
+// configuration or data file by it, and test and coverage by any file in a
+// directory containing Go sources or below, since the tests may read it.
+func Relevant(enabled []Check, root string, files []string) bool {
+	r := relevance{root: root, dirs: map[string]dirInfo{}}
+	for _, f := range files {
+		f = strings.Replace(f, "\\", "/", -1)
+		goInput := -1
+		isGoInput := func() bool {
+			if goInput == -1 {
+				goInput = 0
+				if r.isGoInput(f) {
+					goInput = 1
+				}
+			}
+			return goInput == 1
+		}
+		for _, c := range enabled {
+			if affects(c, f, &r, isGoInput) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Private stuff.
+
+// affects returns true if a modification of f may change the outcome of
+// check.
+func affects(check Check, f string, r *relevance, isGoInput func() bool) bool {
+	if l, ok := check.(*LanguageCheck); ok {
+		return l.Matches(f) && affects(l.Check, f, r, isGoInput)
+	}
+	if s, ok := check.(interface {
+		inScope(f string) bool
+	}); ok && !s.inScope(f) {
+		return false
+	}
+	switch c := check.(type) {
+	case *Asmfmt:
+		return strings.HasSuffix(f, ".s")
+	case *ConfigLint:
+		ext := path.Ext(f)
+		return (ext == ".yml" || ext == ".yaml" || ext == ".json" || ext == ".toml") && !matchAny(c.Exclude, f)
+	case *Hadolint:
+		return isDockerfile(f)
+	case *Markdown:
+		patterns := c.Files
+		if len(patterns) == 0 {
+			patterns = []string{"*.md"}
+		}
+		return matchAny(patterns, f)
+	case *Shellcheck:
+		return strings.HasSuffix(f, ".sh")
+	case *GolangciLint:
+		// golangci-lint looks up its configuration in the directories of the
+		// packages and their parents.
+		return isGoInput() || r.isFile(c.Config, f) || strings.HasPrefix(path.Base(f), ".golangci.")
+	case *GoDirective:
+		for _, ci := range c.CIFiles {
+			if r.isFile(ci, f) {
+				return true
+			}
+		}
+		return isGoInput()
+	case *Spelling:
+		return isGoInput() || r.isFile(c.Dictionary, f) || r.isFile(c.WordList, f)
+	case *Coverage, *Test:
+		return isGoInput() || r.inPackage(f)
+	case *ASTRule, *Boundaries, *Build, *BuildTags, *Clock, *Copyright, *CopyrightYear, *Embed, *Errcheck, *GoSum, *Gofmt, *Goimports, *Golint, *Govet, *Length, *ModReplace, *Naming, *SQLVet, *TestHygiene:
+		return isGoInput()
+	}
+	return true
+}
+
+// goInputExts are the extensions of the files compiled by the go tool besides
+// the Go sources.
+var goInputExts = map[string]bool{
+	".c": true, ".cc": true, ".cpp": true, ".cxx": true, ".h": true, ".hh": true,
+	".hpp": true, ".hxx": true, ".m": true, ".s": true, ".S": true, ".syso": true,
+}
+
+// dirInfo is what relevance needs to know about a directory.
+type dirInfo struct {
+	// goFiles is true if the directory contains a Go source.
+	goFiles bool
+	// embed is true if a Go source in the directory contains a //go:embed
+	// directive.
+	embed bool
+}
+
+// relevance memoizes the content of the directories.
+type relevance struct {
+	root string
+	dirs map[string]dirInfo
+}
+
+// isGoInput returns true if f may be an input of the go tool: a Go, assembly
+// or cgo source, a module file, a file in testdata or a file a Go source in its
+// directory or a parent one may embed.
+func (r *relevance) isGoInput(f string) bool {
+	base := path.Base(f)
+	if strings.HasSuffix(f, ".go") || goInputExts[path.Ext(f)] {
+		return true
+	}
+	switch base {
+	case "go.mod", "go.sum", "go.work", "go.work.sum":
+		return true
+	}
+	if strings.HasPrefix(f, "testdata/") || strings.Contains(f, "/testdata/") {
+		return true
+	}
+	for d := path.Dir(f); ; d = path.Dir(d) {
+		if r.dir(d).embed {
+			return true
+		}
+		if d == "." || d == "/" {
+			return false
+		}
+	}
+}
+
+// inPackage returns true if f is in a directory containing Go sources or
+// below one.
+func (r *relevance) inPackage(f string) bool {
+	for d := path.Dir(f); ; d = path.Dir(d) {
+		if r.dir(d).goFiles {
+			return true
+		}
+		if d == "." || d == "/" {
+			return false
+		}
+	}
+}
+
+// isFile returns true if the path p of a file read by a check, relative to the
+// root unless absolute, is f.
+func (r *relevance) isFile(p, f string) bool {
+	if p == "" {
+		return false
+	}
+	if filepath.IsAbs(p) {
+		rel, err := filepath.Rel(r.root, p)
+		if err != nil {
+			return false
+		}
+		p = rel
+	}
+	return path.Clean(filepath.ToSlash(p)) == f
+}
+
+// dir returns the information about the directory d.
+func (r *relevance) dir(d string) dirInfo {
+	if v, ok := r.dirs[d]; ok {
+		return v
+	}
+	var info dirInfo
+	dir := filepath.Join(r.root, filepath.FromSlash(d))
+	entries, _ := ioutil.ReadDir(dir)
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") {
+			continue
+		}
+		info.goFiles = true
+		if content, err := ioutil.ReadFile(filepath.Join(dir, e.Name())); err == nil && bytes.Contains(content, []byte("//go:embed")) {
+			info.embed = true
+			break
+		}
+	}
+	r.dirs[d] = info
+	return info
+}
@@ -0,0 +1,67 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestRelevant(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	ut.AssertEqual(t, nil, os.MkdirAll(filepath.Join(td, "web", "static"), 0700))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "web", "web.go"), []byte("package web\n//go:embed static\nvar static embed.FS\n"), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	ut.AssertEqual(t, nil, os.MkdirAll(filepath.Join(td, "pkg", "fixtures"), 0700))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pkg", "pkg.go"), []byte("package pkg\n"), 0600))
+
+	goChecks := []Check{&Gofmt{}, &Test{}}
+	data := []struct {
+		enabled  []Check
+		files    []string
+		expected bool
+	}{
+		{goChecks, nil, false},
+		{[]Check{&Gofmt{}}, []string{"README.md", "doc/guide.txt"}, false},
+		// The tests may read any file of their package.
+		{[]Check{&Test{}}, []string{"README.md"}, true},
+		{[]Check{&Coverage{}}, []string{"pkg/fixtures/input.json"}, true},
+		{[]Check{&Golint{}}, []string{"pkg/fixtures/input.json"}, false},
+		// The configuration and data files of the checks.
+		{[]Check{&GolangciLint{}}, []string{"doc/.golangci.yml"}, true},
+		{[]Check{&GolangciLint{Config: "ci/lint.yml"}}, []string{"ci/lint.yml"}, true},
+		{[]Check{&GolangciLint{}}, []string{"ci/lint.yml"}, false},
+		{[]Check{&GoDirective{CIFiles: []string{".github/workflows/test.yml"}}}, []string{".github/workflows/test.yml"}, true},
+		{[]Check{&GoDirective{}}, []string{".github/workflows/test.yml"}, false},
+		{[]Check{&Spelling{WordList: "doc/words.txt"}}, []string{"doc/words.txt"}, true},
+		{[]Check{&Spelling{Dictionary: filepath.Join(td, "doc", "dict.txt")}}, []string{"doc/dict.txt"}, true},
+		{[]Check{&Spelling{}}, []string{"doc/words.txt"}, false},
+		{goChecks, []string{"README.md", "a.go"}, true},
+		{goChecks, []string{"go.sum"}, true},
+		{goChecks, []string{"pkg/testdata/golden.txt"}, true},
+		{goChecks, []string{"web/static/index.html"}, true},
+		{goChecks, []string{"web/README.md"}, true},
+		{goChecks, []string{"pkg/impl.s"}, true},
+		{[]Check{&Markdown{}}, []string{"README.md"}, true},
+		{[]Check{&Markdown{}}, []string{"a.go"}, false},
+		{[]Check{&Gofmt{Limits: Limits{Paths: []string{"cmd/**"}}}}, []string{"a.go"}, false},
+		{[]Check{&Shellcheck{}, &Hadolint{}}, []string{"build/Dockerfile"}, true},
+		{[]Check{&Shellcheck{}, &Hadolint{}}, []string{"README.md"}, false},
+		{[]Check{&ConfigLint{}}, []string{"config.yml"}, true},
+		{[]Check{&LanguageCheck{Language: "python", Extensions: []string{".py"}, Check: &Custom{}}}, []string{"README.md"}, false},
+		{[]Check{&LanguageCheck{Language: "python", Extensions: []string{".py"}, Check: &Custom{}}}, []string{"a.py"}, true},
+		{[]Check{&Custom{}}, []string{"README.md"}, true},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, Relevant(line.enabled, td, line.files))
+	}
+}
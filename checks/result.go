@@ -0,0 +1,172 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Outcome of a check, with the issues parsed from the output of the tools.
+
+package checks
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Issue is a problem reported by a check at a specific location.
+type Issue struct {
+	// File is relative to the repository root, in slash form.
+	File string `json:"file"`
+	// Line and Column are 1-based, 0 when unknown.
+	Line    int    `json:"line,omitempty"`
+	Column  int    `json:"column,omitempty"`
+	Message string `json:"message"`
+	// Severity is SeverityError, unless the check is configured with severity:
+	// warning.
+	Severity Severity `json:"severity,omitempty"`
+	// Diff is the unified diff fixing the issue, e.g. for a formatting issue.
+	Diff string `json:"diff,omitempty"`
+}
+
+// Result is the outcome of Check.Run.
+type Result struct {
+	// Err is the failure of the check, nil when it passes. Its message includes
+	// the output of the tools run.
+	Err error
+	// Issues are the locations parsed from the output of the tools, when they
+	// list some.
+	Issues []Issue
+	// Coverage is the coverage measured by the check, if any.
+	Coverage CoverageProfile
+}
+
+// ParseIssues returns the issues listed in out as "file:line[:col]: message",
+// the format used by the compiler, go vet, golint and most linters.
+func ParseIssues(out string) []Issue {
+	var issues []Issue
+	for _, m := range reIssue.FindAllStringSubmatch(out, -1) {
+		i := Issue{File: m[1], Message: m[4], Severity: SeverityError}
+		i.Line, _ = strconv.Atoi(m[2])
+		i.Column, _ = strconv.Atoi(m[3])
+		issues = append(issues, i)
+	}
+	return issues
+}
+
+// Private stuff.
+
+// issueAt returns an issue at pos, as returned by token.FileSet.Position.
+func issueAt(pos token.Position, format string, a ...interface{}) Issue {
+	return Issue{File: pos.Filename, Line: pos.Line, Column: pos.Column, Message: fmt.Sprintf(format, a...)}
+}
+
+// parseErrorIssues returns the issues of an error returned by go/parser.
+func parseErrorIssues(err error) []Issue {
+	list, ok := err.(scanner.ErrorList)
+	if !ok {
+		return []Issue{{Message: err.Error()}}
+	}
+	out := make([]Issue, 0, len(list))
+	for _, e := range list {
+		out = append(out, issueAt(e.Pos, "%s", e.Msg))
+	}
+	return out
+}
+
+// formatIssues returns the issues sorted by location, one per line as
+// "file:line:col: message", the format read by ParseIssues. The parts of the
+// location that are unknown are omitted.
+func formatIssues(issues []Issue) string {
+	sort.Sort(issuesByLocation(issues))
+	lines := make([]string, 0, len(issues))
+	for _, i := range issues {
+		loc := i.File
+		if i.Line != 0 {
+			loc += ":" + strconv.Itoa(i.Line)
+			if i.Column != 0 {
+				loc += ":" + strconv.Itoa(i.Column)
+			}
+		}
+		if loc == "" {
+			lines = append(lines, i.Message)
+		} else {
+			lines = append(lines, loc+": "+i.Message)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// reIssue matches "file.ext:line[:col]: message" at the start of a line,
+// ignoring the leading spaces.
+var reIssue = regexp.MustCompile(`(?m)^\s*([^\s:]+\.[A-Za-z0-9]+):(\d+)(?::(\d+))?:\s*(.*?)\s*$`)
+
+// reGoTest matches the header of the output of a failed package in the errors
+// returned by Test and Coverage, e.g. "go test -timeout 120s ./foo failed:".
+var reGoTest = regexp.MustCompile(`^go test .* (\S+) failed:`)
+
+// newResult returns the result of a check that failed with err, the issues
+// parsed with parse.
+func newResult(err error, parse func(string) []Issue) Result {
+	if err == nil {
+		return Result{}
+	}
+	return Result{Err: err, Issues: parse(err.Error())}
+}
+
+// parseGoTestIssues parses the output of go test. The failures logged by the
+// tests only have the file base name; it is prefixed with the directory of
+// the package.
+func parseGoTestIssues(out string) []Issue {
+	var issues []Issue
+	pkg := "."
+	for _, line := range strings.Split(out, "\n") {
+		if m := reGoTest.FindStringSubmatch(line); m != nil {
+			pkg = path.Clean(m[1])
+			continue
+		}
+		for _, i := range ParseIssues(line) {
+			if !strings.Contains(i.File, "/") {
+				i.File = path.Join(pkg, i.File)
+			}
+			issues = append(issues, i)
+		}
+	}
+	return issues
+}
+
+// parseFormatIssues parses the error returned by Gofmt and Goimports, one
+// improperly formatted file per line after the first one, then optionally an
+// empty line followed by the diffs of the files as printed by gofmt -d.
+func parseFormatIssues(out string) []Issue {
+	lines := strings.Split(out, "\n")
+	if len(lines) < 2 || !strings.HasPrefix(lines[0], "these files are improperly") {
+		return nil
+	}
+	var issues []Issue
+	index := map[string]int{}
+	i := 1
+	for ; i < len(lines) && lines[i] != ""; i++ {
+		index[lines[i]] = len(issues)
+		issues = append(issues, Issue{File: lines[i], Message: "improperly formatted", Severity: SeverityError})
+	}
+	// Each diff starts with "diff [-u] <file>.orig <file>".
+	j := -1
+	for _, line := range lines[i:] {
+		if strings.HasPrefix(line, "diff ") {
+			fields := strings.Fields(line)
+			j = -1
+			if k, ok := index[fields[len(fields)-1]]; ok {
+				j = k
+			}
+			continue
+		}
+		if j != -1 {
+			issues[j].Diff += line + "\n"
+		}
+	}
+	return issues
+}
@@ -0,0 +1,80 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestParseIssues(t *testing.T) {
+	t.Parallel()
+	out := "golint failed:\na.go:12:5: exported func Foo should have comment\n  b/c.go:3: unreachable code\nnot an issue: foo\n"
+	expected := []Issue{
+		{File: "a.go", Line: 12, Column: 5, Message: "exported func Foo should have comment", Severity: SeverityError},
+		{File: "b/c.go", Line: 3, Message: "unreachable code", Severity: SeverityError},
+	}
+	ut.AssertEqual(t, expected, ParseIssues(out))
+	ut.AssertEqual(t, []Issue(nil), ParseIssues("build failed"))
+}
+
+func TestParseGoTestIssues(t *testing.T) {
+	t.Parallel()
+	out := "go test -timeout 120s ./foo failed:\n--- FAIL: TestFoo (0.00s)\n    foo_test.go:12: got 1\nFAIL\ngo test -timeout 120s . failed:\nbar/bar.go:3:2: undefined: x\n    a_test.go:5: bad"
+	expected := []Issue{
+		{File: "foo/foo_test.go", Line: 12, Message: "got 1", Severity: SeverityError},
+		{File: "bar/bar.go", Line: 3, Column: 2, Message: "undefined: x", Severity: SeverityError},
+		{File: "a_test.go", Line: 5, Message: "bad", Severity: SeverityError},
+	}
+	ut.AssertEqual(t, expected, parseGoTestIssues(out))
+}
+
+func TestParseFormatIssues(t *testing.T) {
+	t.Parallel()
+	out := "these files are improperly formmatted, please run: gofmt -w -s .\na.go\nb/c.go"
+	expected := []Issue{
+		{File: "a.go", Message: "improperly formatted", Severity: SeverityError},
+		{File: "b/c.go", Message: "improperly formatted", Severity: SeverityError},
+	}
+	ut.AssertEqual(t, expected, parseFormatIssues(out))
+	ut.AssertEqual(t, []Issue(nil), parseFormatIssues("gofmt -l -s . failed: exit status 2"))
+
+	out += "\n\ndiff b/c.go.orig b/c.go\n--- b/c.go.orig\n+++ b/c.go\n@@ -1,2 +1,2 @@\n package c\n-func  f() {}\n+func f() {}"
+	expected[1].Diff = "--- b/c.go.orig\n+++ b/c.go\n@@ -1,2 +1,2 @@\n package c\n-func  f() {}\n+func f() {}\n"
+	ut.AssertEqual(t, expected, parseFormatIssues(out))
+}
+
+func TestNewResult(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, Result{}, newResult(nil, ParseIssues))
+	err := errors.New("vet failed:\na.go:1: bad")
+	ut.AssertEqual(t, Result{Err: err, Issues: []Issue{{File: "a.go", Line: 1, Message: "bad", Severity: SeverityError}}}, newResult(err, ParseIssues))
+}
+
+func TestFormatIssues(t *testing.T) {
+	t.Parallel()
+	issues := []Issue{
+		{File: "b.go", Line: 10, Column: 2, Message: "d"},
+		{File: "b.go", Line: 9, Column: 20, Message: "c"},
+		{File: "b.go", Line: 9, Column: 3, Message: "b"},
+		{File: "a.go", Message: "a"},
+		{Message: "no location"},
+	}
+	ut.AssertEqual(t, "no location\na.go: a\nb.go:9:3: b\nb.go:9:20: c\nb.go:10:2: d", formatIssues(issues))
+	ut.AssertEqual(t, "", formatIssues(nil))
+}
+
+func TestParseErrorIssues(t *testing.T) {
+	t.Parallel()
+	_, err := parser.ParseFile(token.NewFileSet(), "a.go", "package a\nfunc {", 0)
+	issues := parseErrorIssues(err)
+	ut.AssertEqual(t, "a.go", issues[0].File)
+	ut.AssertEqual(t, 2, issues[0].Line)
+	ut.AssertEqual(t, []Issue{{Message: "foo"}}, parseErrorIssues(errors.New("foo")))
+}
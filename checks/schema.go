@@ -0,0 +1,116 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// schema generates the JSON Schema of pre-commit-go.yml.
+
+package checks
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Schema returns the JSON Schema of pre-commit-go.yml, generated from Config
+// and the options of all KnownChecks. Marshal it with encoding/json.
+func Schema() map[string]interface{} {
+	names := make([]string, 0, len(KnownChecks))
+	for name := range KnownChecks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	checks := map[string]interface{}{}
+	for _, name := range names {
+		c := KnownChecks[name]()
+		checks[name] = map[string]interface{}{
+			"description": c.GetDescription(),
+			"type":        "array",
+			"items":       typeSchema(reflect.TypeOf(c)),
+		}
+	}
+	out := typeSchema(reflect.TypeOf(Config{}))
+	out["$schema"] = "http://json-schema.org/draft-07/schema#"
+	out["title"] = configBaseName + ".yml"
+	out["definitions"] = map[string]interface{}{
+		"checks": map[string]interface{}{
+			"type":                 "object",
+			"properties":           checks,
+			"additionalProperties": false,
+		},
+	}
+	return out
+}
+
+// Private stuff.
+
+var (
+	typeMode      = reflect.TypeOf(Mode(""))
+	typeSeverity  = reflect.TypeOf(Severity(""))
+	typeUntracked = reflect.TypeOf(scm.Untracked(""))
+)
+
+// typeSchema returns the JSON Schema of the values of type t, as serialized by
+// yaml.v2.
+func typeSchema(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t {
+	case typeChecks:
+		return map[string]interface{}{"$ref": "#/definitions/checks"}
+	case typeMode:
+		return map[string]interface{}{"type": "string", "pattern": reModeName.String()}
+	case typeSeverity:
+		return map[string]interface{}{"type": "string", "enum": []Severity{SeverityError, SeverityWarning}}
+	case typeUntracked:
+		return map[string]interface{}{"type": "string", "enum": []scm.Untracked{scm.UntrackedExclude, scm.UntrackedInclude, scm.UntrackedFail}}
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		fields := map[string]reflect.Type{}
+		yamlFields(t, fields)
+		properties := map[string]interface{}{}
+		for name, ft := range fields {
+			properties[name] = typeSchema(ft)
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"properties":           properties,
+			"additionalProperties": false,
+		}
+	case reflect.Map:
+		if t.Key() == typeMode {
+			// The predefined modes are listed for completion; custom modes must
+			// have a valid name.
+			properties := map[string]interface{}{}
+			for _, m := range AllModes {
+				properties[string(m)] = typeSchema(t.Elem())
+			}
+			return map[string]interface{}{
+				"type":                 "object",
+				"properties":           properties,
+				"patternProperties":    map[string]interface{}{reModeName.String(): typeSchema(t.Elem())},
+				"additionalProperties": false,
+			}
+		}
+		return map[string]interface{}{
+			"type":                 "object",
+			"additionalProperties": typeSchema(t.Elem()),
+		}
+	case reflect.Slice, reflect.Array:
+		return map[string]interface{}{"type": "array", "items": typeSchema(t.Elem())}
+	case reflect.String:
+		// yaml.v2 accepts unquoted numbers for strings, e.g. "min_version: 0.5".
+		return map[string]interface{}{"type": []string{"string", "number"}}
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]interface{}{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	}
+	// interface{} and the like accept anything.
+	return map[string]interface{}{}
+}
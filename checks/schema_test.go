@@ -0,0 +1,63 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+	s := Schema()
+	_, err := json.Marshal(s)
+	ut.AssertEqual(t, nil, err)
+	properties := s["properties"].(map[string]interface{})
+	ut.AssertEqual(t, map[string]interface{}{"type": []string{"string", "number"}}, properties["min_version"])
+	modes := properties["modes"].(map[string]interface{})["properties"].(map[string]interface{})
+	ut.AssertEqual(t, len(AllModes), len(modes))
+	settings := modes["pre-commit"].(map[string]interface{})["properties"].(map[string]interface{})
+	ut.AssertEqual(t, map[string]interface{}{"$ref": "#/definitions/checks"}, settings["checks"])
+	ut.AssertEqual(t, map[string]interface{}{"type": "integer"}, settings["max_duration"])
+
+	checks := s["definitions"].(map[string]interface{})["checks"].(map[string]interface{})["properties"].(map[string]interface{})
+	ut.AssertEqual(t, len(KnownChecks), len(checks))
+	build := checks["build"].(map[string]interface{})
+	ut.AssertEqual(t, (&Build{}).GetDescription(), build["description"])
+	stringList := map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": []string{"string", "number"}}}
+	expected := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"build_all":     map[string]interface{}{"type": "boolean"},
+			"extra_args":    map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": []string{"string", "number"}}},
+			"timeout":       map[string]interface{}{"type": "integer"},
+			"paths":         map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": []string{"string", "number"}}},
+			"exclude_paths": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": []string{"string", "number"}}},
+			"when": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"goos":        stringList,
+					"goarch":      stringList,
+					"env":         stringList,
+					"executables": stringList,
+					"ci":          map[string]interface{}{"type": "boolean"},
+				},
+				"additionalProperties": false,
+			},
+			"env":        map[string]interface{}{"type": "object", "additionalProperties": map[string]interface{}{"type": []string{"string", "number"}}},
+			"cwd":        map[string]interface{}{"type": []string{"string", "number"}},
+			"nice":       map[string]interface{}{"type": "integer"},
+			"cpus":       map[string]interface{}{"type": "integer"},
+			"memory_mb":  map[string]interface{}{"type": "integer"},
+			"depends_on": stringList,
+			"weight":     map[string]interface{}{"type": "integer"},
+			"severity":   map[string]interface{}{"type": "string", "enum": []Severity{SeverityError, SeverityWarning}},
+		},
+		"additionalProperties": false,
+	}
+	ut.AssertEqual(t, expected, build["items"])
+}
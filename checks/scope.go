@@ -0,0 +1,151 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Per check file scoping.
+
+package checks
+
+import (
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Scope implements Limiter.
+func (l *Limits) Scope(change scm.Change) scm.Change {
+	if len(l.Paths) == 0 && len(l.ExcludePaths) == 0 {
+		return change
+	}
+	s := newScopedChange(change, l.inScope)
+	if len(s.changed.files) == 0 {
+		return nil
+	}
+	return s
+}
+
+// inScope returns true if the file f matches Paths, when set, and doesn't
+// match ExcludePaths.
+func (l *Limits) inScope(f string) bool {
+	if len(l.Paths) != 0 && !matchAny(l.Paths, f) {
+		return false
+	}
+	return !matchAny(l.ExcludePaths, f)
+}
+
+// scopedChange is a scm.Change that only exposes the files in the scope of a
+// check. The files out of scope are also reported as ignored, for the checks
+// running tools on the whole tree.
+type scopedChange struct {
+	scm.Change
+	keep     func(f string) bool
+	changed  scopedSet
+	indirect scopedSet
+	all      scopedSet
+
+	filterOnce sync.Once
+	filtered   *scopedChange
+}
+
+func newScopedChange(change scm.Change, keep func(f string) bool) *scopedChange {
+	s := &scopedChange{Change: change, keep: keep}
+	keptDirs := map[string]bool{}
+	for _, f := range change.All().GoFiles() {
+		if keep(f) {
+			keptDirs[path.Dir(strings.Replace(f, "\\", "/", -1))] = true
+		}
+	}
+	s.changed = newScopedSet(change.Changed(), keep, keptDirs)
+	s.indirect = newScopedSet(change.Indirect(), keep, keptDirs)
+	s.all = newScopedSet(change.All(), keep, keptDirs)
+	return s
+}
+
+func (s *scopedChange) Changed() scm.Set {
+	return &s.changed
+}
+
+func (s *scopedChange) Indirect() scm.Set {
+	return &s.indirect
+}
+
+func (s *scopedChange) All() scm.Set {
+	return &s.all
+}
+
+func (s *scopedChange) IsIgnored(p string) bool {
+	return !s.keep(p) || s.Change.IsIgnored(p)
+}
+
+func (s *scopedChange) Filtered() scm.Change {
+	s.filterOnce.Do(func() {
+		if f := s.Change.Filtered(); f == s.Change {
+			s.filtered = s
+		} else {
+			s.filtered = newScopedChange(f, s.keep)
+		}
+	})
+	return s.filtered
+}
+
+func (s *scopedChange) Tree() []string {
+	var out []string
+	for _, f := range s.Change.Tree() {
+		if s.keep(f) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// scopedSet implements scm.Set. A package is kept when at least one of its Go
+// files is in scope.
+type scopedSet struct {
+	files        []string
+	goFiles      []string
+	packages     []string
+	testPackages []string
+}
+
+func newScopedSet(s scm.Set, keep func(f string) bool, keptDirs map[string]bool) scopedSet {
+	var out scopedSet
+	for _, f := range s.Files() {
+		if keep(f) {
+			out.files = append(out.files, f)
+		}
+	}
+	for _, f := range s.GoFiles() {
+		if keep(f) {
+			out.goFiles = append(out.goFiles, f)
+		}
+	}
+	for _, p := range s.Packages() {
+		if keptDirs[pkgToDir(p)] {
+			out.packages = append(out.packages, p)
+		}
+	}
+	for _, p := range s.TestPackages() {
+		if keptDirs[pkgToDir(p)] {
+			out.testPackages = append(out.testPackages, p)
+		}
+	}
+	return out
+}
+
+func (s *scopedSet) Files() []string {
+	return s.files
+}
+
+func (s *scopedSet) GoFiles() []string {
+	return s.goFiles
+}
+
+func (s *scopedSet) Packages() []string {
+	return s.packages
+}
+
+func (s *scopedSet) TestPackages() []string {
+	return s.testPackages
+}
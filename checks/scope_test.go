@@ -0,0 +1,47 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestLimitsScope(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"foo.go":              "package foo\n",
+		"pkg/a/a.go":          "package a\n",
+		"pkg/a/a_test.go":     "package a\n",
+		"pkg/b/b.go":          "package b\n",
+		"pkg/b/testdata/t.go": "package t\n",
+		"api/x.proto":         "syntax = \"proto3\";\n",
+	}
+	change := setup(t, td, files)
+
+	l := &Limits{}
+	ut.AssertEqual(t, change, l.Scope(change))
+
+	l = &Limits{Paths: []string{"pkg/**"}, ExcludePaths: []string{"**/testdata/**"}}
+	scoped := l.Scope(change)
+	ut.AssertEqual(t, []string{"pkg/a/a.go", "pkg/a/a_test.go", "pkg/b/b.go"}, scoped.Changed().Files())
+	ut.AssertEqual(t, []string{"pkg/a/a.go", "pkg/a/a_test.go", "pkg/b/b.go"}, scoped.Changed().GoFiles())
+	ut.AssertEqual(t, []string{"./pkg/a", "./pkg/b"}, scoped.Changed().Packages())
+	ut.AssertEqual(t, []string{"./pkg/a"}, scoped.Changed().TestPackages())
+	ut.AssertEqual(t, []string{"./pkg/a", "./pkg/b"}, scoped.All().Packages())
+	ut.AssertEqual(t, true, scoped.IsIgnored("foo.go"))
+	ut.AssertEqual(t, true, scoped.IsIgnored("pkg/b/testdata/t.go"))
+	ut.AssertEqual(t, false, scoped.IsIgnored("pkg/b/b.go"))
+
+	l = &Limits{Paths: []string{"*.proto"}}
+	ut.AssertEqual(t, []string{"api/x.proto"}, l.Scope(change).Changed().Files())
+
+	l = &Limits{Paths: []string{"docs/**"}}
+	ut.AssertEqual(t, nil, l.Scope(change))
+}
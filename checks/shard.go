@@ -0,0 +1,65 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// shard splits the work of a run across parallel CI jobs.
+
+package checks
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Shard is the part of a run done by one of Count parallel CI jobs.
+//
+// The assignment only depends on the items, so all the jobs compute the same
+// partition without sharing any state. A nil *Shard selects everything.
+type Shard struct {
+	// Index is the 0-based index of this job, less than Count.
+	Index int
+	Count int
+}
+
+// ParseShard parses "N/M", for the N-th of M jobs with N starting at 1.
+func ParseShard(s string) (*Shard, error) {
+	var n, m int
+	var extra string
+	if c, _ := fmt.Sscanf(s, "%d/%d%s", &n, &m, &extra); c != 2 || n < 1 || n > m {
+		return nil, fmt.Errorf("invalid shard \"%s\", expected N/M with 1 <= N <= M", s)
+	}
+	return &Shard{Index: n - 1, Count: m}, nil
+}
+
+// String returns the shard as "N/M", empty for a nil *Shard.
+func (s *Shard) String() string {
+	if s == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", s.Index+1, s.Count)
+}
+
+// Select returns the items assigned to this shard, in their original order.
+//
+// The items are dealt to the shards in the order of their names.
+func (s *Shard) Select(items []string) []string {
+	if s == nil || s.Count <= 1 {
+		return items
+	}
+	sorted := make([]string, len(items))
+	copy(sorted, items)
+	sort.Strings(sorted)
+	selected := map[string]bool{}
+	for i, item := range sorted {
+		if i%s.Count == s.Index {
+			selected[item] = true
+		}
+	}
+	var out []string
+	for _, item := range items {
+		if selected[item] {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,40 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestParseShard(t *testing.T) {
+	t.Parallel()
+	s, err := ParseShard("2/3")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, &Shard{Index: 1, Count: 3}, s)
+	ut.AssertEqual(t, "2/3", s.String())
+	ut.AssertEqual(t, "", (*Shard)(nil).String())
+	for _, v := range []string{"", "1", "0/3", "4/3", "1/2/3", "a/b"} {
+		_, err := ParseShard(v)
+		ut.AssertEqual(t, errors.New("invalid shard \""+v+"\", expected N/M with 1 <= N <= M"), err)
+	}
+}
+
+func TestShardSelect(t *testing.T) {
+	t.Parallel()
+	items := []string{"./a", "./b", "./c", "./d", "./e"}
+	ut.AssertEqual(t, items, (*Shard)(nil).Select(items))
+	ut.AssertEqual(t, items, (&Shard{Count: 1}).Select(items))
+
+	// The items are dealt by name, whatever their order.
+	ut.AssertEqual(t, []string{"./a", "./c", "./e"}, (&Shard{Index: 0, Count: 2}).Select(items))
+	ut.AssertEqual(t, []string{"./b", "./d"}, (&Shard{Index: 1, Count: 2}).Select(items))
+	ut.AssertEqual(t, []string{"./e", "./c", "./a"}, (&Shard{Index: 0, Count: 2}).Select([]string{"./e", "./d", "./c", "./b", "./a"}))
+
+	// More shards than items.
+	ut.AssertEqual(t, []string(nil), (&Shard{Index: 5, Count: 6}).Select(items))
+}
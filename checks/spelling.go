@@ -0,0 +1,294 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// spelling catches typos in the public API.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Spelling spellchecks the exported identifier names and their doc comments in
+// the modified Go files against a dictionary.
+//
+// Unlike misspell, which looks for common misspellings in the whole text, it
+// reports every word not found in the dictionary so typos do not fossilize
+// into the public API. Words shorter than MinLength, all caps words like
+// acronyms and words matching an identifier declared in the file are ignored.
+type Spelling struct {
+	Limits `yaml:",inline"`
+
+	// Dictionary is the path of the dictionary file, with one word per line.
+	// Defaults to "/usr/share/dict/words".
+	Dictionary string `yaml:"dictionary"`
+	// WordList is the path, relative to the repository root, of the project
+	// word list file, with one word per line. Lines starting with '#' are
+	// ignored. Optional.
+	WordList string `yaml:"word_list"`
+	// MinLength is the minimum length of the words to check. Defaults to 3.
+	MinLength int `yaml:"min_length"`
+}
+
+// GetDescription implements Check.
+func (s *Spelling) GetDescription() string {
+	return "spellchecks exported identifiers and their doc comments"
+}
+
+// GetName implements Check.
+func (s *Spelling) GetName() string {
+	return "spelling"
+}
+
+// GetPrerequisites implements Check.
+func (s *Spelling) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (s *Spelling) Run(change scm.Change, options *Options) Result {
+	return newResult(s.run(change, options), ParseIssues)
+}
+
+func (s *Spelling) run(change scm.Change, options *Options) error {
+	dictionary := s.Dictionary
+	if dictionary == "" {
+		dictionary = "/usr/share/dict/words"
+	}
+	content, err := ioutil.ReadFile(dictionary)
+	if err != nil {
+		return fmt.Errorf("failed to read dictionary: %s; install a words list or set dictionary", err)
+	}
+	words := map[string]bool{}
+	addWords(words, content)
+	if s.WordList != "" {
+		content, err := ioutil.ReadFile(filepath.Join(change.Repo().Root(), filepath.FromSlash(s.WordList)))
+		if err != nil {
+			return fmt.Errorf("failed to read word_list: %s", err)
+		}
+		addWords(words, content)
+	}
+	minLength := s.MinLength
+	if minLength <= 0 {
+		minLength = 3
+	}
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		if strings.HasSuffix(f, "_test.go") {
+			continue
+		}
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f, content, parser.ParseComments)
+		if err != nil {
+			bad = append(bad, parseErrorIssues(err)...)
+			continue
+		}
+		c := &spellchecker{fset: fset, words: words, minLength: minLength, known: map[string]bool{}}
+		c.check(file)
+		bad = append(bad, c.bad...)
+	}
+	if len(bad) != 0 {
+		return errors.New("unknown words, fix them or add them to the word list:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// Private stuff.
+
+var (
+	// reWord matches the words in a comment, including Go identifiers.
+	reWord = regexp.MustCompile(`[A-Za-z][A-Za-z0-9_]*`)
+	// reURL matches URLs, which are not spellchecked.
+	reURL = regexp.MustCompile(`[a-z]+://\S+`)
+)
+
+// spellchecker spellchecks one file.
+type spellchecker struct {
+	fset      *token.FileSet
+	words     map[string]bool
+	minLength int
+	// known is the set of identifiers declared in the file.
+	known map[string]bool
+	bad   []Issue
+}
+
+func (c *spellchecker) check(file *ast.File) {
+	ast.Inspect(file, func(n ast.Node) bool {
+		if i, ok := n.(*ast.Ident); ok {
+			c.known[i.Name] = true
+		}
+		return true
+	})
+	c.comment(file.Doc)
+	for _, decl := range file.Decls {
+		switch d := decl.(type) {
+		case *ast.FuncDecl:
+			if d.Name.IsExported() && (d.Recv == nil || isExportedRecv(d.Recv)) {
+				c.ident(d.Name)
+				c.comment(d.Doc)
+			}
+		case *ast.GenDecl:
+			c.comment(d.Doc)
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if s.Name.IsExported() {
+						c.ident(s.Name)
+						c.comment(s.Doc)
+						c.fields(s.Type)
+					}
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						if name.IsExported() {
+							c.ident(name)
+							c.comment(s.Doc)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// fields spellchecks the exported fields and methods of a struct or an
+// interface.
+func (c *spellchecker) fields(t ast.Expr) {
+	var list *ast.FieldList
+	switch s := t.(type) {
+	case *ast.StructType:
+		list = s.Fields
+	case *ast.InterfaceType:
+		list = s.Methods
+	}
+	if list == nil {
+		return
+	}
+	for _, f := range list.List {
+		for _, name := range f.Names {
+			if name.IsExported() {
+				c.ident(name)
+				c.comment(f.Doc)
+			}
+		}
+	}
+}
+
+// ident spellchecks each word of an identifier.
+func (c *spellchecker) ident(i *ast.Ident) {
+	for _, w := range splitIdentifier(i.Name) {
+		if !c.isValid(w) {
+			c.bad = append(c.bad, issueAt(c.fset.Position(i.Pos()), "\"%s\" in %s", w, i.Name))
+		}
+	}
+}
+
+// comment spellchecks the words of a doc comment. Indented lines are code and
+// are skipped.
+func (c *spellchecker) comment(g *ast.CommentGroup) {
+	if g == nil {
+		return
+	}
+	for _, cm := range g.List {
+		text := cm.Text
+		if strings.HasPrefix(text, "//") {
+			text = text[2:]
+		} else {
+			text = strings.TrimSuffix(text[2:], "*/")
+		}
+		for i, line := range strings.Split(text, "\n") {
+			if strings.HasPrefix(line, "\t") || strings.HasPrefix(line, " \t") || strings.HasPrefix(line, "  ") {
+				continue
+			}
+			line = reURL.ReplaceAllString(line, "")
+			for _, w := range reWord.FindAllString(line, -1) {
+				if c.known[w] || strings.ContainsAny(w, "_0123456789") || len(splitIdentifier(w)) > 1 {
+					// Identifiers are checked where they are declared.
+					continue
+				}
+				if !c.isValid(w) {
+					pos := c.fset.Position(cm.Pos())
+					c.bad = append(c.bad, Issue{File: pos.Filename, Line: pos.Line + i, Message: fmt.Sprintf("\"%s\" in comment", w)})
+				}
+			}
+		}
+	}
+}
+
+// isValid returns true if the word is in the dictionary or shouldn't be
+// checked.
+func (c *spellchecker) isValid(w string) bool {
+	if len(w) < c.minLength || strings.ToUpper(w) == w {
+		return true
+	}
+	return c.words[w] || c.words[strings.ToLower(w)]
+}
+
+// isExportedRecv returns true if the method receiver type is exported.
+func isExportedRecv(recv *ast.FieldList) bool {
+	if len(recv.List) == 0 {
+		return false
+	}
+	t := recv.List[0].Type
+	if s, ok := t.(*ast.StarExpr); ok {
+		t = s.X
+	}
+	i, ok := t.(*ast.Ident)
+	return ok && i.IsExported()
+}
+
+// splitIdentifier splits a Go identifier into its words, e.g. "HTTPServer2"
+// returns {"HTTP", "Server"}.
+func splitIdentifier(name string) []string {
+	var out []string
+	runes := []rune(name)
+	start := -1
+	for i, r := range runes {
+		if !unicode.IsLetter(r) {
+			if start != -1 {
+				out = append(out, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+		if start == -1 {
+			start = i
+			continue
+		}
+		// Split before an upper case letter that follows a lower case one, or that
+		// starts a word after an acronym, e.g. "HTTPServer".
+		if unicode.IsUpper(r) && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			out = append(out, string(runes[start:i]))
+			start = i
+		}
+	}
+	if start != -1 {
+		out = append(out, string(runes[start:]))
+	}
+	return out
+}
+
+// addWords adds the words of a word list file to words.
+func addWords(words map[string]bool, content []byte) {
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && !strings.HasPrefix(line, "#") {
+			words[line] = true
+		}
+	}
+}
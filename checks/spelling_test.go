@@ -0,0 +1,75 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestSplitIdentifier(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		in       string
+		expected []string
+	}{
+		{"", nil},
+		{"foo", []string{"foo"}},
+		{"FooBar", []string{"Foo", "Bar"}},
+		{"HTTPServer2", []string{"HTTP", "Server"}},
+		{"parseJSON", []string{"parse", "JSON"}},
+		{"foo_bar2Baz", []string{"foo", "bar", "Baz"}},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, splitIdentifier(line.in))
+	}
+}
+
+func TestSpelling(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"foo.go": `package foo
+
+// Reciever recieves data from http://example.com/foo.
+//
+//	exampel := Reciever{}
+type Reciever struct {
+	// Bufer is the data.
+	Bufer []byte
+	privte int
+}
+
+// Recieve implements the HTTP protocl.
+func (r *Reciever) Recieve() {
+}
+
+func privateFuncton() {
+}
+`,
+		"foo_test.go": "package foo\n\n// Tset is ignored.\nfunc Tset() {\n}\n",
+		"words.txt":   "# Project words.\nprotocl\n",
+	}
+	change := setup(t, td, files)
+	dict := filepath.Join(td, "dict")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(dict, []byte("data\nfrom\nimplements\nis\nthe\n"), 0600))
+	s := &Spelling{Dictionary: dict, WordList: "words.txt"}
+	expected := "unknown words, fix them or add them to the word list:\n" +
+		"foo.go:3: \"recieves\" in comment\n" +
+		"foo.go:6:6: \"Reciever\" in Reciever\n" +
+		"foo.go:8:2: \"Bufer\" in Bufer\n" +
+		"foo.go:13:20: \"Recieve\" in Recieve"
+	ut.AssertEqual(t, errors.New(expected), s.Run(change, &Options{}).Err)
+	s.MinLength = 9
+	ut.AssertEqual(t, nil, s.Run(change, &Options{}).Err)
+	s.Dictionary = filepath.Join(td, "missing")
+	ut.AssertEqual(t, true, s.Run(change, &Options{}).Err != nil)
+}
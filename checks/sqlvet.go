@@ -0,0 +1,363 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// sqlvet validates SQL queries in string literals.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strconv"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// SQLVet validates the SQL string literals passed to database/sql and sqlx
+// call sites in the modified Go files.
+//
+// It catches malformed queries that would otherwise only fail at runtime: an
+// unknown statement, unbalanced parentheses or quotes, dangling commas and a
+// number of placeholders that doesn't match the number of arguments. It is
+// not a full SQL parser. Only queries that are string literals, or
+// concatenations of them, are verified.
+type SQLVet struct {
+	Limits `yaml:",inline"`
+
+	// Dialect is one of "postgres" ($1), "mysql" or "sqlite" (?) and
+	// "sqlserver" (@p1). Defaults to detecting the placeholder style of each
+	// query.
+	Dialect string `yaml:"dialect"`
+}
+
+// GetDescription implements Check.
+func (s *SQLVet) GetDescription() string {
+	return "enforces SQL queries in string literals are valid"
+}
+
+// GetName implements Check.
+func (s *SQLVet) GetName() string {
+	return "sqlvet"
+}
+
+// GetPrerequisites implements Check.
+func (s *SQLVet) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (s *SQLVet) Run(change scm.Change, options *Options) Result {
+	return newResult(s.run(change, options), ParseIssues)
+}
+
+func (s *SQLVet) run(change scm.Change, options *Options) error {
+	switch s.Dialect {
+	case "", "postgres", "mysql", "sqlite", "sqlserver":
+	default:
+		return fmt.Errorf("invalid dialect \"%s\"", s.Dialect)
+	}
+	var bad []Issue
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		content := change.Content(f)
+		if content == nil {
+			continue
+		}
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, f, content, 0)
+		if err != nil {
+			bad = append(bad, parseErrorIssues(err)...)
+			continue
+		}
+		pkg := importsSQL(file)
+		if pkg == "" {
+			continue
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			i, ok := sqlMethods[sel.Sel.Name]
+			if !ok || len(call.Args) <= i || (sqlxMethods[sel.Sel.Name] && pkg != "github.com/jmoiron/sqlx") {
+				return true
+			}
+			query, ok := stringConstant(call.Args[i])
+			if !ok {
+				return true
+			}
+			// Named queries and variadic calls can't be counted.
+			args := -1
+			if !strings.HasPrefix(sel.Sel.Name, "Named") && !call.Ellipsis.IsValid() && !strings.HasPrefix(sel.Sel.Name, "Prepare") {
+				args = len(call.Args) - i - 1
+			}
+			for _, issue := range s.vet(query, args) {
+				bad = append(bad, issueAt(fset.Position(call.Args[i].Pos()), "%s: %s", sel.Sel.Name, issue))
+			}
+			return true
+		})
+	}
+	if len(bad) != 0 {
+		return errors.New("invalid SQL queries:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// vet returns the issues in query. args is the number of arguments passed
+// along the query, or -1 if unknown.
+func (s *SQLVet) vet(query string, args int) []string {
+	var out []string
+	t := tokenizeSQL(query)
+	if t.err != "" {
+		return []string{t.err}
+	}
+	if len(t.words) == 0 {
+		return []string{"empty query"}
+	}
+	if !sqlStatements[strings.ToUpper(t.words[0])] {
+		out = append(out, fmt.Sprintf("unknown statement \"%s\"", t.words[0]))
+	}
+	for i := 1; i < len(t.words); i++ {
+		prev, cur := t.words[i-1], strings.ToUpper(t.words[i])
+		if prev == "," && (cur == "," || cur == ")" || cur == "FROM" || cur == "WHERE") {
+			out = append(out, fmt.Sprintf("unexpected \"%s\" after \",\"", t.words[i]))
+		}
+	}
+	if last := t.words[len(t.words)-1]; last == "," {
+		out = append(out, "unexpected \",\" at the end")
+	}
+	dialect := s.Dialect
+	if dialect == "" {
+		switch {
+		case t.dollar != 0:
+			dialect = "postgres"
+		case t.at != 0:
+			dialect = "sqlserver"
+		default:
+			dialect = "mysql"
+		}
+	}
+	placeholders := 0
+	switch dialect {
+	case "postgres":
+		placeholders = t.dollar
+		if t.question != 0 {
+			out = append(out, "\"?\" placeholders are not supported by postgres, use $1")
+		}
+	case "sqlserver":
+		placeholders = t.at
+	default:
+		placeholders = t.question
+		if t.dollar != 0 {
+			out = append(out, fmt.Sprintf("$1 placeholders are not supported by %s, use ?", dialect))
+		}
+	}
+	if args != -1 && placeholders != args {
+		out = append(out, fmt.Sprintf("%d placeholders but %d arguments", placeholders, args))
+	}
+	return out
+}
+
+// Private stuff.
+
+// sqlMethods is the index of the query argument of database/sql and sqlx
+// methods.
+var sqlMethods = map[string]int{
+	"Exec":                0,
+	"ExecContext":         1,
+	"Query":               0,
+	"QueryContext":        1,
+	"QueryRow":            0,
+	"QueryRowContext":     1,
+	"Prepare":             0,
+	"PrepareContext":      1,
+	"MustExec":            0,
+	"MustExecContext":     1,
+	"Queryx":              0,
+	"QueryxContext":       1,
+	"QueryRowx":           0,
+	"QueryRowxContext":    1,
+	"Preparex":            0,
+	"PreparexContext":     1,
+	"Get":                 1,
+	"GetContext":          2,
+	"Select":              1,
+	"SelectContext":       2,
+	"NamedExec":           0,
+	"NamedExecContext":    1,
+	"NamedQuery":          0,
+	"NamedQueryContext":   1,
+	"PrepareNamed":        0,
+	"PrepareNamedContext": 1,
+}
+
+// sqlxMethods are the methods only checked when sqlx is imported, since their
+// names are too generic.
+var sqlxMethods = map[string]bool{
+	"Get": true, "GetContext": true, "Select": true, "SelectContext": true,
+}
+
+// sqlStatements are the keywords a statement can start with.
+var sqlStatements = map[string]bool{
+	"ALTER": true, "BEGIN": true, "CALL": true, "COMMIT": true, "COPY": true,
+	"CREATE": true, "DELETE": true, "DROP": true, "EXPLAIN": true, "GRANT": true,
+	"INSERT": true, "LOCK": true, "MERGE": true, "PRAGMA": true, "RELEASE": true,
+	"REPLACE": true, "REVOKE": true, "ROLLBACK": true, "SAVEPOINT": true,
+	"SELECT": true, "SET": true, "SHOW": true, "TRUNCATE": true, "UPDATE": true,
+	"UPSERT": true, "USE": true, "VACUUM": true, "VALUES": true, "WITH": true,
+	"(": true,
+}
+
+// importsSQL returns "github.com/jmoiron/sqlx" if the file imports sqlx,
+// "database/sql" if it imports database/sql, otherwise an empty string.
+func importsSQL(file *ast.File) string {
+	out := ""
+	for _, i := range file.Imports {
+		p, _ := strconv.Unquote(i.Path.Value)
+		if p == "github.com/jmoiron/sqlx" {
+			return p
+		}
+		if p == "database/sql" {
+			out = p
+		}
+	}
+	return out
+}
+
+// stringConstant returns the value of a string literal or a concatenation of
+// string literals.
+func stringConstant(e ast.Expr) (string, bool) {
+	switch v := e.(type) {
+	case *ast.BasicLit:
+		if v.Kind != token.STRING {
+			return "", false
+		}
+		s, err := strconv.Unquote(v.Value)
+		return s, err == nil
+	case *ast.BinaryExpr:
+		if v.Op != token.ADD {
+			return "", false
+		}
+		x, ok := stringConstant(v.X)
+		if !ok {
+			return "", false
+		}
+		y, ok := stringConstant(v.Y)
+		return x + y, ok
+	case *ast.ParenExpr:
+		return stringConstant(v.X)
+	}
+	return "", false
+}
+
+// sqlTokens is the result of tokenizeSQL.
+type sqlTokens struct {
+	// words are the keywords, identifiers and punctuation outside of strings
+	// and comments.
+	words []string
+	// question is the number of ? placeholders.
+	question int
+	// dollar is the highest $N placeholder.
+	dollar int
+	// at is the number of distinct @name placeholders.
+	at  int
+	err string
+}
+
+// tokenizeSQL splits a query in tokens, skipping strings and comments.
+func tokenizeSQL(q string) *sqlTokens {
+	t := &sqlTokens{}
+	depth := 0
+	named := map[string]bool{}
+	for i := 0; i < len(q); i++ {
+		c := q[i]
+		switch {
+		case c == '\'' || c == '"' || c == '`':
+			// Quotes are escaped by doubling them.
+			end := i + 1
+			for ; end < len(q); end++ {
+				if q[end] == c {
+					if end+1 < len(q) && q[end+1] == c {
+						end++
+						continue
+					}
+					break
+				}
+			}
+			if end >= len(q) {
+				t.err = fmt.Sprintf("unterminated %c quote", c)
+				return t
+			}
+			t.words = append(t.words, q[i:end+1])
+			i = end
+		case c == '-' && i+1 < len(q) && q[i+1] == '-':
+			for i < len(q) && q[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(q) && q[i+1] == '*':
+			end := strings.Index(q[i+2:], "*/")
+			if end == -1 {
+				t.err = "unterminated comment"
+				return t
+			}
+			i += end + 3
+		case c == '?':
+			t.question++
+			t.words = append(t.words, "?")
+		case c == '$' && i+1 < len(q) && q[i+1] >= '0' && q[i+1] <= '9':
+			j := i + 1
+			for j < len(q) && q[j] >= '0' && q[j] <= '9' {
+				j++
+			}
+			if n, _ := strconv.Atoi(q[i+1 : j]); n > t.dollar {
+				t.dollar = n
+			}
+			t.words = append(t.words, q[i:j])
+			i = j - 1
+		case c == '@' && i+1 < len(q) && isSQLIdent(q[i+1]) && (i == 0 || q[i-1] != '@'):
+			j := i + 1
+			for j < len(q) && isSQLIdent(q[j]) {
+				j++
+			}
+			if !named[q[i:j]] {
+				named[q[i:j]] = true
+				t.at++
+			}
+			t.words = append(t.words, q[i:j])
+			i = j - 1
+		case c == '(' || c == ')':
+			if c == '(' {
+				depth++
+			} else if depth--; depth < 0 {
+				t.err = "unbalanced parenthesis"
+				return t
+			}
+			t.words = append(t.words, string(c))
+		case c == ',' || c == ';':
+			t.words = append(t.words, string(c))
+		case isSQLIdent(c):
+			j := i
+			for j < len(q) && (isSQLIdent(q[j]) || q[j] == '.') {
+				j++
+			}
+			t.words = append(t.words, q[i:j])
+			i = j - 1
+		}
+	}
+	if depth != 0 {
+		t.err = "unbalanced parenthesis"
+	}
+	return t
+}
+
+func isSQLIdent(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
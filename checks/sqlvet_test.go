@@ -0,0 +1,74 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestSQLVetVet(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		dialect  string
+		query    string
+		args     int
+		expected []string
+	}{
+		{"", "SELECT a, b FROM t WHERE c = ? AND d = '?'", 1, nil},
+		{"", "select a from t where b = $1 or c = $2 or d = $1", 2, nil},
+		{"", "UPDATE t SET a = @p1 -- @p2\nWHERE b = @p2 /* @p3 */", 2, nil},
+		{"", "SELECT @@ROWCOUNT", 0, nil},
+		{"", "INSERT INTO t (a, b) VALUES (?, ?)", -1, nil},
+		{"", "  ", 0, []string{"empty query"}},
+		{"", "SELEC a FROM t", 0, []string{"unknown statement \"SELEC\""}},
+		{"", "SELECT a, FROM t", 0, []string{"unexpected \"FROM\" after \",\""}},
+		{"", "INSERT INTO t (a,) VALUES (1),", 0, []string{"unexpected \")\" after \",\"", "unexpected \",\" at the end"}},
+		{"", "SELECT (a FROM t", 0, []string{"unbalanced parenthesis"}},
+		{"", "SELECT a) FROM t", 0, []string{"unbalanced parenthesis"}},
+		{"", "SELECT 'it''s FROM t", 0, []string{"unterminated ' quote"}},
+		{"", "SELECT a FROM t WHERE b = ? AND c = ?", 1, []string{"2 placeholders but 1 arguments"}},
+		{"postgres", "SELECT a FROM t WHERE b = ?", 1, []string{"\"?\" placeholders are not supported by postgres, use $1", "0 placeholders but 1 arguments"}},
+		{"sqlite", "SELECT a FROM t WHERE b = $1", 1, []string{"$1 placeholders are not supported by sqlite, use ?", "0 placeholders but 1 arguments"}},
+	}
+	for i, line := range data {
+		s := &SQLVet{Dialect: line.dialect}
+		ut.AssertEqualIndex(t, i, line.expected, s.vet(line.query, line.args))
+	}
+}
+
+func TestSQLVet(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"db.go": `package foo
+
+import (
+	"context"
+	"database/sql"
+)
+
+func f(ctx context.Context, db *sql.DB, q string, args []interface{}) {
+	db.QueryRowContext(ctx, "SELECT a FROM t WHERE b = $1 "+
+		"AND c = $2", 1)
+	db.Exec("DELET FROM t")
+	db.Exec(q, 1)
+	db.Exec("DELETE FROM t WHERE a = ?", args...)
+	db.Get(nil, "not sql")
+}
+`,
+		"other.go": "package foo\n\ntype c struct{}\n\nfunc (c) Exec(s string) {}\n\nfunc g() {\n\tc{}.Exec(\"not sql\")\n}\n",
+	}
+	change := setup(t, td, files)
+	expected := "invalid SQL queries:\n" +
+		"db.go:9:26: QueryRowContext: 2 placeholders but 1 arguments\n" +
+		"db.go:11:10: Exec: unknown statement \"DELET\""
+	ut.AssertEqual(t, errors.New(expected), (&SQLVet{}).Run(change, &Options{}).Err)
+	ut.AssertEqual(t, errors.New("invalid dialect \"foo\""), (&SQLVet{Dialect: "foo"}).Run(change, &Options{}).Err)
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// StaleBranch fails when the current branch is too far behind its upstream
+// branch, or the default branch when it has none. Use severity "warning" to
+// only warn.
+//
+// It is meant to be used in mode pre-push, to encourage rebasing before pushing
+// and to prevent CI failures due to a stale merge base.
+type StaleBranch struct {
+	Limits `yaml:",inline"`
+
+	// Against is the reference to compare against. Defaults to the upstream of
+	// the current branch. When there is no upstream, e.g. for a new branch, the
+	// first of origin/HEAD, origin/main, origin/master, main and master that
+	// exists is used. When none does, the check is skipped.
+	Against string `yaml:"against"`
+	// MaxBehind is the maximum number of commits the current branch can be
+	// behind Against.
+	MaxBehind int `yaml:"max_behind"`
+}
+
+// GetDescription implements Check.
+func (s *StaleBranch) GetDescription() string {
+	return "enforces the branch is not too far behind its upstream"
+}
+
+// GetName implements Check.
+func (s *StaleBranch) GetName() string {
+	return "stalebranch"
+}
+
+// GetPrerequisites implements Check.
+func (s *StaleBranch) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (s *StaleBranch) Run(change scm.Change, options *Options) Result {
+	return newResult(s.run(change, options), ParseIssues)
+}
+
+func (s *StaleBranch) run(change scm.Change, options *Options) error {
+	repo := change.Repo()
+	var base scm.Commit
+	var err error
+	against := s.Against
+	if against != "" {
+		if base, err = repo.Eval(against); err != nil {
+			return err
+		}
+	} else if base, err = repo.Upstream(); err == nil {
+		against = "upstream"
+	} else {
+		for _, ref := range defaultBases {
+			if base, err = repo.Eval(ref); err == nil {
+				against = ref
+				break
+			}
+		}
+		if against == "" {
+			log.Printf("stalebranch: no upstream nor default branch, skipping")
+			return nil
+		}
+	}
+	behind, err := repo.CountCommits(repo.HEAD(), base)
+	if err != nil {
+		return err
+	}
+	if behind <= s.MaxBehind {
+		return nil
+	}
+	return fmt.Errorf("branch is %d commits behind %s (max %d); please rebase", behind, against, s.MaxBehind)
+}
+
+// Private stuff.
+
+// defaultBases are the references StaleBranch compares against when the
+// branch has no upstream, in order of preference.
+var defaultBases = []string{"origin/HEAD", "origin/main", "origin/master", "main", "master"}
@@ -0,0 +1,63 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestStaleBranch(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	git := func(args ...string) {
+		_, code, err := internal.Capture(td, nil, append([]string{"git"}, args...)...)
+		ut.AssertEqual(t, 0, code)
+		ut.AssertEqual(t, nil, err)
+	}
+	git("init", "-q")
+	git("config", "user.email", "nobody@localhost")
+	git("config", "user.name", "nobody")
+	git("commit", "-q", "--allow-empty", "-m", "initial")
+	git("branch", "-M", "main")
+	git("branch", "feature")
+	// main is 3 commits ahead of the new branch, which has no upstream.
+	for i := 0; i < 3; i++ {
+		git("commit", "-q", "--allow-empty", "-m", "more")
+	}
+	git("checkout", "-q", "feature")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	change, err := repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	ut.AssertEqual(t, nil, (&StaleBranch{MaxBehind: 3}).Run(change, &Options{}).Err)
+	s := &StaleBranch{MaxBehind: 2}
+	ut.AssertEqual(t, errors.New("branch is 3 commits behind main (max 2); please rebase"), s.Run(change, &Options{}).Err)
+	ut.AssertEqual(t, SeverityError, s.GetSeverity())
+
+	// origin/HEAD is preferred over the local default branch.
+	git("update-ref", "refs/remotes/origin/HEAD", "main~1")
+	ut.AssertEqual(t, errors.New("branch is 2 commits behind origin/HEAD (max 1); please rebase"), (&StaleBranch{MaxBehind: 1}).Run(change, &Options{}).Err)
+
+	// With severity warning, the check still fails but not the run.
+	s = &StaleBranch{Limits: Limits{Severity: SeverityWarning}, Against: "main"}
+	ut.AssertEqual(t, errors.New("branch is 3 commits behind main (max 0); please rebase"), s.Run(change, &Options{}).Err)
+	ut.AssertEqual(t, SeverityWarning, s.GetSeverity())
+
+	// Nothing to compare against.
+	git("checkout", "-q", "main")
+	git("branch", "-q", "-m", "main", "trunk")
+	git("update-ref", "-d", "refs/remotes/origin/HEAD")
+	ut.AssertEqual(t, nil, (&StaleBranch{}).Run(change, &Options{}).Err)
+}
@@ -0,0 +1,192 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// testhygiene enforces test conventions.
+
+package checks
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// TestHygiene enforces conventions on tests.
+//
+// Each rule is disabled by default.
+type TestHygiene struct {
+	Limits `yaml:",inline"`
+
+	// RequireTests requires every modified non-main package to have at least
+	// one _test.go file.
+	RequireTests bool `yaml:"require_tests"`
+	// Exclude is a list of glob patterns of package directories, relative to
+	// the repository root, exempted from RequireTests.
+	Exclude []string `yaml:"exclude"`
+	// Parallel is the t.Parallel() policy for the Test functions of the
+	// modified _test.go files: "required" requires each to call t.Parallel(),
+	// "forbidden" forbids it. Empty means no policy.
+	Parallel string `yaml:"parallel"`
+	// SkipReason forbids t.Skip() without argument and t.SkipNow(), so the
+	// reason a test is skipped is always printed.
+	SkipReason bool `yaml:"skip_reason"`
+}
+
+// GetDescription implements Check.
+func (t *TestHygiene) GetDescription() string {
+	return "enforces test conventions"
+}
+
+// GetName implements Check.
+func (t *TestHygiene) GetName() string {
+	return "testhygiene"
+}
+
+// GetPrerequisites implements Check.
+func (t *TestHygiene) GetPrerequisites() []CheckPrerequisite {
+	return nil
+}
+
+// Run implements Check.
+func (t *TestHygiene) Run(change scm.Change, options *Options) Result {
+	return newResult(t.run(change, options), ParseIssues)
+}
+
+func (t *TestHygiene) run(change scm.Change, options *Options) error {
+	if t.Parallel != "" && t.Parallel != "required" && t.Parallel != "forbidden" {
+		return fmt.Errorf("invalid parallel \"%s\"", t.Parallel)
+	}
+	var bad []Issue
+	if t.RequireTests {
+		bad = append(bad, t.missingTests(change)...)
+	}
+	if t.Parallel != "" || t.SkipReason {
+		for _, f := range change.Filtered().Changed().GoFiles() {
+			if !strings.HasSuffix(f, "_test.go") {
+				continue
+			}
+			content := change.Content(f)
+			if content == nil {
+				continue
+			}
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, f, content, 0)
+			if err != nil {
+				bad = append(bad, parseErrorIssues(err)...)
+				continue
+			}
+			bad = append(bad, t.lint(fset, file)...)
+		}
+	}
+	if len(bad) != 0 {
+		return errors.New("test conventions not followed:\n" + formatIssues(bad))
+	}
+	return nil
+}
+
+// missingTests returns the modified non-main packages without test.
+func (t *TestHygiene) missingTests(change scm.Change) []Issue {
+	// Map of <directory> : <has test>
+	dirs := map[string]bool{}
+	for _, f := range change.Filtered().Changed().GoFiles() {
+		if !matchAny(t.Exclude, path.Dir(f)) {
+			dirs[path.Dir(f)] = false
+		}
+	}
+	// Map of <directory> : <first non-test file>
+	sources := map[string]string{}
+	for _, f := range change.All().GoFiles() {
+		dir := path.Dir(f)
+		if _, ok := dirs[dir]; !ok {
+			continue
+		}
+		if strings.HasSuffix(f, "_test.go") {
+			dirs[dir] = true
+		} else if _, ok := sources[dir]; !ok {
+			sources[dir] = f
+		}
+	}
+	var out []Issue
+	for dir, hasTest := range dirs {
+		f, ok := sources[dir]
+		if hasTest || !ok {
+			continue
+		}
+		file, err := parser.ParseFile(token.NewFileSet(), f, change.Content(f), parser.PackageClauseOnly)
+		if err != nil {
+			out = append(out, parseErrorIssues(err)...)
+		} else if file.Name.Name != "main" {
+			out = append(out, Issue{File: dir, Message: fmt.Sprintf("package %s has no test", file.Name.Name)})
+		}
+	}
+	return out
+}
+
+// lint returns the issues in a _test.go file.
+func (t *TestHygiene) lint(fset *token.FileSet, file *ast.File) []Issue {
+	var out []Issue
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		param := testParam(fn)
+		parallel := false
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if x, ok := sel.X.(*ast.Ident); ok && param != "" && x.Name == param && sel.Sel.Name == "Parallel" {
+				parallel = true
+			}
+			if t.SkipReason && ((sel.Sel.Name == "Skip" && len(call.Args) == 0) || sel.Sel.Name == "SkipNow") {
+				out = append(out, issueAt(fset.Position(call.Pos()), "%s() without a reason", sel.Sel.Name))
+			}
+			return true
+		})
+		if param == "" {
+			continue
+		}
+		if t.Parallel == "required" && !parallel {
+			out = append(out, issueAt(fset.Position(fn.Pos()), "%s doesn't call %s.Parallel()", fn.Name.Name, param))
+		} else if t.Parallel == "forbidden" && parallel {
+			out = append(out, issueAt(fset.Position(fn.Pos()), "%s calls %s.Parallel()", fn.Name.Name, param))
+		}
+	}
+	return out
+}
+
+// Private stuff.
+
+// testParam returns the name of the *testing.T parameter if fn is a Test
+// function.
+func testParam(fn *ast.FuncDecl) string {
+	if fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Test") || fn.Name.Name == "TestMain" {
+		return ""
+	}
+	params := fn.Type.Params.List
+	if len(params) != 1 || len(params[0].Names) != 1 {
+		return ""
+	}
+	star, ok := params[0].Type.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "T" {
+		return ""
+	}
+	return params[0].Names[0].Name
+}
@@ -0,0 +1,39 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestTestHygiene(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"foo.go":      "package foo\n",
+		"foo_test.go": "package foo\n\nimport \"testing\"\n\nfunc TestA(t *testing.T) {\n\tt.Parallel()\n\tt.Skip()\n}\n\nfunc TestB(x *testing.T) {\n\tx.Skipf(\"flaky\")\n}\n\nfunc helper(t *testing.T) {\n\tt.SkipNow()\n}\n",
+		"bar/bar.go":  "package bar\n",
+		"cmd/main.go": "package main\n",
+		"gen/gen.go":  "package gen\n",
+	}
+	change := setup(t, td, files)
+	ut.AssertEqual(t, nil, (&TestHygiene{}).Run(change, &Options{}).Err)
+	h := &TestHygiene{RequireTests: true, Exclude: []string{"gen"}, Parallel: "required", SkipReason: true}
+	expected := "test conventions not followed:\n" +
+		"bar: package bar has no test\n" +
+		"foo_test.go:7:2: Skip() without a reason\n" +
+		"foo_test.go:10:1: TestB doesn't call x.Parallel()\n" +
+		"foo_test.go:15:2: SkipNow() without a reason"
+	ut.AssertEqual(t, errors.New(expected), h.Run(change, &Options{}).Err)
+	h = &TestHygiene{Parallel: "forbidden"}
+	ut.AssertEqual(t, errors.New("test conventions not followed:\nfoo_test.go:5:1: TestA calls t.Parallel()"), h.Run(change, &Options{}).Err)
+	h.Parallel = "sometimes"
+	ut.AssertEqual(t, errors.New("invalid parallel \"sometimes\""), h.Run(change, &Options{}).Err)
+}
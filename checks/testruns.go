@@ -0,0 +1,122 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// testruns shares the go test invocations between the test and coverage
+// checks.
+
+package checks
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// testRuns memoizes the coverage enabled "go test" run of each package when
+// both the test and coverage checks are enabled, so the test binary of a
+// package is built and run once and both checks use the result.
+//
+// It is only used when the coverage check computes the coverage per package,
+// the test check has no extra arguments and both run their commands the same
+// way, otherwise the runs differ.
+//
+// It is safe to use concurrently. A nil *testRuns is never shared.
+type testRuns struct {
+	coverage *Coverage
+
+	lock sync.Mutex
+	runs map[string]*testRun
+}
+
+// testRun is the result of one "go test" run.
+type testRun struct {
+	once     sync.Once
+	args     []string
+	out      string
+	exitCode int
+	profile  []byte
+	duration time.Duration
+}
+
+// newTestRuns returns a testRuns if the test and coverage checks in enabled
+// can share their runs, nil otherwise.
+func newTestRuns(enabled []Check) *testRuns {
+	var t *Test
+	var c *Coverage
+	for _, check := range enabled {
+		switch check := check.(type) {
+		case *Test:
+			if t != nil {
+				return nil
+			}
+			t = check
+		case *Coverage:
+			if c != nil {
+				return nil
+			}
+			c = check
+		}
+	}
+	if t == nil || c == nil || len(t.ExtraArgs) != 0 || c.UseGlobalInference {
+		return nil
+	}
+	// The commands must run the same way.
+	if t.Cwd != c.Cwd || !sameEnv(t.Env, c.Env) || t.Nice != c.Nice || t.CPUs != c.CPUs || t.MemoryMB != c.MemoryMB {
+		return nil
+	}
+	return &testRuns{coverage: c, runs: map[string]*testRun{}}
+}
+
+// shared returns true if testPkg is run with coverage, so its run is shared.
+func (r *testRuns) shared(testPkg string) bool {
+	return r != nil && r.coverage.SettingsForPkg(testPkg).MinCoverage != 0
+}
+
+// run runs the coverage enabled tests of testPkg once and returns the result.
+func (r *testRuns) run(testPkg string, options *Options, repo scm.ReadOnlyRepo) *testRun {
+	r.lock.Lock()
+	t := r.runs[testPkg]
+	if t == nil {
+		t = &testRun{}
+		r.runs[testPkg] = t
+	}
+	r.lock.Unlock()
+	t.once.Do(func() {
+		f, err := ioutil.TempFile("", "pre-commit-go")
+		if err != nil {
+			t.exitCode = 1
+			t.out = err.Error()
+			return
+		}
+		_ = f.Close()
+		defer os.Remove(f.Name())
+		t.args = r.coverage.localArgs(testPkg, f.Name(), options)
+		start := time.Now()
+		t.out, t.exitCode, _ = capture(options, repo, t.args...)
+		t.duration = time.Since(start)
+		if t.exitCode == 0 {
+			if t.profile, err = ioutil.ReadFile(f.Name()); err != nil {
+				t.exitCode = 1
+				t.out = err.Error()
+			}
+		}
+	})
+	return t
+}
+
+// sameEnv returns true if a and b define the same environment variables.
+func sameEnv(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || v != w {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestNewTestRuns(t *testing.T) {
+	t.Parallel()
+	c := &Coverage{}
+	ut.AssertEqual(t, (*testRuns)(nil), newTestRuns(nil))
+	ut.AssertEqual(t, (*testRuns)(nil), newTestRuns([]Check{&Test{}}))
+	ut.AssertEqual(t, (*testRuns)(nil), newTestRuns([]Check{c}))
+	ut.AssertEqual(t, c, newTestRuns([]Check{&Test{}, &Gofmt{}, c}).coverage)
+	ut.AssertEqual(t, (*testRuns)(nil), newTestRuns([]Check{&Test{ExtraArgs: []string{"-race"}}, c}))
+	ut.AssertEqual(t, (*testRuns)(nil), newTestRuns([]Check{&Test{}, &Coverage{UseGlobalInference: true}}))
+	ut.AssertEqual(t, (*testRuns)(nil), newTestRuns([]Check{&Test{Limits: Limits{Env: map[string]string{"A": "1"}}}, c}))
+	ut.AssertEqual(t, (*testRuns)(nil), newTestRuns([]Check{&Test{}, &Test{}, c}))
+
+	var r *testRuns
+	ut.AssertEqual(t, false, r.shared("./foo"))
+	r = newTestRuns([]Check{&Test{}, &Coverage{PerDirDefault: CoverageSettings{MinCoverage: 10}, PerDir: map[string]*CoverageSettings{"bar": nil}}})
+	ut.AssertEqual(t, true, r.shared("./foo"))
+	ut.AssertEqual(t, false, r.shared("./bar"))
+}
+
+func TestTestRunsShared(t *testing.T) {
+	t.Parallel()
+	if testing.Short() {
+		t.SkipNow()
+	}
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	change := setup(t, td, coverageFiles)
+
+	c := &Coverage{PerDirDefault: CoverageSettings{MinCoverage: 50, MaxCoverage: 100}}
+	test := &Test{}
+	options := &Options{MaxDuration: 10, testRuns: newTestRuns([]Check{test, c})}
+	ut.AssertEqual(t, nil, test.Run(change, options).Err)
+	ut.AssertEqual(t, 2, len(options.testRuns.runs))
+	// The coverage check reuses the runs done by the test check.
+	r := c.Run(change, options)
+	ut.AssertEqual(t, nil, r.Err)
+	ut.AssertEqual(t, 2, len(options.testRuns.runs))
+	ut.AssertEqual(t, 60., r.Coverage.CoveragePercent())
+}
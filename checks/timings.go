@@ -0,0 +1,66 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// timings records the duration of each package processed by a check.
+
+package checks
+
+import (
+	"sync"
+	"time"
+)
+
+// PackageTimings records how long each package took to process, per check.
+//
+// It is safe to use concurrently. A nil *PackageTimings ignores the records.
+type PackageTimings struct {
+	lock      sync.Mutex
+	durations map[string]map[string]time.Duration
+}
+
+// NewPackageTimings returns an empty PackageTimings.
+func NewPackageTimings() *PackageTimings {
+	return &PackageTimings{durations: map[string]map[string]time.Duration{}}
+}
+
+// Record records that check took d to process the package pkg.
+func (p *PackageTimings) Record(check, pkg string, d time.Duration) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.durations[check] == nil {
+		p.durations[check] = map[string]time.Duration{}
+	}
+	p.durations[check][pkg] += d
+}
+
+// Durations returns a copy of the durations recorded, keyed by check name then
+// package.
+func (p *PackageTimings) Durations() map[string]map[string]time.Duration {
+	out := map[string]map[string]time.Duration{}
+	if p == nil {
+		return out
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for check, pkgs := range p.durations {
+		out[check] = map[string]time.Duration{}
+		for pkg, d := range pkgs {
+			out[check][pkg] = d
+		}
+	}
+	return out
+}
+
+// Packages returns the number of packages processed so far by check.
+func (p *PackageTimings) Packages(check string) int {
+	if p == nil {
+		return 0
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.durations[check])
+}
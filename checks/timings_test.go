@@ -0,0 +1,32 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package checks
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestPackageTimings(t *testing.T) {
+	t.Parallel()
+	var nilTimings *PackageTimings
+	nilTimings.Record("test", "./foo", time.Second)
+	ut.AssertEqual(t, map[string]map[string]time.Duration{}, nilTimings.Durations())
+
+	p := NewPackageTimings()
+	p.Record("test", "./foo", time.Second)
+	p.Record("test", "./foo", time.Second)
+	p.Record("coverage", "./bar", 3*time.Second)
+	expected := map[string]map[string]time.Duration{
+		"test":     {"./foo": 2 * time.Second},
+		"coverage": {"./bar": 3 * time.Second},
+	}
+	ut.AssertEqual(t, expected, p.Durations())
+	ut.AssertEqual(t, 1, p.Packages("test"))
+	ut.AssertEqual(t, 0, p.Packages("build"))
+	ut.AssertEqual(t, 0, nilTimings.Packages("test"))
+}
@@ -5,8 +5,12 @@
 package checks
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"path"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/maruel/pre-commit-go/internal"
@@ -50,9 +54,113 @@ func rsplitn(s, sep string, n int) []string {
 	return items
 }
 
-// capture sets GOPATH.
-func capture(r scm.ReadOnlyRepo, args ...string) (string, int, error) {
-	return internal.Capture(r.Root(), []string{"GOPATH=" + r.GOPATH()}, args...)
+// matchPattern returns true if the file f matches the glob pattern. A pattern
+// without '/' is matched against the file name, otherwise against the whole
+// path, where "**" matches any number of directories.
+func matchPattern(pattern, f string) bool {
+	f = strings.Replace(f, "\\", "/", -1)
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(f))
+		return matched
+	}
+	return internal.MatchPath(pattern, f)
+}
+
+// forEachPackage calls fn for each package of pkgs, with at most workers calls
+// running concurrently, and returns the errors in the order of pkgs separated
+// by an empty line. The packages not started yet are skipped once the check is
+// cancelled or, with FailFast, after the first failure.
+func forEachPackage(options *Options, pkgs []string, workers int, fn func(pkg string) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(pkgs) {
+		workers = len(pkgs)
+	}
+	errs := make([]error, len(pkgs))
+	var lock sync.Mutex
+	failed := false
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				lock.Lock()
+				skip := failed && options.FailFast
+				lock.Unlock()
+				if skip || options.context().Err() != nil {
+					continue
+				}
+				if errs[i] = fn(pkgs[i]); errs[i] != nil {
+					lock.Lock()
+					failed = true
+					lock.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range pkgs {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	var out []string
+	for _, err := range errs {
+		if err != nil {
+			out = append(out, err.Error())
+		}
+	}
+	if len(out) != 0 {
+		return errors.New(strings.Join(out, "\n\n"))
+	}
+	return nil
+}
+
+// capture sets GOPATH. The command is killed when the check times out.
+func capture(options *Options, r scm.ReadOnlyRepo, args ...string) (string, int, error) {
+	return internal.CaptureContext(options.context(), options.procDir(r.Root()), options.procEnv("GOPATH="+r.GOPATH()), options.procArgs(args)...)
+}
+
+// formatDiff runs args, e.g. gofmt -d, on files and returns the diff to apply
+// to fix their formatting, preceded by an empty line. It returns "" if the
+// diff can't be computed since it is only informative.
+func formatDiff(change scm.Change, options *Options, args, files []string) string {
+	out, _, err := capture(options, change.Repo(), append(args, files...)...)
+	if err != nil || strings.TrimSpace(out) == "" {
+		return ""
+	}
+	return "\n\n" + strings.TrimRight(out, "\n")
+}
+
+// fixFiles runs list, which prints the files needing a fix, then runs write on
+// the ones not ignored. Returns the files fixed.
+func fixFiles(change scm.Change, options *Options, list, write []string) ([]string, error) {
+	out, exitCode, err := capture(options, change.Repo(), list...)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %s", strings.Join(list, " "), err)
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("%s failed with code %d:\n%s", strings.Join(list, " "), exitCode, out)
+	}
+	var files []string
+	for _, line := range strings.Split(out, "\n") {
+		if line != "" && !change.IsIgnored(line) {
+			files = append(files, line)
+		}
+	}
+	if len(files) == 0 {
+		return nil, nil
+	}
+	out, exitCode, err = capture(options, change.Repo(), append(write, files...)...)
+	if err != nil {
+		return nil, fmt.Errorf("%s failed: %s", strings.Join(write, " "), err)
+	}
+	if exitCode != 0 {
+		return nil, fmt.Errorf("%s failed with code %d:\n%s", strings.Join(write, " "), exitCode, out)
+	}
+	return files, nil
 }
 
 // round rounds a time.Duration at round.
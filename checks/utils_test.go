@@ -5,6 +5,8 @@
 package checks
 
 import (
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -18,3 +20,57 @@ func TestRound(t *testing.T) {
 	ut.AssertEqual(t, -1500*time.Millisecond, round(-1549*time.Millisecond, 100*time.Millisecond))
 	ut.AssertEqual(t, -1600*time.Millisecond, round(-1550*time.Millisecond, 100*time.Millisecond))
 }
+
+func TestMatchPattern(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, true, matchPattern("*.pb.go", "foo/bar.pb.go"))
+	ut.AssertEqual(t, true, matchPattern("foo/*.go", "foo/bar.go"))
+	ut.AssertEqual(t, false, matchPattern("foo/*.go", "baz/foo/bar.go"))
+	ut.AssertEqual(t, false, matchPattern("*.pb.go", "foo/bar.go"))
+	ut.AssertEqual(t, true, matchPattern("pkg/**", "pkg/a/b.go"))
+	ut.AssertEqual(t, true, matchPattern("pkg/**", "pkg/b.go"))
+	ut.AssertEqual(t, false, matchPattern("pkg/**", "cmd/pkg/b.go"))
+	ut.AssertEqual(t, true, matchPattern("**/testdata/**", "a/testdata/b/c.go"))
+	ut.AssertEqual(t, true, matchPattern("**/testdata/**", "testdata/c.go"))
+	ut.AssertEqual(t, true, matchPattern("api/**/*.proto", "api/v1/a.proto"))
+	ut.AssertEqual(t, false, matchPattern("api/**/*.proto", "api/v1/a.go"))
+}
+
+func TestForEachPackage(t *testing.T) {
+	t.Parallel()
+	pkgs := []string{"./a", "./b", "./c", "./d"}
+	var lock sync.Mutex
+	running := 0
+	maxRunning := 0
+	var done []string
+	fn := func(pkg string) error {
+		lock.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		done = append(done, pkg)
+		lock.Unlock()
+		time.Sleep(time.Millisecond)
+		lock.Lock()
+		running--
+		lock.Unlock()
+		if pkg == "./b" || pkg == "./d" {
+			return errors.New(pkg + " failed")
+		}
+		return nil
+	}
+	// The errors are in the order of the packages.
+	err := forEachPackage(&Options{}, pkgs, 2, fn)
+	ut.AssertEqual(t, errors.New("./b failed\n\n./d failed"), err)
+	ut.AssertEqual(t, 2, maxRunning)
+	ut.AssertEqual(t, 4, len(done))
+
+	// The packages not started are skipped after the first failure.
+	done = nil
+	err = forEachPackage(&Options{FailFast: true}, pkgs, 1, fn)
+	ut.AssertEqual(t, errors.New("./b failed"), err)
+	ut.AssertEqual(t, []string{"./a", "./b"}, done)
+
+	ut.AssertEqual(t, nil, forEachPackage(&Options{}, nil, 2, fn))
+}
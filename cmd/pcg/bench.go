@@ -0,0 +1,112 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Benchmark baselines, updated by 'pcg bench'.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// benchFile is the name of the baseline file at the root of the repository.
+// It is meant to be committed so the baselines are shared.
+const benchFile = "pre-commit-go-bench.json"
+
+// defaultBenchArgs are the flags passed to 'go test' when none is specified.
+var defaultBenchArgs = []string{"-run", "^$", "-bench", ".", "-benchmem"}
+
+// benchResult is the result of a benchmark.
+type benchResult struct {
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  int64   `json:"bytes_per_op,omitempty"`
+	AllocsPerOp int64   `json:"allocs_per_op,omitempty"`
+}
+
+// benchBaseline is the content of benchFile.
+type benchBaseline struct {
+	// Args are the flags passed to 'go test'. They are reused on the next
+	// update so the results stay comparable.
+	Args []string `json:"args"`
+	// Results is keyed by "<package>.<benchmark>", e.g. "./scm.BenchmarkFoo".
+	Results map[string]benchResult `json:"results"`
+}
+
+// loadBaseline loads the baseline file. An empty baseline is returned if the
+// file doesn't exist.
+func loadBaseline(p string) (*benchBaseline, error) {
+	b := &benchBaseline{Results: map[string]benchResult{}}
+	content, err := ioutil.ReadFile(p)
+	if os.IsNotExist(err) {
+		return b, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(content, b); err != nil {
+		return nil, err
+	}
+	if b.Results == nil {
+		b.Results = map[string]benchResult{}
+	}
+	return b, nil
+}
+
+// save writes the baseline file.
+func (b *benchBaseline) save(p string) error {
+	content, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, append(content, '\n'), 0666)
+}
+
+// update replaces the results of the package pkg.
+func (b *benchBaseline) update(pkg string, results map[string]benchResult) {
+	for k := range b.Results {
+		if strings.HasPrefix(k, pkg+".") {
+			delete(b.Results, k)
+		}
+	}
+	for name, r := range results {
+		b.Results[pkg+"."+name] = r
+	}
+}
+
+// parseBench parses the output of 'go test -bench'.
+func parseBench(out string) map[string]benchResult {
+	results := map[string]benchResult{}
+	for _, line := range strings.Split(out, "\n") {
+		m := reBenchLine.FindStringSubmatch(line)
+		if m == nil {
+			continue
+		}
+		r := benchResult{}
+		r.NsPerOp, _ = strconv.ParseFloat(m[2], 64)
+		if m[3] != "" {
+			r.BytesPerOp, _ = strconv.ParseInt(m[3], 10, 64)
+		}
+		if m[4] != "" {
+			r.AllocsPerOp, _ = strconv.ParseInt(m[4], 10, 64)
+		}
+		results[m[1]] = r
+	}
+	return results
+}
+
+// Private stuff.
+
+// reBenchLine matches "BenchmarkFoo-8  1000  1234 ns/op  56 B/op  2 allocs/op".
+// The -N GOMAXPROCS suffix is stripped from the name.
+var reBenchLine = regexp.MustCompile(`^(Benchmark\S*?)(?:-\d+)?\s+\d+\s+([0-9.]+) ns/op(?:\s+(\d+) B/op)?(?:\s+(\d+) allocs/op)?`)
+
+func baselinePath(root string) string {
+	return filepath.Join(root, benchFile)
+}
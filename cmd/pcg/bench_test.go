@@ -0,0 +1,52 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestParseBench(t *testing.T) {
+	t.Parallel()
+	out := "goos: linux\n" +
+		"BenchmarkFoo-8   \t 1000000\t      1234 ns/op\t      56 B/op\t       2 allocs/op\n" +
+		"BenchmarkBar/sub \t 2000\t 0.5 ns/op\n" +
+		"PASS\n"
+	expected := map[string]benchResult{
+		"BenchmarkFoo":     {1234, 56, 2},
+		"BenchmarkBar/sub": {NsPerOp: 0.5},
+	}
+	ut.AssertEqual(t, expected, parseBench(out))
+}
+
+func TestBenchBaseline(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	p := baselinePath(td)
+	b, err := loadBaseline(p)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, &benchBaseline{Results: map[string]benchResult{}}, b)
+
+	b.Args = []string{"-bench", "."}
+	b.update("./a", map[string]benchResult{"BenchmarkA": {NsPerOp: 1}, "BenchmarkB": {NsPerOp: 2}})
+	b.update("./ab", map[string]benchResult{"BenchmarkA": {NsPerOp: 3}})
+	b.update("./a", map[string]benchResult{"BenchmarkC": {NsPerOp: 4}})
+	expected := map[string]benchResult{"./a.BenchmarkC": {NsPerOp: 4}, "./ab.BenchmarkA": {NsPerOp: 3}}
+	ut.AssertEqual(t, expected, b.Results)
+	ut.AssertEqual(t, nil, b.save(p))
+	actual, err := loadBaseline(p)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, b, actual)
+
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, benchFile), []byte("{"), 0600))
+	_, err = loadBaseline(p)
+	ut.AssertEqual(t, true, err != nil)
+}
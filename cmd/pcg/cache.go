@@ -0,0 +1,287 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Persisted cache of the checks that passed, to skip them when nothing they
+// depend on changed.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// cacheFile is the name of the file in the scm directory, e.g. .git/.
+const cacheFile = "pre-commit-go-cache.json"
+
+// maxCacheEntries is the number of passed runs kept; the oldest ones are
+// evicted first.
+const maxCacheEntries = 1000
+
+// useCache enables the result cache. It is disabled with 'pcg run -no-cache'
+// or $PRECOMMITGO_NO_CACHE, e.g. when a custom check depends on something
+// outside the repository.
+var useCache = os.Getenv("PRECOMMITGO_NO_CACHE") == ""
+
+// cachedResult is a passed run of a check.
+type cachedResult struct {
+	// Time is when the check passed, to evict the oldest entries.
+	Time time.Time `json:"time"`
+	// Coverage is the coverage measured by the check, if any.
+	Coverage checks.CoverageProfile `json:"coverage,omitempty"`
+}
+
+// resultCache is the persisted set of the check runs that passed, keyed by
+// key().
+type resultCache struct {
+	lock    sync.Mutex
+	Results map[string]*cachedResult `json:"results"`
+	// files memoizes the content hash of the files in this run, by their
+	// absolute path since 'pcg daemon' runs concurrent hooks on different
+	// worktrees.
+	files map[string]string
+	// tools memoizes the version of the tools in this run, see toolHash().
+	tools map[string]string
+	// paths memoizes where the tools are found by their name and $PATH. Unlike
+	// files and tools, it is kept by 'pcg daemon' between the runs.
+	paths map[string]string
+}
+
+// loadResultCache loads the cache from the scm directory. It never fails; an
+// empty cache is returned if none is found. It returns nil when the cache is
+// disabled.
+func loadResultCache(repo scm.ReadOnlyRepo) *resultCache {
+	if !useCache {
+		return nil
+	}
+	if daemonCache != nil {
+		// The files and the tools may have been modified since the previous run of
+		// the daemon.
+		daemonCache.lock.Lock()
+		daemonCache.files = map[string]string{}
+		daemonCache.tools = map[string]string{}
+		daemonCache.lock.Unlock()
+		return daemonCache
+	}
+	c := &resultCache{Results: map[string]*cachedResult{}, files: map[string]string{}, tools: map[string]string{}, paths: map[string]string{}}
+	p, err := scmFilePath(repo, cacheFile)
+	if err != nil {
+		return c
+	}
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		return c
+	}
+	if err := json.Unmarshal(content, c); err != nil {
+		log.Printf("ignoring corrupted %s: %s", p, err)
+		c.Results = map[string]*cachedResult{}
+	}
+	if c.Results == nil {
+		c.Results = map[string]*cachedResult{}
+	}
+	return c
+}
+
+// save writes the cache in the scm directory.
+func (c *resultCache) save(repo scm.ReadOnlyRepo) error {
+	if c == nil {
+		return nil
+	}
+	p, err := scmFilePath(repo, cacheFile)
+	if err != nil {
+		return err
+	}
+	c.lock.Lock()
+	c.evict(maxCacheEntries)
+	content, err := json.Marshal(c)
+	c.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, append(content, '\n'), 0666)
+}
+
+// lookup returns the key of the run of check on change and the cached result
+// if it already passed. The key is empty if the run can't be cached.
+func (c *resultCache) lookup(check checks.Check, change scm.Change, options *checks.Options) (string, *cachedResult) {
+	if c == nil {
+		return "", nil
+	}
+	key := c.key(check, change, options)
+	if key == "" {
+		return "", nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return key, c.Results[key]
+}
+
+// record adds the run of a check that passed.
+func (c *resultCache) record(key string, r checks.Result) {
+	if c == nil || key == "" || r.Err != nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.Results[key] = &cachedResult{Time: time.Now(), Coverage: r.Coverage}
+}
+
+// Private stuff.
+
+// key returns the hash identifying a run of check on change: the check name
+// and configuration, the version of the tools it runs and the content of the
+// files it covers. The modified files are included since the checks only
+// running on them report differently. The runs depending on the git history
+// through owned_only are not cached, nor the checks depending on more than the
+// files, see uncacheable().
+func (c *resultCache) key(check checks.Check, change scm.Change, options *checks.Options) string {
+	if change = checkScope(check, change); change == nil || options.OwnedOnly || uncacheable(check) {
+		return ""
+	}
+	config, err := json.Marshal(check)
+	if err != nil {
+		log.Printf("%s: not cached: %s", check.GetName(), err)
+		return ""
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s\x00%s\x00", check.GetName(), config)
+	fmt.Fprintf(h, "only_changed\x00%t\x00", options.OnlyChanged)
+	fmt.Fprintf(h, "shard\x00%s\x00", options.Shard)
+	if options.CommitMessageFile != "" {
+		msg, _ := ioutil.ReadFile(options.CommitMessageFile)
+		fmt.Fprintf(h, "commit message\x00%s\x00", msg)
+	}
+	// The baseline may not be committed.
+	baseline, _ := ioutil.ReadFile(filepath.Join(change.Repo().Root(), checks.BaselineFile))
+	fmt.Fprintf(h, "baseline\x00%s\x00", baseline)
+	path := options.SearchPath()
+	fmt.Fprintf(h, "go\x00%s\x00", c.toolHash("go", path))
+	for _, p := range check.GetPrerequisites() {
+		if len(p.HelpCommand) != 0 {
+			fmt.Fprintf(h, "%s\x00%s\x00", p.HelpCommand[0], c.toolHash(p.HelpCommand[0], path))
+		}
+	}
+	for _, f := range change.Changed().Files() {
+		fmt.Fprintf(h, "changed\x00%s\x00", f)
+	}
+	files := change.All().Files()
+	sort.Strings(files)
+	for _, f := range files {
+		fmt.Fprintf(h, "%s\x00%s\x00", f, c.fileHash(change, f))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// uncacheable returns true if the outcome of check depends on something else
+// than the files hashed by key(), like the upstream history for stalebranch or
+// anything the commands of custom and generated read. The files hashed are
+// without the ignored ones, so the checks reading a configuration that is
+// usually a dotfile, e.g. .golangci.yml for golangcilint or the CI workflows
+// for godirective, aren't cached either. gosum depends on the module cache.
+func uncacheable(check checks.Check) bool {
+	if l, ok := check.(*checks.LanguageCheck); ok {
+		check = l.Check
+	}
+	switch check.(type) {
+	case *checks.Custom, *checks.Generated, *checks.StaleBranch, *checks.GolangciLint, *checks.GoDirective, *checks.GoSum:
+		return true
+	}
+	return false
+}
+
+// fileHash returns the content hash of the file f in change.
+func (c *resultCache) fileHash(change scm.Change, f string) string {
+	k := filepath.Join(change.Repo().Root(), f)
+	c.lock.Lock()
+	s, ok := c.files[k]
+	c.lock.Unlock()
+	if !ok {
+		sum := sha256.Sum256(change.Content(f))
+		s = hex.EncodeToString(sum[:])
+		c.lock.Lock()
+		c.files[k] = s
+		c.lock.Unlock()
+	}
+	return s
+}
+
+// toolHash returns the path, size and modification time of the executable
+// name found in path, which change when the tool is updated. Hashing the
+// whole executables would cost more than the checks it saves.
+func (c *resultCache) toolHash(name, path string) string {
+	k := name + "\x00" + path
+	c.lock.Lock()
+	s, ok := c.tools[k]
+	p, found := c.paths[k]
+	c.lock.Unlock()
+	if !ok {
+		if !found {
+			p, _ = internal.LookPath(name, path)
+		}
+		s = fileStamp(p)
+		c.lock.Lock()
+		c.tools[k] = s
+		c.paths[k] = p
+		c.lock.Unlock()
+	}
+	return s
+}
+
+// toolStamp returns the path, size and modification time of the executable
+// name, or "missing" if it is not in $PATH.
+func toolStamp(name string) string {
+	p, _ := exec.LookPath(name)
+	return fileStamp(p)
+}
+
+// fileStamp returns the path, size and modification time of the executable p,
+// or "missing" if p is empty or doesn't exist.
+func fileStamp(p string) string {
+	if p != "" {
+		if fi, err := os.Stat(p); err == nil {
+			return fmt.Sprintf("%s %d %d", p, fi.Size(), fi.ModTime().UnixNano())
+		}
+	}
+	return "missing"
+}
+
+// evict removes the oldest entries to keep at most max.
+func (c *resultCache) evict(max int) {
+	if len(c.Results) <= max {
+		return
+	}
+	keys := make([]string, 0, len(c.Results))
+	for k := range c.Results {
+		keys = append(keys, k)
+	}
+	sort.Sort(&byNewest{keys, c.Results})
+	for _, k := range keys[max:] {
+		delete(c.Results, k)
+	}
+}
+
+type byNewest struct {
+	keys    []string
+	results map[string]*cachedResult
+}
+
+func (b *byNewest) Len() int      { return len(b.keys) }
+func (b *byNewest) Swap(i, j int) { b.keys[i], b.keys[j] = b.keys[j], b.keys[i] }
+func (b *byNewest) Less(i, j int) bool {
+	return b.results[b.keys[i]].Time.After(b.results[b.keys[j]].Time)
+}
@@ -0,0 +1,131 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestResultCache(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	p := filepath.Join(td, "a.go")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("package a\n"), 0600))
+	change, err := repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	options := &checks.Options{}
+	gofmt := &checks.Gofmt{}
+	c := &resultCache{Results: map[string]*cachedResult{}, files: map[string]string{}, tools: map[string]string{}, paths: map[string]string{}}
+	key, cached := c.lookup(gofmt, change, options)
+	ut.AssertEqual(t, (*cachedResult)(nil), cached)
+	ut.AssertEqual(t, 64, len(key))
+	// Only the passed runs are recorded.
+	c.record(key, checks.Result{Err: errors.New("gofmt failed")})
+	_, cached = c.lookup(gofmt, change, options)
+	ut.AssertEqual(t, (*cachedResult)(nil), cached)
+	c.record(key, checks.Result{})
+	_, cached = c.lookup(gofmt, change, options)
+	ut.AssertEqual(t, true, cached != nil)
+
+	// Another check, another configuration or other options are other runs.
+	ut.AssertEqual(t, false, c.key(&checks.Govet{}, change, options) == key)
+	ut.AssertEqual(t, false, c.key(&checks.Gofmt{Limits: checks.Limits{Paths: []string{"a.go"}}}, change, options) == key)
+	ut.AssertEqual(t, false, c.key(gofmt, change, &checks.Options{OnlyChanged: true}) == key)
+	ut.AssertEqual(t, "", c.key(gofmt, change, &checks.Options{OwnedOnly: true}))
+	// The checks depending on more than the files are never cached.
+	ut.AssertEqual(t, "", c.key(&checks.StaleBranch{}, change, options))
+	ut.AssertEqual(t, "", c.key(&checks.Custom{Command: []string{"true"}}, change, options))
+	ut.AssertEqual(t, "", c.key(&checks.LanguageCheck{Language: "go", Extensions: []string{".go"}, Check: &checks.Custom{Command: []string{"true"}}}, change, options))
+	ut.AssertEqual(t, 64, len(c.key(&checks.LanguageCheck{Language: "go", Extensions: []string{".go"}, Check: &checks.Gofmt{}}, change, options)))
+
+	// So is a modified file.
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("package a\n\nvar b int\n"), 0600))
+	change, err = repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+	c.files = map[string]string{}
+	ut.AssertEqual(t, false, c.key(gofmt, change, options) == key)
+
+	ut.AssertEqual(t, nil, c.save(repo))
+	ut.AssertEqual(t, []string{key}, keysOf(loadResultCache(repo).Results))
+}
+
+func TestResultCacheIgnoredConfig(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	p := filepath.Join(td, ".golangci.yml")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("linters:\n  enable: [govet]\n"), 0600))
+	change, err := repo.Files([]string{"a.go", ".golangci.yml"}, checks.New(version).IgnorePatterns)
+	ut.AssertEqual(t, nil, err)
+
+	// The ignored .golangci.yml isn't part of the hashed files, so the run is
+	// never replayed from the cache.
+	c := &resultCache{Results: map[string]*cachedResult{}, files: map[string]string{}, tools: map[string]string{}, paths: map[string]string{}}
+	lint := &checks.GolangciLint{}
+	key, _ := c.lookup(lint, change, &checks.Options{})
+	c.record(key, checks.Result{})
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("linters:\n  enable: [govet, errcheck]\n"), 0600))
+	_, cached := c.lookup(lint, change, &checks.Options{})
+	ut.AssertEqual(t, (*cachedResult)(nil), cached)
+	ut.AssertEqual(t, "", c.key(&checks.GoDirective{CIFiles: []string{".github/workflows/test.yml"}}, change, &checks.Options{}))
+}
+
+func TestResultCacheDisabled(t *testing.T) {
+	t.Parallel()
+	var c *resultCache
+	key, cached := c.lookup(&checks.Gofmt{}, nil, &checks.Options{})
+	ut.AssertEqual(t, "", key)
+	ut.AssertEqual(t, (*cachedResult)(nil), cached)
+	c.record("a", checks.Result{})
+	ut.AssertEqual(t, nil, c.save(nil))
+}
+
+func TestResultCacheEvict(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &resultCache{Results: map[string]*cachedResult{
+		"old":    {Time: now},
+		"newest": {Time: now.Add(2 * time.Hour)},
+		"new":    {Time: now.Add(time.Hour)},
+	}}
+	c.evict(3)
+	ut.AssertEqual(t, 3, len(c.Results))
+	c.evict(2)
+	ut.AssertEqual(t, []string{"new", "newest"}, keysOf(c.Results))
+}
+
+// Private stuff.
+
+func keysOf(m map[string]*cachedResult) []string {
+	var out []string
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
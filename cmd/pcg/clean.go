@@ -0,0 +1,51 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// State left behind by pcg, removed by 'pcg clean'.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// tmpPrefix is the prefix of the temporary directories created by pcg and
+// its checks.
+const tmpPrefix = "pre-commit-go"
+
+// staleTmpAge is the age after which a temporary directory is considered
+// left behind by an interrupted run instead of being used by a running one.
+const staleTmpAge = time.Hour
+
+// stateFiles are the files pcg keeps in the scm directory.
+var stateFiles = []string{cacheFile, historyFile, statsFile, prereqCacheFile, checks.CoverageCacheFile}
+
+// cleanPaths returns the files and directories to delete: the state files in
+// the scm directory and the stale temporary directories in tmpDir.
+func cleanPaths(repo scm.ReadOnlyRepo, tmpDir string, now time.Time) []string {
+	var out []string
+	for _, name := range stateFiles {
+		if p, err := scmFilePath(repo, name); err == nil {
+			if _, err := os.Stat(p); err == nil {
+				out = append(out, p)
+			}
+		}
+	}
+	entries, _ := ioutil.ReadDir(tmpDir)
+	for _, e := range entries {
+		if e.IsDir() && strings.HasPrefix(e.Name(), tmpPrefix) && now.Sub(e.ModTime()) > staleTmpAge {
+			out = append(out, filepath.Join(tmpDir, e.Name()))
+		}
+	}
+	sort.Strings(out)
+	return out
+}
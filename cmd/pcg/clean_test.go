@@ -0,0 +1,45 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestCleanPaths(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	repoDir := filepath.Join(td, "repo")
+	tmpDir := filepath.Join(td, "tmp")
+	ut.AssertEqual(t, nil, os.MkdirAll(repoDir, 0700))
+	_, code, err := internal.Capture(repoDir, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(repoDir, td)
+	ut.AssertEqual(t, nil, err)
+
+	now := time.Now()
+	ut.AssertEqual(t, []string(nil), cleanPaths(repo, tmpDir, now))
+
+	history := filepath.Join(repoDir, ".git", historyFile)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(history, []byte("{}"), 0600))
+	for _, name := range []string{"pre-commit-go123", "pre-commit-go456", "other"} {
+		ut.AssertEqual(t, nil, os.MkdirAll(filepath.Join(tmpDir, name), 0700))
+	}
+	old := now.Add(-2 * staleTmpAge)
+	ut.AssertEqual(t, nil, os.Chtimes(filepath.Join(tmpDir, "pre-commit-go123"), old, old))
+	ut.AssertEqual(t, nil, os.Chtimes(filepath.Join(tmpDir, "other"), old, old))
+	expected := []string{history, filepath.Join(tmpDir, "pre-commit-go123")}
+	ut.AssertEqual(t, expected, cleanPaths(repo, tmpDir, now))
+}
@@ -0,0 +1,59 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Colored terminal output.
+
+package main
+
+import (
+	"fmt"
+	"os"
+)
+
+// palette colorizes the text printed on the terminal. The zero value doesn't
+// colorize.
+type palette struct {
+	enabled bool
+}
+
+// colors is the palette of the standard output. It is enabled when it is a
+// terminal and NO_COLOR is not set; 'run -color' overrides it.
+var colors = palette{enabled: isTerminal(os.Stdout) && os.Getenv("NO_COLOR") == "" && os.Getenv("TERM") != "dumb"}
+
+// setColor overrides colors according to the -color flag: "auto", "always"
+// or "never".
+func setColor(mode string) error {
+	switch mode {
+	case "auto":
+	case "always":
+		colors.enabled = true
+	case "never":
+		colors.enabled = false
+	default:
+		return fmt.Errorf("invalid -color \"%s\", expected auto, always or never", mode)
+	}
+	return nil
+}
+
+func (p palette) red(s string) string {
+	return p.wrap("1;31", s)
+}
+
+func (p palette) green(s string) string {
+	return p.wrap("32", s)
+}
+
+func (p palette) yellow(s string) string {
+	return p.wrap("33", s)
+}
+
+// Private stuff.
+
+// wrap surrounds s with the ANSI escape sequence code, if enabled.
+func (p palette) wrap(code, s string) string {
+	if !p.enabled || s == "" {
+		return s
+	}
+	return "\x1b[" + code + "m" + s + "\x1b[0m"
+}
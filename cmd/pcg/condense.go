@@ -0,0 +1,82 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Condensed output of the failed checks, to keep big failures readable.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+// maxOutputLines is the number of lines of the output of a failed check
+// printed, unless fullOutput is set.
+const maxOutputLines = 40
+
+// maxGroupLocations is the number of locations listed for a group of
+// identical findings, besides the first one.
+const maxGroupLocations = 3
+
+// fullOutput disables condenseOutput, set with 'pcg run -full' or
+// $PRECOMMITGO_FULL_OUTPUT, e.g. for the hooks.
+var fullOutput = os.Getenv("PRECOMMITGO_FULL_OUTPUT") != ""
+
+// condenseOutput returns the output of a failed check, out, with the
+// findings sharing the same message grouped on the line of the first one,
+// then capped to maxLines lines.
+func condenseOutput(out string, maxLines int) string {
+	if fullOutput {
+		return out
+	}
+	lines := strings.Split(out, "\n")
+	// Index of the first line with each message and the other locations.
+	first := map[string]int{}
+	others := map[int][]string{}
+	kept := make([]string, 0, len(lines))
+	for _, line := range lines {
+		issues := checks.ParseIssues(line)
+		if len(issues) != 1 {
+			kept = append(kept, line)
+			continue
+		}
+		i := issues[0]
+		if j, ok := first[i.Message]; ok {
+			others[j] = append(others[j], issueLocation(i))
+			continue
+		}
+		first[i.Message] = len(kept)
+		kept = append(kept, line)
+	}
+	for j, locs := range others {
+		more := ""
+		if len(locs) > maxGroupLocations {
+			more = ", ..."
+			locs = locs[:maxGroupLocations]
+		}
+		kept[j] += fmt.Sprintf(" (and %d more: %s%s)", len(others[j]), strings.Join(locs, ", "), more)
+	}
+	if maxLines > 0 && len(kept) > maxLines {
+		n := len(kept) - maxLines
+		kept = append(kept[:maxLines], fmt.Sprintf("... and %d more line(s); use 'pcg run -full' to print them all", n))
+	}
+	return strings.Join(kept, "\n")
+}
+
+// Private stuff.
+
+// issueLocation returns "file:line[:col]".
+func issueLocation(i checks.Issue) string {
+	loc := i.File
+	if i.Line != 0 {
+		loc += fmt.Sprintf(":%d", i.Line)
+	}
+	if i.Column != 0 {
+		loc += fmt.Sprintf(":%d", i.Column)
+	}
+	return loc
+}
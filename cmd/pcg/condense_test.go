@@ -0,0 +1,29 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestCondenseOutput(t *testing.T) {
+	t.Parallel()
+	out := "go vet failed:\na/a.go:1:2: unreachable code\na/a.go:3: bad\nb/b.go:4:5: unreachable code\nc/c.go:6: unreachable code\n"
+	expected := "go vet failed:\na/a.go:1:2: unreachable code (and 2 more: b/b.go:4:5, c/c.go:6)\na/a.go:3: bad\n"
+	ut.AssertEqual(t, expected, condenseOutput(out, 0))
+
+	lines := []string{"golint failed:"}
+	for i := 1; i <= 6; i++ {
+		lines = append(lines, fmt.Sprintf("a.go:%d: same", i))
+	}
+	ut.AssertEqual(t, "golint failed:\na.go:1: same (and 5 more: a.go:2, a.go:3, a.go:4, ...)", condenseOutput(strings.Join(lines, "\n"), 0))
+
+	ut.AssertEqual(t, "1\n2\n... and 2 more line(s); use 'pcg run -full' to print them all", condenseOutput("1\n2\n3\n4", 2))
+	ut.AssertEqual(t, "1\n2", condenseOutput("1\n2", 2))
+}
@@ -0,0 +1,325 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// 'pcg daemon' keeps a warm process per repository that the hooks forward to
+// over a unix socket.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"runtime/debug"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// daemonSocket is the name of the unix socket of the daemon in the scm
+// directory, e.g. .git/.
+const daemonSocket = "pre-commit-go.sock"
+
+// daemonRequest is a hook run forwarded by 'pcg run-hook' to the daemon.
+type daemonRequest struct {
+	// Dir is the working directory of the hook, the root of the checkout.
+	Dir string
+	// Mode and Args are the arguments of 'run-hook'.
+	Mode     string
+	Args     []string
+	NoUpdate bool
+	// Env is the environment of the hook, e.g. GIT_INDEX_FILE or
+	// PRECOMMITGO_SKIP.
+	Env []string
+	// Stdin is the standard input of the hook, for pre-push.
+	Stdin []byte
+	// Color is true when the output of the hook is a colored terminal.
+	Color bool
+}
+
+// daemonMessage is streamed back to the hook: the output of the run, then
+// the outcome once Done.
+type daemonMessage struct {
+	// Stream is 1 for stdout and 2 for stderr.
+	Stream int    `json:",omitempty"`
+	Data   []byte `json:",omitempty"`
+	Done   bool   `json:",omitempty"`
+	// Code is the exit code and Error the error of the run, when Done.
+	Code  int    `json:",omitempty"`
+	Error string `json:",omitempty"`
+	// Fallback is set with Done when the daemon can't run the hook, e.g. it
+	// serves another checkout. The hook then runs in its own process.
+	Fallback bool `json:",omitempty"`
+}
+
+// daemon runs the hooks of a repository without loading it each time. The
+// hooks run concurrently, each with its own standard streams and environment.
+type daemon struct {
+	// lock protects r and inputs, which are replaced when the configuration is
+	// reloaded.
+	lock sync.Mutex
+	r    *repoFlags
+	// inputs is the hash of the configuration when it was loaded, including
+	// its base and nested configurations, to reload it once one is modified.
+	// See configHash.
+	inputs string
+}
+
+// cmdDaemon serves the hooks of the repository loaded in r until
+// interrupted.
+func cmdDaemon(r *repoFlags) error {
+	p, err := scmFilePath(r.repo, daemonSocket)
+	if err != nil {
+		return environmentError(err)
+	}
+	if c, err := net.Dial("unix", p); err == nil {
+		c.Close()
+		return environmentError(fmt.Errorf("a daemon is already running on %s", p))
+	}
+	// Left behind by a daemon that was killed.
+	_ = os.Remove(p)
+	l, err := net.Listen("unix", p)
+	if err != nil {
+		return environmentError(err)
+	}
+	defer os.Remove(p)
+	interrupted := make(chan os.Signal, 1)
+	signal.Notify(interrupted, os.Interrupt, syscall.SIGTERM)
+	stopped := make(chan struct{})
+	go func() {
+		<-interrupted
+		close(stopped)
+		l.Close()
+	}()
+	inputs, err := configHash(r.repo.Root(), r.configPath, r.configFile)
+	if err != nil {
+		return configError(err)
+	}
+	d := &daemon{r: r, inputs: inputs}
+	// The results of the checks, where the tools are and the imports of the
+	// packages are kept in memory between the runs.
+	daemonCache = loadResultCache(r.repo)
+	r.repo.CacheImports()
+	fmt.Fprintf(os.Stderr, "serving %s on %s; stop with Ctrl-C\n", r.repo.Root(), p)
+	err = d.serve(l)
+	if err := daemonCache.save(r.repo); err != nil {
+		log.Printf("failed to save cache: %s", err)
+	}
+	select {
+	case <-stopped:
+		return nil
+	default:
+		return err
+	}
+}
+
+// forwardToDaemon runs the hook in the daemon serving the repository in the
+// current directory. It returns false when no daemon is running or the daemon
+// can't run the hook, in which case the hook runs in this process.
+func forwardToDaemon(mode string, args []string, noUpdate bool) (bool, error) {
+	gitDir := os.Getenv("GIT_DIR")
+	if gitDir == "" {
+		gitDir = ".git"
+	}
+	conn, err := net.Dial("unix", filepath.Join(gitDir, daemonSocket))
+	if err != nil {
+		return false, nil
+	}
+	defer conn.Close()
+	dir, err := os.Getwd()
+	if err != nil {
+		return false, nil
+	}
+	req := &daemonRequest{Dir: dir, Mode: mode, Args: args, NoUpdate: noUpdate, Env: os.Environ(), Color: colors.enabled}
+	if checks.Mode(mode) == checks.PrePush {
+		if req.Stdin, err = ioutil.ReadAll(os.Stdin); err != nil {
+			return true, err
+		}
+	}
+	log.Printf("forwarding %s to the daemon", mode)
+	if err := json.NewEncoder(conn).Encode(req); err != nil {
+		return true, environmentError(err)
+	}
+	if err = readDaemonMessages(conn, os.Stdout, os.Stderr); err != errDaemonFallback {
+		return true, err
+	}
+	log.Printf("the daemon can't run %s; running it in process", mode)
+	if req.Stdin != nil {
+		// It was already read.
+		if os.Stdin, err = pipeFrom(req.Stdin); err != nil {
+			return true, environmentError(err)
+		}
+	}
+	return false, nil
+}
+
+// readDaemonMessages copies the output of the run from r and returns its
+// outcome.
+func readDaemonMessages(r io.Reader, stdout, stderr io.Writer) error {
+	dec := json.NewDecoder(r)
+	for {
+		m := &daemonMessage{}
+		if err := dec.Decode(m); err != nil {
+			return environmentError(fmt.Errorf("lost the daemon: %s", err))
+		}
+		switch {
+		case m.Done && m.Fallback:
+			return errDaemonFallback
+		case m.Done && m.Code == exitSuccess:
+			return nil
+		case m.Done:
+			return &exitError{m.Code, errors.New(m.Error)}
+		case m.Stream == 2:
+			stderr.Write(m.Data)
+		default:
+			stdout.Write(m.Data)
+		}
+	}
+}
+
+// Private stuff.
+
+// daemonCache, when set, is the result cache kept in memory by the daemon
+// instead of being loaded on each run.
+var daemonCache *resultCache
+
+// errDaemonFallback is returned when the daemon can't run the hook, which
+// must then run in process.
+var errDaemonFallback = errors.New("the daemon can't run the hook")
+
+// serve handles the connections on l until it is closed.
+func (d *daemon) serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go d.handle(conn)
+	}
+}
+
+// handle runs the hook requested on conn.
+func (d *daemon) handle(conn net.Conn) {
+	defer conn.Close()
+	req := &daemonRequest{}
+	if err := json.NewDecoder(conn).Decode(req); err != nil {
+		log.Printf("invalid request: %s", err)
+		return
+	}
+	var lock sync.Mutex
+	enc := json.NewEncoder(conn)
+	send := func(m *daemonMessage) {
+		lock.Lock()
+		defer lock.Unlock()
+		// The hook may have been interrupted; keep going.
+		_ = enc.Encode(m)
+	}
+	start := time.Now()
+	err := d.run(req, send)
+	log.Printf("%s in %1.2fs: %v", req.Mode, time.Now().Sub(start).Seconds(), err)
+	m := &daemonMessage{Done: true, Code: exitCode(err)}
+	if err == errDaemonFallback {
+		m = &daemonMessage{Done: true, Fallback: true}
+	} else if err != nil && err != errSilent {
+		m.Error = err.Error()
+	}
+	send(m)
+}
+
+// run runs the hook of req with its standard streams and environment.
+func (d *daemon) run(req *daemonRequest, send func(m *daemonMessage)) (err error) {
+	repo, config, err := d.load(req)
+	if err != nil {
+		return err
+	}
+	s := &session{
+		stdin:  bytes.NewReader(req.Stdin),
+		stdout: &daemonWriter{stream: 1, send: send},
+		stderr: &daemonWriter{stream: 2, send: send},
+		env:    req.Env,
+		colors: palette{enabled: req.Color},
+		// The live progress of the checks would be garbled over the socket.
+		progress: false,
+	}
+	defer func() {
+		if v := recover(); v != nil {
+			log.Printf("internal error: %v\n%s", v, debug.Stack())
+			err = &exitError{exitInternalError, fmt.Errorf("internal error: %v", v)}
+		}
+	}()
+	return cmdRunHook(s, repo.WithEnv(s.envOverrides()), config, req.Mode, req.Args, req.NoUpdate)
+}
+
+// load returns the repository and its configuration to run the hook of req,
+// reloading the configuration if it was modified.
+func (d *daemon) load(req *daemonRequest) (scm.Repo, *checks.Config, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !sameDir(req.Dir, d.r.repo.Root()) {
+		log.Printf("the daemon serves %s, not %s", d.r.repo.Root(), req.Dir)
+		return nil, nil, errDaemonFallback
+	}
+	if inputs, err := configHash(d.r.repo.Root(), d.r.configPath, d.r.configFile); err != nil || inputs != d.inputs {
+		log.Printf("reloading %s", d.r.configFile)
+		if err := d.r.load(); err != nil {
+			return nil, nil, err
+		}
+		d.r.repo.CacheImports()
+		d.inputs = inputs
+	}
+	if err := verifyConfig(d.r.repo, d.r.configPath, d.r.configFile); err != nil {
+		return nil, nil, configError(err)
+	}
+	return d.r.repo, d.r.config, nil
+}
+
+// daemonWriter sends what is written to it to the hook, as stdout for the
+// stream 1 or stderr for the stream 2.
+type daemonWriter struct {
+	stream int
+	send   func(m *daemonMessage)
+}
+
+func (w *daemonWriter) Write(p []byte) (int, error) {
+	w.send(&daemonMessage{Stream: w.stream, Data: append([]byte{}, p...)})
+	return len(p), nil
+}
+
+// pipeFrom returns a file reading content.
+func pipeFrom(content []byte) (*os.File, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		w.Write(content)
+		w.Close()
+	}()
+	return r, nil
+}
+
+// sameDir returns true if the paths a and b are the same directory, once the
+// symlinks are resolved.
+func sameDir(a, b string) bool {
+	if a == b {
+		return true
+	}
+	ra, err := filepath.EvalSymlinks(a)
+	if err != nil {
+		return false
+	}
+	rb, err := filepath.EvalSymlinks(b)
+	return err == nil && ra == rb
+}
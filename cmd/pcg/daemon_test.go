@@ -0,0 +1,90 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestReadDaemonMessages(t *testing.T) {
+	t.Parallel()
+	in := &bytes.Buffer{}
+	enc := json.NewEncoder(in)
+	ut.AssertEqual(t, nil, enc.Encode(&daemonMessage{Stream: 1, Data: []byte("out\n")}))
+	ut.AssertEqual(t, nil, enc.Encode(&daemonMessage{Stream: 2, Data: []byte("err\n")}))
+	ut.AssertEqual(t, nil, enc.Encode(&daemonMessage{Done: true, Code: exitChecksFailed, Error: "checks failed"}))
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	err := readDaemonMessages(in, stdout, stderr)
+	ut.AssertEqual(t, checksFailed(errors.New("checks failed")), err)
+	ut.AssertEqual(t, exitChecksFailed, exitCode(err))
+	ut.AssertEqual(t, "out\n", stdout.String())
+	ut.AssertEqual(t, "err\n", stderr.String())
+
+	ut.AssertEqual(t, nil, enc.Encode(&daemonMessage{Done: true}))
+	ut.AssertEqual(t, nil, readDaemonMessages(in, stdout, stderr))
+	ut.AssertEqual(t, nil, enc.Encode(&daemonMessage{Done: true, Fallback: true}))
+	ut.AssertEqual(t, errDaemonFallback, readDaemonMessages(in, stdout, stderr))
+	// The daemon went away.
+	ut.AssertEqual(t, exitEnvironmentError, exitCode(readDaemonMessages(in, stdout, stderr)))
+}
+
+func TestDaemon(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	l, err := net.Listen("unix", filepath.Join(td, daemonSocket))
+	ut.AssertEqual(t, nil, err)
+	defer l.Close()
+	r := &repoFlags{repo: repo, config: checks.New(version), configPath: "pre-commit-go.yml", configFile: "<N/A>"}
+	inputs, err := configHash(repo.Root(), r.configPath, r.configFile)
+	ut.AssertEqual(t, nil, err)
+	d := &daemon{r: r, inputs: inputs}
+	go d.serve(l)
+
+	call := func(req *daemonRequest) (string, error) {
+		conn, err := net.Dial("unix", filepath.Join(td, daemonSocket))
+		ut.AssertEqual(t, nil, err)
+		defer conn.Close()
+		ut.AssertEqual(t, nil, json.NewEncoder(conn).Encode(req))
+		stdout := &bytes.Buffer{}
+		err = readDaemonMessages(conn, stdout, ioutil.Discard)
+		return stdout.String(), err
+	}
+	_, err = call(&daemonRequest{Dir: repo.Root(), Mode: "foo"})
+	ut.AssertEqual(t, &exitError{exitInternalError, errors.New("unsupported hook type for run-hook")}, err)
+	// The hook runs with its own environment and output; nothing is staged.
+	out, err := call(&daemonRequest{Dir: repo.Root(), Mode: "pre-commit", Env: []string{skipEnvVar + "=foo"}})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "warning: cannot skip unknown check \"foo\"\n", out)
+	out, err = call(&daemonRequest{Dir: repo.Root(), Mode: "pre-commit", Env: os.Environ()})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "", out)
+	// The same checkout through a symlink.
+	link := filepath.Join(td, "link")
+	ut.AssertEqual(t, nil, os.Symlink(repo.Root(), link))
+	_, err = call(&daemonRequest{Dir: link, Mode: "foo"})
+	ut.AssertEqual(t, &exitError{exitInternalError, errors.New("unsupported hook type for run-hook")}, err)
+	// Another checkout runs in process.
+	_, err = call(&daemonRequest{Dir: filepath.Join(repo.Root(), "foo"), Mode: "pre-commit"})
+	ut.AssertEqual(t, errDaemonFallback, err)
+}
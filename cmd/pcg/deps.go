@@ -0,0 +1,62 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Ordering of the checks declared with depends_on.
+
+package main
+
+import (
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+// depGraph tracks which checks can be started, based on the completion of the
+// checks they depend on.
+type depGraph struct {
+	// remaining is the number of instances of each check name not completed.
+	remaining map[string]int
+	// failed is set for the check names with a failed instance.
+	failed map[string]bool
+}
+
+func newDepGraph(enabledChecks []checks.Check) *depGraph {
+	g := &depGraph{remaining: map[string]int{}, failed: map[string]bool{}}
+	for _, c := range enabledChecks {
+		g.remaining[c.GetName()]++
+	}
+	return g
+}
+
+// state returns whether check can be started and, if it must be skipped, the
+// name of the dependency that failed.
+func (g *depGraph) state(check checks.Check) (bool, string) {
+	ready := true
+	for _, d := range dependencies(check) {
+		if g.failed[d] {
+			return false, d
+		}
+		if g.remaining[d] != 0 {
+			ready = false
+		}
+	}
+	return ready, ""
+}
+
+// done records the completion of a check. A non-blocking failure, e.g. of a
+// check with severity "warning", doesn't block the checks depending on it.
+func (g *depGraph) done(r *result) {
+	g.remaining[r.check.GetName()]--
+	if r.blocking() {
+		g.failed[r.check.GetName()] = true
+	}
+}
+
+// Private stuff.
+
+// dependencies returns the names of the checks to complete before check.
+func dependencies(check checks.Check) []string {
+	if l, ok := check.(checks.Limiter); ok {
+		return l.GetDependencies()
+	}
+	return nil
+}
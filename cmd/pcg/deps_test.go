@@ -0,0 +1,248 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestDepGraph(t *testing.T) {
+	t.Parallel()
+	build := &orderedCheck{name: "build"}
+	test := &orderedCheck{Limits: checks.Limits{DependsOn: []string{"build", "missing"}}, name: "test"}
+	g := newDepGraph([]checks.Check{build, test})
+	ready, failed := g.state(build)
+	ut.AssertEqual(t, true, ready)
+	ut.AssertEqual(t, "", failed)
+	ready, _ = g.state(test)
+	ut.AssertEqual(t, false, ready)
+	g.done(&result{check: build})
+	ready, _ = g.state(test)
+	ut.AssertEqual(t, true, ready)
+
+	g = newDepGraph([]checks.Check{build, test})
+	g.done(&result{check: build, err: errors.New("failed")})
+	_, failed = g.state(test)
+	ut.AssertEqual(t, "build", failed)
+
+	// A non-blocking check doesn't block the checks depending on it.
+	lint := &orderedCheck{Limits: checks.Limits{Severity: checks.SeverityWarning}, name: "build"}
+	g = newDepGraph([]checks.Check{lint, test})
+	g.done(&result{check: lint, err: errors.New("failed")})
+	ready, failed = g.state(test)
+	ut.AssertEqual(t, true, ready)
+	ut.AssertEqual(t, "", failed)
+}
+
+func TestRunAllChecksDependsOn(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	change, err := repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	var lock sync.Mutex
+	var order []string
+	mk := func(name string, err error, deps ...string) *orderedCheck {
+		return &orderedCheck{Limits: checks.Limits{DependsOn: deps}, name: name, err: err, lock: &lock, order: &order}
+	}
+	failure := errors.New("failure")
+	enabled := []checks.Check{
+		mk("test", nil, "build"),
+		mk("build", nil, "generate"),
+		mk("generate", nil),
+		mk("lint", failure),
+		mk("vet", nil, "lint"),
+		mk("a", nil, "b"),
+		mk("b", nil, "a"),
+	}
+	results := runAllChecks(processSession(), enabled, &checks.Options{}, change, &sync.WaitGroup{})
+	ut.AssertEqual(t, len(enabled), len(results))
+	errs := map[string]error{}
+	for _, r := range results {
+		errs[r.check.GetName()] = r.err
+	}
+	ut.AssertEqual(t, map[string]error{
+		"test":     nil,
+		"build":    nil,
+		"generate": nil,
+		"lint":     failure,
+		"vet":      errors.New("vet skipped: lint failed"),
+		"a":        errors.New("a skipped: circular depends_on"),
+		"b":        errors.New("b skipped: circular depends_on"),
+	}, errs)
+	index := map[string]int{}
+	for i, name := range order {
+		index[name] = i
+	}
+	ut.AssertEqual(t, true, index["generate"] < index["build"])
+	ut.AssertEqual(t, true, index["build"] < index["test"])
+}
+
+func TestRunAllChecksMaxParallel(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	change, err := repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	var running, peak int32
+	mk := func(name string, weight int) *concurrentCheck {
+		return &concurrentCheck{Limits: checks.Limits{Weight: weight}, name: name, running: &running, peak: &peak}
+	}
+	// The heavy checks use all the slots; the light ones can run in pairs.
+	enabled := []checks.Check{mk("a", 1), mk("b", 1), mk("c", 1), mk("d", 1), mk("e", 5), mk("f", 2)}
+	results := runAllChecks(processSession(), enabled, &checks.Options{MaxParallel: 2}, change, &sync.WaitGroup{})
+	ut.AssertEqual(t, len(enabled), len(results))
+	ut.AssertEqual(t, int32(2), peak)
+
+	ut.AssertEqual(t, 3, parallelism(&checks.Options{MaxParallel: 3}))
+	ut.AssertEqual(t, 2, checkWeight(mk("e", 5), 2))
+	ut.AssertEqual(t, 1, checkWeight(mk("a", 0), 2))
+}
+
+func TestRunAllChecksFailFast(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	change, err := repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	var lock sync.Mutex
+	var order []string
+	failure := errors.New("failure")
+	enabled := []checks.Check{
+		&checks.Custom{DisplayName: "sleep", Command: []string{"sleep", "30"}, CheckExitCode: true},
+		&orderedCheck{name: "lint", err: failure, lock: &lock, order: &order},
+		&orderedCheck{name: "vet", lock: &lock, order: &order},
+	}
+	start := time.Now()
+	results := runAllChecks(processSession(), enabled, &checks.Options{MaxParallel: 2, FailFast: true}, change, &sync.WaitGroup{})
+	ut.AssertEqual(t, true, time.Now().Sub(start) < 10*time.Second)
+	errs := map[string]error{}
+	for _, r := range results {
+		errs[r.check.GetName()] = r.err
+	}
+	ut.AssertEqual(t, map[string]error{
+		"custom": errors.New("custom canceled: lint failed"),
+		"lint":   failure,
+		"vet":    errors.New("vet skipped: lint failed"),
+	}, errs)
+	ut.AssertEqual(t, []string{"lint"}, order)
+}
+
+func TestRunAllChecksHeavy(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	change, err := repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	var lock sync.Mutex
+	var order []string
+	mk := func(name string, delay time.Duration) *orderedCheck {
+		return &orderedCheck{name: name, lock: &lock, order: &order, delay: delay}
+	}
+	enabled := []checks.Check{mk("light1", 0), mk("heavy2", 0), mk("light2", 0), mk("heavy1", 200*time.Millisecond)}
+	h := &history{Durations: map[string]float64{}}
+	h.record(enabled[3], 10*time.Second)
+	h.record(enabled[1], 9*time.Second)
+	h.record(enabled[0], time.Second)
+	h.record(enabled[2], time.Second)
+	ut.AssertEqual(t, map[checks.Check]bool{enabled[1]: true, enabled[3]: true}, h.heavy(enabled))
+	ut.AssertEqual(t, nil, h.save(repo))
+
+	// heavy2 waits for heavy1 as long as the light checks can use the other
+	// slot, then runs along heavy1 instead of leaving the slot unused.
+	results := runAllChecks(processSession(), enabled, &checks.Options{MaxParallel: 2}, change, &sync.WaitGroup{})
+	ut.AssertEqual(t, len(enabled), len(results))
+	ut.AssertEqual(t, []string{"heavy1", "light1", "light2", "heavy2"}, order)
+	ut.AssertEqual(t, "heavy2", results[2].check.GetName())
+}
+
+// Private stuff.
+
+// orderedCheck records the order in which the checks run and returns err.
+type orderedCheck struct {
+	checks.Limits
+	name  string
+	err   error
+	lock  *sync.Mutex
+	order *[]string
+	// delay is how long the check runs once started.
+	delay time.Duration
+}
+
+func (f *orderedCheck) GetDescription() string                       { return "fake" }
+func (f *orderedCheck) GetName() string                              { return f.name }
+func (f *orderedCheck) GetPrerequisites() []checks.CheckPrerequisite { return nil }
+
+func (f *orderedCheck) Run(change scm.Change, options *checks.Options) checks.Result {
+	f.lock.Lock()
+	*f.order = append(*f.order, f.name)
+	f.lock.Unlock()
+	time.Sleep(f.delay)
+	return checks.Result{Err: f.err}
+}
+
+// concurrentCheck records the peak number of checks running at once.
+type concurrentCheck struct {
+	checks.Limits
+	name    string
+	running *int32
+	peak    *int32
+}
+
+func (c *concurrentCheck) GetDescription() string                       { return "fake" }
+func (c *concurrentCheck) GetName() string                              { return c.name }
+func (c *concurrentCheck) GetPrerequisites() []checks.CheckPrerequisite { return nil }
+
+func (c *concurrentCheck) Run(change scm.Change, options *checks.Options) checks.Result {
+	n := atomic.AddInt32(c.running, 1)
+	defer atomic.AddInt32(c.running, -1)
+	for {
+		p := atomic.LoadInt32(c.peak)
+		if n <= p || atomic.CompareAndSwapInt32(c.peak, p, n) {
+			break
+		}
+	}
+	time.Sleep(20 * time.Millisecond)
+	return checks.Result{}
+}
@@ -0,0 +1,74 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Desktop notification when a run finishes.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// desktopNotification enables the desktop notification at the end of each
+// run, set with 'pcg run -notify' or $PRECOMMITGO_NOTIFY, e.g. for the hooks.
+var desktopNotification = os.Getenv("PRECOMMITGO_NOTIFY") != ""
+
+// notifyDesktop shows a desktop notification summarizing results, when
+// desktopNotification is set. repoName is the title of the notification. It
+// doesn't wait for the notification to be shown.
+func notifyDesktop(repoName string, results []result, duration time.Duration) {
+	if !desktopNotification {
+		return
+	}
+	args := desktopNotifyCommand(runtime.GOOS, "pcg: "+repoName, desktopMessage(results, duration))
+	if args == nil {
+		log.Printf("desktop notifications are not supported on %s", runtime.GOOS)
+		return
+	}
+	if err := exec.Command(args[0], args[1:]...).Start(); err != nil {
+		log.Printf("failed to notify: %s", err)
+	}
+}
+
+// desktopMessage returns the text of the notification.
+func desktopMessage(results []result, duration time.Duration) string {
+	var failed []string
+	for _, r := range results {
+		if r.blocking() {
+			failed = append(failed, r.check.GetName())
+		}
+	}
+	if len(failed) != 0 {
+		return fmt.Sprintf("%s failed in %1.2fs", strings.Join(failed, ", "), duration.Seconds())
+	}
+	return fmt.Sprintf("%d checks passed in %1.2fs", len(results), duration.Seconds())
+}
+
+// desktopNotifyCommand returns the command line showing a notification on
+// goos, nil if unsupported.
+func desktopNotifyCommand(goos, title, msg string) []string {
+	switch goos {
+	case "darwin":
+		q := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+		return []string{"osascript", "-e", fmt.Sprintf(`display notification "%s" with title "%s"`, q.Replace(msg), q.Replace(title))}
+	case "windows":
+		q := strings.NewReplacer("'", "''")
+		script := "Add-Type -AssemblyName System.Windows.Forms; " +
+			"$n = New-Object System.Windows.Forms.NotifyIcon; " +
+			"$n.Icon = [System.Drawing.SystemIcons]::Information; " +
+			"$n.Visible = $true; " +
+			fmt.Sprintf("$n.ShowBalloonTip(10000, '%s', '%s', 'None'); ", q.Replace(title), q.Replace(msg)) +
+			"Start-Sleep -Seconds 10; $n.Dispose()"
+		return []string{"powershell", "-NoProfile", "-Command", script}
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return []string{"notify-send", "-a", "pcg", title, msg}
+	}
+	return nil
+}
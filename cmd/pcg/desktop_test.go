@@ -0,0 +1,36 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestDesktopMessage(t *testing.T) {
+	t.Parallel()
+	results := []result{
+		{check: &fakeCheck{name: "ok"}},
+		{check: &checks.Golint{Limits: checks.Limits{Severity: checks.SeverityWarning}}, err: errors.New("lint")},
+	}
+	ut.AssertEqual(t, "2 checks passed in 1.50s", desktopMessage(results, 1500*time.Millisecond))
+	results = append(results, result{check: &fakeCheck{name: "test"}, err: errors.New("test failed")})
+	ut.AssertEqual(t, "test failed in 2.00s", desktopMessage(results, 2*time.Second))
+}
+
+func TestDesktopNotifyCommand(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, []string{"notify-send", "-a", "pcg", "pcg: repo", "test failed"}, desktopNotifyCommand("linux", "pcg: repo", "test failed"))
+	ut.AssertEqual(t, []string{"osascript", "-e", `display notification "a \"b\"" with title "pcg: repo"`}, desktopNotifyCommand("darwin", "pcg: repo", `a "b"`))
+	win := desktopNotifyCommand("windows", "pcg: repo", "it's done")
+	ut.AssertEqual(t, "powershell", win[0])
+	ut.AssertEqual(t, true, strings.Contains(win[3], "ShowBalloonTip(10000, 'pcg: repo', 'it''s done', 'None')"))
+	ut.AssertEqual(t, []string(nil), desktopNotifyCommand("plan9", "pcg", "done"))
+}
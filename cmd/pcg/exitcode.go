@@ -0,0 +1,70 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Exit codes, so wrapper scripts and CI can branch on the class of failure.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Exit codes of pcg.
+const (
+	// exitSuccess is returned when all the checks passed, or on -help.
+	exitSuccess = 0
+	// exitChecksFailed is returned when at least one blocking check failed.
+	exitChecksFailed = 1
+	// exitConfigError is returned for an invalid configuration or command
+	// line.
+	exitConfigError = 2
+	// exitEnvironmentError is returned when the environment prevents running
+	// the checks, e.g. not in a git checkout or missing prerequisites.
+	exitEnvironmentError = 3
+	// exitInternalError is returned for any other error, including a crash.
+	exitInternalError = 4
+)
+
+// exitError is an error with the exit code of its class.
+type exitError struct {
+	code int
+	err  error
+}
+
+func (e *exitError) Error() string {
+	return e.err.Error()
+}
+
+// checksFailed marks err as the failure of the checks.
+func checksFailed(err error) error {
+	return &exitError{exitChecksFailed, err}
+}
+
+// configError marks err as an error in the configuration or the command line.
+func configError(err error) error {
+	return &exitError{exitConfigError, err}
+}
+
+// usageErrorf returns an error about the command line.
+func usageErrorf(format string, a ...interface{}) error {
+	return configError(fmt.Errorf(format, a...))
+}
+
+// environmentError marks err as an error of the environment.
+func environmentError(err error) error {
+	return &exitError{exitEnvironmentError, err}
+}
+
+// exitCode returns the exit code of the process when mainImpl returned err.
+func exitCode(err error) int {
+	if err == nil || err == errSilent {
+		return exitSuccess
+	}
+	var e *exitError
+	if errors.As(err, &e) {
+		return e.code
+	}
+	return exitInternalError
+}
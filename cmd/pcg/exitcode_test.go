@@ -0,0 +1,42 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		err      error
+		expected int
+	}{
+		{nil, 0},
+		{errSilent, 0},
+		{checksFailed(errors.New("checks failed")), 1},
+		{usageErrorf("bad flag %s", "-x"), 2},
+		{configError(errors.New("bad config")), 2},
+		{environmentError(errors.New("not a git checkout")), 3},
+		{fmt.Errorf("wrapped: %w", environmentError(errors.New("missing"))), 3},
+		{errors.New("unexpected"), 4},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, exitCode(line.err))
+	}
+	ut.AssertEqual(t, "bad flag -x", usageErrorf("bad flag %s", "-x").Error())
+}
+
+func TestMainImplExitCode(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, exitConfigError, exitCode(mainImpl([]string{"foo"})))
+	ut.AssertEqual(t, exitConfigError, exitCode(mainImpl([]string{"run", "-nope"})))
+	ut.AssertEqual(t, exitSuccess, exitCode(mainImpl([]string{"version", "-help"})))
+	ut.AssertEqual(t, exitConfigError, exitCode(mainImpl([]string{"run", "-format", "xml"})))
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Detailed description of a check, printed by 'pcg explain'.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// checkInstance is a configured check and the options of the mode enabling
+// it.
+type checkInstance struct {
+	mode    checks.Mode
+	check   checks.Check
+	options *checks.Options
+}
+
+// findInstances returns the instances of the check name enabled in modes.
+func findInstances(config *checks.Config, modes []checks.Mode, name string) []checkInstance {
+	var out []checkInstance
+	for _, mode := range modes {
+		_, options := config.EnabledChecks([]checks.Mode{mode})
+		for _, c := range config.Modes[mode].Checks[name] {
+			out = append(out, checkInstance{mode, c, options})
+		}
+	}
+	return out
+}
+
+// explain prints what the check does, the commands it would run on change,
+// its prerequisites with their version and how to fix its failures. When the
+// check is not enabled, instances is empty and the default options are used.
+func explain(w io.Writer, name string, instances []checkInstance, change scm.Change) error {
+	factory, ok := checks.KnownChecks[name]
+	if !ok {
+		return fmt.Errorf("unknown check \"%s\"", name)
+	}
+	fmt.Fprintf(w, "%s: %s\n", name, factory().GetDescription())
+	if len(instances) == 0 {
+		fmt.Fprintf(w, "\nNot enabled; using the default options.\n")
+		instances = []checkInstance{{"", factory(), &checks.Options{}}}
+	}
+	for _, i := range instances {
+		if i.mode != "" {
+			fmt.Fprintf(w, "\nEnabled in %s with:\n", i.mode)
+		} else {
+			fmt.Fprintf(w, "\nOptions:\n")
+		}
+		content, err := yaml.Marshal(i.check)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "  %s\n", strings.Join(strings.Split(strings.TrimSpace(string(content)), "\n"), "\n  "))
+		fmt.Fprintf(w, "Commands:\n")
+		c, ok := i.check.(checks.Commander)
+		switch {
+		case !ok:
+			fmt.Fprintf(w, "  none, it runs in process\n")
+		case change == nil:
+			fmt.Fprintf(w, "  none, no file is modified\n")
+		case checkScope(i.check, change) == nil:
+			fmt.Fprintf(w, "  none, no modified file is in its paths\n")
+		default:
+			cmds := c.Commands(checkScope(i.check, change), i.options)
+			if len(cmds) == 0 {
+				fmt.Fprintf(w, "  none, no file it checks is modified\n")
+			}
+			for _, cmd := range cmds {
+				fmt.Fprintf(w, "  %s\n", strings.Join(cmd, " "))
+			}
+		}
+	}
+	if prereqs := factory().GetPrerequisites(); len(prereqs) != 0 {
+		fmt.Fprintf(w, "\nPrerequisites:\n")
+		for _, p := range prereqs {
+			v := p.Version()
+			if v == "" {
+				v = "missing"
+				if p.URL != "" {
+					v += "; install with: go get " + p.URL
+				} else if p.InstallHint != "" {
+					v += "; " + p.InstallHint
+				}
+			}
+			fmt.Fprintf(w, "  %s: %s\n", prereqName(p), v)
+		}
+	}
+	fmt.Fprintf(w, "\nRemediation:\n  %s\n", checks.Remediation(name))
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestFindInstances(t *testing.T) {
+	t.Parallel()
+	config := checks.New(version)
+	instances := findInstances(config, checks.AllModes, "gofmt")
+	ut.AssertEqual(t, 2, len(instances))
+	ut.AssertEqual(t, checks.PreCommit, instances[0].mode)
+	ut.AssertEqual(t, true, instances[0].options.OnlyChanged)
+	ut.AssertEqual(t, checks.ContinuousIntegration, instances[1].mode)
+	ut.AssertEqual(t, false, instances[1].options.OnlyChanged)
+	ut.AssertEqual(t, 0, len(findInstances(config, []checks.Mode{checks.PrePush}, "gofmt")))
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+	out := &bytes.Buffer{}
+	ut.AssertEqual(t, errors.New("unknown check \"foo\""), explain(out, "foo", nil, nil))
+
+	config := checks.New(version)
+	ut.AssertEqual(t, nil, explain(out, "gofmt", findInstances(config, []checks.Mode{checks.PreCommit}, "gofmt"), nil))
+	expected := "gofmt: enforces all .go sources are formatted with 'gofmt -s'\n" +
+		"\nEnabled in pre-commit with:\n  {}\nCommands:\n  none, it runs in process\n" +
+		"\nRemediation:\n  " + checks.Remediation("gofmt") + "\n"
+	ut.AssertEqual(t, expected, out.String())
+
+	out.Reset()
+	ut.AssertEqual(t, nil, explain(out, "clock", nil, nil))
+	expected = "clock: " + (&checks.Clock{}).GetDescription() + "\n" +
+		"\nNot enabled; using the default options.\n" +
+		"\nOptions:\n  packages: []\n  allow: []\n  functions: []\nCommands:\n  none, it runs in process\n" +
+		"\nRemediation:\n  " + checks.Remediation("clock") + "\n"
+	ut.AssertEqual(t, expected, out.String())
+}
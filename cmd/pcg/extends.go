@@ -0,0 +1,205 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Base configurations, referenced with the "extends" key.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+// extendsCacheTTL is how long a remote base configuration not pinned with
+// extends_sha256 is used from the cache before being fetched again.
+const extendsCacheTTL = time.Hour
+
+// maxExtendsDepth is the maximum number of chained base configurations.
+const maxExtendsDepth = 10
+
+// extendsTimeout is the maximum duration of the download of a remote base
+// configuration.
+const extendsTimeout = 30 * time.Second
+
+// maxExtendsSize is the maximum size of a remote base configuration.
+const maxExtendsSize = 1 << 20
+
+// extendsLoader loads the base configurations.
+type extendsLoader struct {
+	// cacheDir is where the remote base configurations are cached.
+	cacheDir string
+	client   *http.Client
+}
+
+// newExtendsLoader returns an extendsLoader caching in the user cache
+// directory.
+func newExtendsLoader() *extendsLoader {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		cacheDir = os.TempDir()
+	}
+	return &extendsLoader{
+		cacheDir: filepath.Join(cacheDir, "pre-commit-go", "extends"),
+		client:   &http.Client{Timeout: extendsTimeout},
+	}
+}
+
+// resolve returns content, the configuration found at location, merged over
+// its base configurations. It is returned as is if it has no "extends" key.
+func (e *extendsLoader) resolve(location string, content []byte) ([]byte, error) {
+	raw, err := e.load(location, content, 0)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return content, nil
+	}
+	return yaml.Marshal(raw)
+}
+
+// load returns the configuration merged over its bases, nil if it has no
+// base.
+func (e *extendsLoader) load(location string, content []byte, depth int) (map[interface{}]interface{}, error) {
+	// Decoded as strings even when they look like numbers, e.g. a hash.
+	keys := &struct {
+		Extends       string `yaml:"extends"`
+		ExtendsSHA256 string `yaml:"extends_sha256"`
+	}{}
+	if err := yaml.Unmarshal(content, keys); err != nil {
+		return nil, err
+	}
+	if keys.Extends == "" {
+		return nil, nil
+	}
+	if depth == maxExtendsDepth {
+		return nil, fmt.Errorf("%s: more than %d levels of extends", location, maxExtendsDepth)
+	}
+	raw := map[interface{}]interface{}{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+	pin := keys.ExtendsSHA256
+	baseLocation, err := resolveLocation(location, keys.Extends)
+	if err != nil {
+		return nil, err
+	}
+	baseContent, err := e.read(baseLocation, pin)
+	if err != nil {
+		return nil, err
+	}
+	if pin != "" && !strings.EqualFold(pin, sha256Hex(baseContent)) {
+		return nil, fmt.Errorf("%s: sha256 is %s, expected %s", baseLocation, sha256Hex(baseContent), pin)
+	}
+	if baseContent, err = decodeConfig(baseLocation, baseContent); err != nil {
+		return nil, err
+	}
+	base, err := e.load(baseLocation, baseContent, depth+1)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil {
+		if err := yaml.Unmarshal(baseContent, &base); err != nil {
+			return nil, fmt.Errorf("%s: %s", baseLocation, err)
+		}
+	}
+	delete(raw, "extends")
+	delete(raw, "extends_sha256")
+	return checks.MergeYAML(base, raw), nil
+}
+
+// read returns the content of a base configuration.
+func (e *extendsLoader) read(location, pin string) ([]byte, error) {
+	if !strings.HasPrefix(location, "https://") {
+		return ioutil.ReadFile(location)
+	}
+	p := filepath.Join(e.cacheDir, sha256Hex([]byte(location))+".yml")
+	cached, err := ioutil.ReadFile(p)
+	if err == nil {
+		if pin != "" && strings.EqualFold(pin, sha256Hex(cached)) {
+			return cached, nil
+		}
+		if fi, err := os.Stat(p); pin == "" && err == nil && time.Now().Sub(fi.ModTime()) < extendsCacheTTL {
+			return cached, nil
+		}
+	}
+	content, err := e.fetch(location)
+	if err != nil {
+		if cached != nil && pin == "" {
+			fmt.Printf("warning: using the cached %s: %s\n", location, err)
+			return cached, nil
+		}
+		return nil, err
+	}
+	if err := os.MkdirAll(e.cacheDir, 0700); err != nil {
+		log.Printf("failed to cache %s: %s", location, err)
+	} else if err := ioutil.WriteFile(p, content, 0600); err != nil {
+		log.Printf("failed to cache %s: %s", location, err)
+	}
+	return content, nil
+}
+
+// fetch downloads a remote base configuration.
+func (e *extendsLoader) fetch(location string) ([]byte, error) {
+	log.Printf("fetching %s", location)
+	resp, err := e.client.Get(location)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("GET %s failed: %s", location, resp.Status)
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxExtendsSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(content) > maxExtendsSize {
+		return nil, fmt.Errorf("%s is larger than %d bytes", location, maxExtendsSize)
+	}
+	return content, nil
+}
+
+// Private stuff.
+
+// resolveLocation returns the location of extends, relative to the
+// configuration at location.
+func resolveLocation(location, extends string) (string, error) {
+	if strings.HasPrefix(extends, "http://") {
+		return "", errors.New("extends: only https URLs are supported")
+	}
+	if strings.HasPrefix(location, "https://") {
+		base, err := url.Parse(location)
+		if err != nil {
+			return "", err
+		}
+		ref, err := url.Parse(extends)
+		if err != nil {
+			return "", err
+		}
+		return base.ResolveReference(ref).String(), nil
+	}
+	if strings.HasPrefix(extends, "https://") || filepath.IsAbs(extends) {
+		return extends, nil
+	}
+	return filepath.Join(filepath.Dir(location), filepath.FromSlash(extends)), nil
+}
+
+func sha256Hex(content []byte) string {
+	h := sha256.Sum256(content)
+	return hex.EncodeToString(h[:])
+}
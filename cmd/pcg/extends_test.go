@@ -0,0 +1,113 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+const extendsBase = `modes:
+  pre-commit:
+    max_duration: 5
+    checks:
+      gofmt:
+      - {}
+      golint:
+      - blacklist: []
+ignore_patterns:
+- .*
+`
+
+func TestExtendsLocal(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "base.yml"), []byte(extendsBase), 0600))
+	local := "extends: base.yml\nmodes:\n  pre-commit:\n    max_duration: 10\n    checks:\n      golint: null\n"
+	e := &extendsLoader{cacheDir: filepath.Join(td, "cache"), client: http.DefaultClient}
+	content, err := e.resolve(filepath.Join(td, "pre-commit-go.yml"), []byte(local))
+	ut.AssertEqual(t, nil, err)
+	config := &checks.Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(content, config))
+	ut.AssertEqual(t, "", config.Extends)
+	ut.AssertEqual(t, 10, config.Modes[checks.PreCommit].Options.MaxDuration)
+	ut.AssertEqual(t, checks.Checks{"gofmt": {&checks.Gofmt{}}}, config.Modes[checks.PreCommit].Checks)
+	ut.AssertEqual(t, []string{".*"}, config.IgnorePatterns)
+
+	// A file without extends is returned as is.
+	content, err = e.resolve(filepath.Join(td, "pre-commit-go.yml"), []byte(extendsBase))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, extendsBase, string(content))
+
+	// Loops are bounded.
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "loop.yml"), []byte("extends: loop.yml\n"), 0600))
+	_, err = e.resolve(filepath.Join(td, "loop.yml"), []byte("extends: loop.yml\n"))
+	ut.AssertEqual(t, fmt.Errorf("%s: more than 10 levels of extends", filepath.Join(td, "loop.yml")), err)
+}
+
+func TestExtendsRemote(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	requests := 0
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		switch r.URL.Path {
+		case "/policy/base.yml":
+			fmt.Fprint(w, "extends: common.yml\nmodes:\n  pre-commit:\n    max_duration: 7\n")
+		case "/policy/common.yml":
+			fmt.Fprint(w, extendsBase)
+		case "/policy/huge.yml":
+			fmt.Fprint(w, strings.Repeat("#", maxExtendsSize+1))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	e := &extendsLoader{cacheDir: filepath.Join(td, "cache"), client: ts.Client()}
+	config := filepath.Join(td, "pre-commit-go.yml")
+	local := "extends: " + ts.URL + "/policy/base.yml\n"
+	content, err := e.resolve(config, []byte(local))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 2, requests)
+	c := &checks.Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(content, c))
+	ut.AssertEqual(t, 7, c.Modes[checks.PreCommit].Options.MaxDuration)
+	ut.AssertEqual(t, 2, len(c.Modes[checks.PreCommit].Checks))
+
+	// Served from the cache.
+	_, err = e.resolve(config, []byte(local))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 2, requests)
+
+	// Pinning.
+	pinned := local + "extends_sha256: 0000\n"
+	_, err = e.resolve(config, []byte(pinned))
+	sum := sha256Hex([]byte("extends: common.yml\nmodes:\n  pre-commit:\n    max_duration: 7\n"))
+	ut.AssertEqual(t, fmt.Errorf("%s/policy/base.yml: sha256 is %s, expected 0000", ts.URL, sum), err)
+
+	_, err = e.resolve(config, []byte("extends: "+ts.URL+"/policy/huge.yml\n"))
+	ut.AssertEqual(t, fmt.Errorf("%s/policy/huge.yml is larger than %d bytes", ts.URL, maxExtendsSize), err)
+
+	pinned = local + "extends_sha256: " + sum + "\n"
+	ts.Close()
+	content2, err := e.resolve(config, []byte(pinned))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, string(content), string(content2))
+
+	_, err = e.resolve(config, []byte("extends: http://example.com/base.yml\n"))
+	ut.AssertEqual(t, errors.New("extends: only https URLs are supported"), err)
+}
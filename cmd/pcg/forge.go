@@ -0,0 +1,134 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Forge support, e.g. GitHub pull requests.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+// pullRequest is the subset of a GitHub pull request that is used.
+type pullRequest struct {
+	Number int            `json:"number"`
+	Title  string         `json:"title"`
+	Head   pullRequestRef `json:"head"`
+	Base   pullRequestRef `json:"base"`
+}
+
+// pullRequestRef is one end of a pull request.
+type pullRequestRef struct {
+	Ref string `json:"ref"`
+	SHA string `json:"sha"`
+}
+
+// forgeClient talks to the forge API.
+type forgeClient struct {
+	repo   string
+	remote string
+	apiURL string
+	token  string
+	client *http.Client
+}
+
+// newForgeClient returns a forgeClient with the defaults filled in.
+func newForgeClient(f *checks.Forge) (*forgeClient, error) {
+	if f == nil {
+		return nil, errors.New("no forge configured in pre-commit-go.yml")
+	}
+	if f.Type != "" && f.Type != "github" {
+		return nil, fmt.Errorf("unsupported forge type \"%s\"", f.Type)
+	}
+	if f.Repo == "" {
+		return nil, errors.New("forge repo is required, e.g. \"owner/name\"")
+	}
+	c := &forgeClient{
+		repo:   f.Repo,
+		remote: f.Remote,
+		apiURL: strings.TrimRight(f.APIURL, "/"),
+		client: http.DefaultClient,
+	}
+	if c.remote == "" {
+		c.remote = "origin"
+	}
+	if c.apiURL == "" {
+		c.apiURL = "https://api.github.com"
+	}
+	tokenEnv := f.TokenEnv
+	if tokenEnv == "" {
+		tokenEnv = "GITHUB_TOKEN"
+	}
+	c.token = os.Getenv(tokenEnv)
+	return c, nil
+}
+
+// getPullRequest returns the pull request information.
+func (f *forgeClient) getPullRequest(number int) (*pullRequest, error) {
+	pr := &pullRequest{}
+	if err := f.do("GET", fmt.Sprintf("/repos/%s/pulls/%d", f.repo, number), nil, pr); err != nil {
+		return nil, err
+	}
+	if pr.Head.SHA == "" || pr.Base.SHA == "" {
+		return nil, fmt.Errorf("pull request %d is missing its head or base", number)
+	}
+	return pr, nil
+}
+
+// postComment posts a comment on a pull request.
+func (f *forgeClient) postComment(number int, body string) error {
+	if f.token == "" {
+		return errors.New("a token is required to post results on the pull request")
+	}
+	in := map[string]string{"body": body}
+	return f.do("POST", fmt.Sprintf("/repos/%s/issues/%d/comments", f.repo, number), in, nil)
+}
+
+// do sends a JSON encoded request and decodes the JSON reply into out, if not
+// nil.
+func (f *forgeClient) do(method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequest(method, f.apiURL+path, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if f.token != "" {
+		req.Header.Set("Authorization", "token "+f.token)
+	}
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	content, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s %s failed: %s\n%s", method, path, resp.Status, content)
+	}
+	if out != nil {
+		return json.Unmarshal(content, out)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestNewForgeClient(t *testing.T) {
+	t.Parallel()
+	_, err := newForgeClient(nil)
+	ut.AssertEqual(t, errors.New("no forge configured in pre-commit-go.yml"), err)
+	_, err = newForgeClient(&checks.Forge{Type: "gitlab", Repo: "a/b"})
+	ut.AssertEqual(t, errors.New("unsupported forge type \"gitlab\""), err)
+	c, err := newForgeClient(&checks.Forge{Repo: "a/b", TokenEnv: "PCG_TEST_NON_EXISTENT"})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "origin", c.remote)
+	ut.AssertEqual(t, "https://api.github.com", c.apiURL)
+	ut.AssertEqual(t, "", c.token)
+}
+
+func TestForgeClientPullRequest(t *testing.T) {
+	t.Parallel()
+	var posted string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/repos/a/b/pulls/12":
+			fmt.Fprint(w, `{"number":12,"title":"Foo","head":{"ref":"foo","sha":"1234"},"base":{"ref":"master","sha":"5678"}}`)
+		case "/repos/a/b/issues/12/comments":
+			ut.AssertEqual(t, "token secret", r.Header.Get("Authorization"))
+			content, _ := ioutil.ReadAll(r.Body)
+			posted = string(content)
+			w.WriteHeader(201)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+	c := &forgeClient{repo: "a/b", apiURL: ts.URL, token: "secret", client: http.DefaultClient}
+	pr, err := c.getPullRequest(12)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, &pullRequest{12, "Foo", pullRequestRef{"foo", "1234"}, pullRequestRef{"master", "5678"}}, pr)
+	ut.AssertEqual(t, nil, c.postComment(12, "hi"))
+	ut.AssertEqual(t, `{"body":"hi"}`, posted)
+	_, err = c.getPullRequest(13)
+	ut.AssertEqual(t, true, err != nil)
+}
@@ -0,0 +1,113 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Configuration encodings, auto-detected by the file extension.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+// configExts are the extensions of the alternative encodings of the
+// configuration, tried in this order after YAML.
+var configExts = []string{".toml", ".json"}
+
+// configNames returns the file names tried for the configuration name. When
+// name is a YAML file, the same name with the alternative extensions is tried
+// next, e.g. pre-commit-go.toml for pre-commit-go.yml.
+func configNames(name string) []string {
+	out := []string{name}
+	if ext := path.Ext(name); ext == ".yml" || ext == ".yaml" {
+		for _, e := range configExts {
+			out = append(out, strings.TrimSuffix(name, ext)+e)
+		}
+	}
+	return out
+}
+
+// findConfigName returns the first of configNames(name) present in dir, ""
+// if none.
+func findConfigName(dir, name string) string {
+	for _, n := range configNames(name) {
+		if _, err := os.Stat(filepath.Join(dir, n)); err == nil {
+			return n
+		}
+	}
+	return ""
+}
+
+// decodeConfig converts the configuration content of the file at pathname to
+// YAML. TOML and JSON are detected by the extension; YAML is returned as is.
+func decodeConfig(pathname string, content []byte) ([]byte, error) {
+	var raw interface{}
+	switch path.Ext(pathname) {
+	case ".toml":
+		t, err := internal.ParseTOML(content)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", pathname, err)
+		}
+		raw = t
+	case ".json":
+		if err := json.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("%s: %s", pathname, err)
+		}
+	default:
+		return content, nil
+	}
+	return yaml.Marshal(raw)
+}
+
+// encodeConfig converts the YAML configuration content to the encoding of the
+// file at pathname. YAML is returned as is.
+func encodeConfig(pathname string, content []byte) ([]byte, error) {
+	ext := path.Ext(pathname)
+	if ext != ".toml" && ext != ".json" {
+		return content, nil
+	}
+	var raw interface{}
+	if err := yaml.Unmarshal(content, &raw); err != nil {
+		return nil, err
+	}
+	raw = stringKeys(raw)
+	if ext == ".json" {
+		out, err := json.MarshalIndent(raw, "", "  ")
+		return append(out, '\n'), err
+	}
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: expected a mapping", pathname)
+	}
+	return internal.FormatTOML(m)
+}
+
+// Private stuff.
+
+// stringKeys recursively converts the mappings decoded by yaml to
+// map[string]interface{}, as expected by the JSON and TOML encoders.
+func stringKeys(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		out := make(map[string]interface{}, len(t))
+		for k, item := range t {
+			out[fmt.Sprint(k)] = stringKeys(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(t))
+		for i, item := range t {
+			out[i] = stringKeys(item)
+		}
+		return out
+	}
+	return v
+}
@@ -0,0 +1,45 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestConfigNames(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, []string{"pre-commit-go.yml", "pre-commit-go.toml", "pre-commit-go.json"}, configNames("pre-commit-go.yml"))
+	ut.AssertEqual(t, []string{"a.toml"}, configNames("a.toml"))
+}
+
+func TestEncodeDecodeConfig(t *testing.T) {
+	t.Parallel()
+	content, err := yaml.Marshal(checks.New(version))
+	ut.AssertEqual(t, nil, err)
+	for _, name := range []string{"pre-commit-go.yml", "pre-commit-go.toml", "pre-commit-go.json"} {
+		encoded, err := encodeConfig(name, content)
+		ut.AssertEqual(t, nil, err)
+		decoded, err := decodeConfig(name, encoded)
+		ut.AssertEqual(t, nil, err)
+		config := &checks.Config{}
+		ut.AssertEqual(t, nil, yaml.Unmarshal(decoded, config))
+		ut.AssertEqual(t, checks.New(version), config)
+		ut.AssertEqual(t, nil, checks.ValidateConfig(decoded))
+	}
+
+	decoded, err := decodeConfig("pre-commit-go.toml", []byte("max_parallel = 2\n[modes.lint]\nmax_duration = 60\n"))
+	ut.AssertEqual(t, nil, err)
+	config := &checks.Config{}
+	ut.AssertEqual(t, nil, yaml.Unmarshal(decoded, config))
+	ut.AssertEqual(t, 2, config.MaxParallel)
+	ut.AssertEqual(t, 60, config.Modes[checks.Lint].Options.MaxDuration)
+
+	_, err = decodeConfig("pre-commit-go.json", []byte("{"))
+	ut.AssertEqual(t, "pre-commit-go.json: unexpected end of JSON input", err.Error())
+}
@@ -0,0 +1,199 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Persisted history of the check durations, used for scheduling.
+
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// historyFile is the name of the file in the scm directory, e.g. .git/.
+const historyFile = "pre-commit-go-history.json"
+
+// history is the persisted duration of the previous runs of each check.
+type history struct {
+	lock sync.Mutex
+	// Durations is the moving average of the duration of each check in
+	// seconds, keyed by historyKey().
+	Durations map[string]float64 `json:"durations"`
+}
+
+// historyKey returns the key of a check in the history. The description is
+// included since a check type can be used multiple times, e.g. custom.
+func historyKey(check checks.Check) string {
+	return check.GetName() + ": " + check.GetDescription()
+}
+
+// loadHistory loads the history from the scm directory. It never fails; an
+// empty history is returned if none is found.
+func loadHistory(repo scm.ReadOnlyRepo) *history {
+	h := &history{Durations: map[string]float64{}}
+	p, err := historyPath(repo)
+	if err != nil {
+		return h
+	}
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		return h
+	}
+	if err := json.Unmarshal(content, h); err != nil {
+		log.Printf("ignoring corrupted %s: %s", p, err)
+		h.Durations = map[string]float64{}
+	}
+	if h.Durations == nil {
+		h.Durations = map[string]float64{}
+	}
+	return h
+}
+
+// save writes the history in the scm directory.
+func (h *history) save(repo scm.ReadOnlyRepo) error {
+	p, err := historyPath(repo)
+	if err != nil {
+		return err
+	}
+	h.lock.Lock()
+	content, err := json.MarshalIndent(h, "", "  ")
+	h.lock.Unlock()
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, append(content, '\n'), 0666)
+}
+
+// record adds the duration of a check run to the history.
+func (h *history) record(check checks.Check, d time.Duration) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	key := historyKey(check)
+	if prev, ok := h.Durations[key]; ok {
+		// Exponential moving average, so the history adapts quickly when a check
+		// becomes faster or slower.
+		h.Durations[key] = (prev + d.Seconds()) / 2
+	} else {
+		h.Durations[key] = d.Seconds()
+	}
+}
+
+// estimate returns the expected duration of a check. Checks never run before
+// are estimated to be as slow as the slowest known check, so they are started
+// early.
+func (h *history) estimate(check checks.Check) time.Duration {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if d, ok := h.Durations[historyKey(check)]; ok {
+		return time.Duration(d * float64(time.Second))
+	}
+	max := 0.
+	for _, d := range h.Durations {
+		if d > max {
+			max = d
+		}
+	}
+	if max == 0 {
+		return time.Second
+	}
+	return time.Duration(max * float64(time.Second))
+}
+
+// schedule sorts the checks by decreasing estimated duration, the longest
+// processing time first heuristic, and returns the estimated wall clock time
+// to run them all with the number of workers specified.
+func (h *history) schedule(enabledChecks []checks.Check, workers int) time.Duration {
+	s := &byEstimate{enabledChecks, make([]time.Duration, len(enabledChecks))}
+	for i, c := range enabledChecks {
+		s.estimates[i] = h.estimate(c)
+	}
+	sort.Stable(s)
+	if workers < 1 {
+		workers = 1
+	}
+	// Simulate the run; each check is started on the least loaded worker.
+	load := make([]time.Duration, workers)
+	for _, d := range s.estimates {
+		min := 0
+		for i := range load {
+			if load[i] < load[min] {
+				min = i
+			}
+		}
+		load[min] += d
+	}
+	var eta time.Duration
+	for _, l := range load {
+		if l > eta {
+			eta = l
+		}
+	}
+	return eta
+}
+
+// heavyShare is the share of the longest recorded duration from which a check
+// is a heavyweight one.
+const heavyShare = 0.5
+
+// heavy returns the checks of enabledChecks recorded as taking at least
+// heavyShare of the duration of the longest one. Two of them are not run
+// concurrently as long as other checks can use the free slots, since they
+// compete for the same CPUs. The checks never run before are not heavy.
+func (h *history) heavy(enabledChecks []checks.Check) map[checks.Check]bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	max := 0.
+	for _, c := range enabledChecks {
+		if d := h.Durations[historyKey(c)]; d > max {
+			max = d
+		}
+	}
+	out := map[checks.Check]bool{}
+	for _, c := range enabledChecks {
+		if d, ok := h.Durations[historyKey(c)]; ok && max > 0 && d >= heavyShare*max {
+			out[c] = true
+		}
+	}
+	return out
+}
+
+// Private stuff.
+
+func historyPath(repo scm.ReadOnlyRepo) (string, error) {
+	return scmFilePath(repo, historyFile)
+}
+
+// scmFilePath returns the path of the file name in the scm directory, e.g.
+// .git/.
+func scmFilePath(repo scm.ReadOnlyRepo, name string) (string, error) {
+	scmDir, err := repo.ScmDir()
+	if err != nil {
+		return "", err
+	}
+	if _, err := os.Stat(scmDir); err != nil {
+		return "", err
+	}
+	return filepath.Join(scmDir, name), nil
+}
+
+type byEstimate struct {
+	checks    []checks.Check
+	estimates []time.Duration
+}
+
+func (b *byEstimate) Len() int           { return len(b.checks) }
+func (b *byEstimate) Less(i, j int) bool { return b.estimates[i] > b.estimates[j] }
+func (b *byEstimate) Swap(i, j int) {
+	b.checks[i], b.checks[j] = b.checks[j], b.checks[i]
+	b.estimates[i], b.estimates[j] = b.estimates[j], b.estimates[i]
+}
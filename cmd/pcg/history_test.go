@@ -0,0 +1,64 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestHistorySchedule(t *testing.T) {
+	t.Parallel()
+	build := &checks.Build{}
+	gofmt := &checks.Gofmt{}
+	test := &checks.Test{}
+	copyright := &checks.Copyright{}
+	h := &history{Durations: map[string]float64{}}
+	h.record(build, 2*time.Second)
+	h.record(gofmt, time.Second)
+	h.record(test, 8*time.Second)
+	h.record(test, 4*time.Second)
+	ut.AssertEqual(t, 6*time.Second, h.estimate(test))
+	// Never run; as slow as the slowest.
+	ut.AssertEqual(t, 6*time.Second, h.estimate(copyright))
+
+	enabled := []checks.Check{gofmt, build, copyright, test}
+	ut.AssertEqual(t, 15*time.Second, h.schedule(enabled, 1))
+	ut.AssertEqual(t, []checks.Check{copyright, test, build, gofmt}, enabled)
+	// copyright | test | build+gofmt
+	ut.AssertEqual(t, 6*time.Second, h.schedule(enabled, 3))
+	// At least one worker is used.
+	ut.AssertEqual(t, 15*time.Second, h.schedule(enabled, 0))
+}
+
+func TestHistoryEmpty(t *testing.T) {
+	t.Parallel()
+	h := &history{Durations: map[string]float64{}}
+	ut.AssertEqual(t, time.Second, h.estimate(&checks.Build{}))
+	ut.AssertEqual(t, time.Duration(0), h.schedule(nil, 4))
+	ut.AssertEqual(t, map[checks.Check]bool{}, h.heavy([]checks.Check{&checks.Build{}}))
+}
+
+func TestHistorySaveLoad(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+
+	h := loadHistory(repo)
+	ut.AssertEqual(t, map[string]float64{}, h.Durations)
+	h.record(&checks.Build{}, 2*time.Second)
+	ut.AssertEqual(t, nil, h.save(repo))
+	ut.AssertEqual(t, map[string]float64{historyKey(&checks.Build{}): 2}, loadHistory(repo).Durations)
+}
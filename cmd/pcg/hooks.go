@@ -0,0 +1,211 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// managedHooks are the git hooks pcg can install.
+var managedHooks = []string{"pre-commit", "pre-push", "commit-msg", postCheckout, postMerge}
+
+// defaultHooks are the git hooks installed when the configuration doesn't
+// list them.
+var defaultHooks = managedHooks
+
+// hookLocalSuffix is appended to the name of a hook not generated by pcg, e.g.
+// one installed by husky or lefthook, when it is replaced on install. pcg's
+// hook runs it first and it is restored on uninstall.
+const hookLocalSuffix = ".local"
+
+// legacyBackupSuffix was used by older versions instead of hookLocalSuffix;
+// these hooks were not run.
+const legacyBackupSuffix = ".pre-commit-go.bak"
+
+// hookMarker identifies the hooks generated by pcg.
+const hookMarker = "# AUTOGENERATED BY pcg."
+
+// isPcgHook returns true if the hook content was generated by pcg.
+func isPcgHook(content []byte) bool {
+	return bytes.Contains(content, []byte(hookMarker))
+}
+
+// enabledHooks returns the hooks to install as listed in the configuration.
+func enabledHooks(config *checks.Config) ([]string, error) {
+	if len(config.Hooks) == 0 {
+		return defaultHooks, nil
+	}
+	for _, h := range config.Hooks {
+		if !contains(managedHooks, h) {
+			return nil, fmt.Errorf("unsupported hook \"%s\"; supported are %s", h, strings.Join(managedHooks, ", "))
+		}
+	}
+	return config.Hooks, nil
+}
+
+// installHooks writes hooks in hookDir. Existing hooks not generated by pcg
+// are moved aside with hookLocalSuffix first so they are chained; it fails if
+// one was already moved aside. The other hooks generated by pcg are removed, so
+// removing a hook from the configuration uninstalls it.
+func installHooks(hookDir string, hooks []string) error {
+	// Check first so nothing is modified on conflict.
+	for _, t := range hooks {
+		p := filepath.Join(hookDir, t)
+		if content, err := ioutil.ReadFile(p); err == nil && !isPcgHook(content) {
+			if _, err := os.Lstat(p + hookLocalSuffix); err == nil {
+				// Both are hooks not generated by pcg; neither can be chained.
+				return fmt.Errorf("%s and %s both exist; remove or merge one of them", p, p+hookLocalSuffix)
+			}
+		}
+	}
+	var others []string
+	for _, t := range managedHooks {
+		if !contains(hooks, t) {
+			others = append(others, t)
+		}
+	}
+	if err := removeHooks(hookDir, others, false); err != nil {
+		return err
+	}
+	for _, t := range hooks {
+		p := filepath.Join(hookDir, t)
+		local := p + hookLocalSuffix
+		if content, err := ioutil.ReadFile(p); err == nil && !isPcgHook(content) {
+			log.Printf("Moving %s to %s", p, local)
+			if err := os.Rename(p, local); err != nil {
+				return err
+			}
+		} else if _, err := os.Lstat(local); os.IsNotExist(err) {
+			if _, err := os.Lstat(p + legacyBackupSuffix); err == nil {
+				log.Printf("Moving %s to %s", p+legacyBackupSuffix, local)
+				if err := os.Rename(p+legacyBackupSuffix, local); err != nil {
+					return err
+				}
+			}
+		}
+		// Always remove hook first if it exists, in case it's a symlink.
+		_ = os.Remove(p)
+		if err := ioutil.WriteFile(p, []byte(fmt.Sprintf(hookContent, t)), 0777); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// refreshHooks installs the hooks enabled in config again if the ones in the
+// repository don't match, e.g. the configuration lists other hooks or they
+// were generated by another version of pcg.
+func refreshHooks(repo scm.ReadOnlyRepo, config *checks.Config) error {
+	hookDir, err := repo.HookPath()
+	if err != nil {
+		return err
+	}
+	hooks, err := enabledHooks(config)
+	if err != nil {
+		return err
+	}
+	if !hooksOutdated(hookDir, hooks) {
+		return nil
+	}
+	log.Printf("Installing hooks")
+	return installHooks(hookDir, hooks)
+}
+
+// hooksOutdated returns true if installHooks(hookDir, hooks) would modify a
+// hook.
+func hooksOutdated(hookDir string, hooks []string) bool {
+	for _, t := range managedHooks {
+		content, err := ioutil.ReadFile(filepath.Join(hookDir, t))
+		if contains(hooks, t) {
+			if err != nil || string(content) != fmt.Sprintf(hookContent, t) {
+				return true
+			}
+		} else if err == nil && isPcgHook(content) {
+			return true
+		}
+	}
+	return false
+}
+
+// runLocalHook runs the hook that pcg's hook replaced, if any, with the same
+// arguments and the streams and environment of s. If stdin is nil, the stdin
+// of s is used.
+func runLocalHook(s *session, hookDir, hook string, args []string, stdin []byte) error {
+	p := filepath.Join(hookDir, hook+hookLocalSuffix)
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil
+	}
+	if fi.Mode()&0111 == 0 {
+		fmt.Fprintf(s.stdout, "warning: %s is not executable, skipping it\n", p)
+		return nil
+	}
+	log.Printf("Running %s", p)
+	cmd := exec.Command(p, args...)
+	cmd.Stdin = s.stdin
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	cmd.Stdout = s.stdout
+	cmd.Stderr = s.stderr
+	cmd.Env = s.env
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %s", p, err)
+	}
+	return nil
+}
+
+// uninstallHooks removes the managed hooks generated by pcg from hookDir and
+// restores the hooks backed up by installHooks.
+func uninstallHooks(hookDir string) error {
+	return removeHooks(hookDir, managedHooks, true)
+}
+
+// Private stuff.
+
+// removeHooks removes the hooks generated by pcg and restores their backup.
+// If warn is true, it warns about the hooks not generated by pcg.
+func removeHooks(hookDir string, hooks []string, warn bool) error {
+	for _, t := range hooks {
+		p := filepath.Join(hookDir, t)
+		content, err := ioutil.ReadFile(p)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if !isPcgHook(content) {
+			if warn {
+				fmt.Printf("warning: %s was not installed by pcg, leaving it\n", p)
+			}
+			continue
+		}
+		log.Printf("Removing %s", p)
+		if err := os.Remove(p); err != nil {
+			return err
+		}
+		for _, backup := range []string{p + hookLocalSuffix, p + legacyBackupSuffix} {
+			if _, err := os.Lstat(backup); err == nil {
+				log.Printf("Restoring %s", backup)
+				if err := os.Rename(backup, p); err != nil {
+					return err
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestInstallUninstallHooks(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	read := func(name string) string {
+		content, err := ioutil.ReadFile(filepath.Join(td, name))
+		if err != nil {
+			return ""
+		}
+		return string(content)
+	}
+	custom := "#!/bin/sh\necho custom\n"
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pre-commit"), []byte(custom), 0777))
+
+	ut.AssertEqual(t, nil, installHooks(td, managedHooks))
+	for _, h := range managedHooks {
+		ut.AssertEqual(t, fmt.Sprintf(hookContent, h), read(h))
+	}
+	ut.AssertEqual(t, custom, read("pre-commit"+hookLocalSuffix))
+
+	// Installing again doesn't overwrite the backup with pcg's own hook. The
+	// hooks not listed anymore are removed.
+	ut.AssertEqual(t, nil, installHooks(td, []string{"pre-commit", "pre-push", "commit-msg"}))
+	ut.AssertEqual(t, custom, read("pre-commit"+hookLocalSuffix))
+	ut.AssertEqual(t, "", read("post-checkout"))
+
+	ut.AssertEqual(t, nil, uninstallHooks(td))
+	ut.AssertEqual(t, custom, read("pre-commit"))
+	for _, h := range []string{"pre-commit" + hookLocalSuffix, "pre-push", "commit-msg"} {
+		_, err := os.Stat(filepath.Join(td, h))
+		ut.AssertEqual(t, true, os.IsNotExist(err))
+	}
+
+	// A hook not generated by pcg is left alone.
+	ut.AssertEqual(t, nil, uninstallHooks(td))
+	ut.AssertEqual(t, custom, read("pre-commit"))
+
+	// A hook already chained is never overwritten.
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pre-commit"+hookLocalSuffix), []byte("#!/bin/sh\necho other\n"), 0777))
+	err := installHooks(td, managedHooks)
+	ut.AssertEqual(t, true, err != nil)
+	ut.AssertEqual(t, custom, read("pre-commit"))
+	ut.AssertEqual(t, "#!/bin/sh\necho other\n", read("pre-commit"+hookLocalSuffix))
+	ut.AssertEqual(t, "", read("pre-push"))
+}
+
+func TestEnabledHooks(t *testing.T) {
+	t.Parallel()
+	hooks, err := enabledHooks(&checks.Config{})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, defaultHooks, hooks)
+	hooks, err = enabledHooks(&checks.Config{Hooks: []string{"pre-commit", "post-checkout"}})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"pre-commit", "post-checkout"}, hooks)
+	_, err = enabledHooks(&checks.Config{Hooks: []string{"pre-rebase"}})
+	ut.AssertEqual(t, errors.New("unsupported hook \"pre-rebase\"; supported are pre-commit, pre-push, commit-msg, post-checkout, post-merge"), err)
+}
+
+func TestHooksOutdated(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	hooks := []string{"pre-commit", "pre-push"}
+	ut.AssertEqual(t, true, hooksOutdated(td, hooks))
+	ut.AssertEqual(t, nil, installHooks(td, hooks))
+	ut.AssertEqual(t, false, hooksOutdated(td, hooks))
+	ut.AssertEqual(t, true, hooksOutdated(td, []string{"pre-commit"}))
+	ut.AssertEqual(t, true, hooksOutdated(td, append(hooks, "commit-msg")))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pre-push"), []byte(hookMarker+"\nexec pcg\n"), 0777))
+	ut.AssertEqual(t, true, hooksOutdated(td, hooks))
+}
+
+func TestRunPostCheckout(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init", "-q")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	hookDir, err := repo.HookPath()
+	ut.AssertEqual(t, nil, err)
+	config := &checks.Config{Hooks: []string{"pre-commit", postCheckout}}
+
+	ut.AssertEqual(t, errors.New("post-checkout hook requires 3 arguments"), runPostCheckout(processSession(), repo, config, postCheckout, nil, true))
+	// File checkout.
+	ut.AssertEqual(t, nil, runPostCheckout(processSession(), repo, config, postCheckout, []string{"a", "b", "0"}, true))
+	ut.AssertEqual(t, true, hooksOutdated(hookDir, config.Hooks))
+
+	ut.AssertEqual(t, nil, runPostCheckout(processSession(), repo, config, postCheckout, []string{"a", "b", "1"}, true))
+	ut.AssertEqual(t, false, hooksOutdated(hookDir, config.Hooks))
+	stamp, err := configStamp(config)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, stamp, loadPrereqCache(repo).Config)
+
+	// Nothing is done as long as the configuration is the same.
+	ut.AssertEqual(t, nil, os.Remove(filepath.Join(hookDir, "pre-commit")))
+	ut.AssertEqual(t, nil, runPostCheckout(processSession(), repo, config, postMerge, []string{"0"}, true))
+	ut.AssertEqual(t, true, hooksOutdated(hookDir, config.Hooks))
+
+	config.Hooks = append(config.Hooks, postMerge)
+	ut.AssertEqual(t, nil, runPostCheckout(processSession(), repo, config, postMerge, []string{"0"}, true))
+	ut.AssertEqual(t, false, hooksOutdated(hookDir, config.Hooks))
+}
+
+func TestInstallHooksLegacyBackup(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	custom := []byte("#!/bin/sh\necho custom\n")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pre-commit"+legacyBackupSuffix), custom, 0777))
+	ut.AssertEqual(t, nil, installHooks(td, defaultHooks))
+	content, err := ioutil.ReadFile(filepath.Join(td, "pre-commit"+hookLocalSuffix))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, custom, content)
+	_, err = os.Stat(filepath.Join(td, "pre-commit"+legacyBackupSuffix))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+}
+
+func TestRunLocalHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hooks are shell scripts")
+	}
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	ut.AssertEqual(t, nil, runLocalHook(processSession(), td, "pre-push", nil, nil))
+
+	out := filepath.Join(td, "out")
+	script := "#!/bin/sh\necho \"$@\" > " + out + "\ncat >> " + out + "\n"
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pre-push"+hookLocalSuffix), []byte(script), 0777))
+	ut.AssertEqual(t, nil, runLocalHook(processSession(), td, "pre-push", []string{"origin", "url"}, []byte("refs\n")))
+	content, err := ioutil.ReadFile(out)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "origin url\nrefs\n", string(content))
+
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pre-commit"+hookLocalSuffix), []byte("#!/bin/sh\nexit 1\n"), 0777))
+	err = runLocalHook(processSession(), td, "pre-commit", nil, []byte{})
+	ut.AssertEqual(t, true, err != nil)
+}
@@ -0,0 +1,111 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Standalone HTML report, e.g. to keep as a CI artifact.
+
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+)
+
+// html writes the report as a standalone HTML page: a timing chart of the
+// checks, then one section per check with its issues and their diffs, its
+// coverage and its raw output.
+func (r *report) html(w io.Writer) error {
+	longest := 0.
+	for _, c := range r.Checks {
+		if c.Duration > longest {
+			longest = c.Duration
+		}
+	}
+	funcs := template.FuncMap{
+		// width returns the width of the timing bar of a check, in percent.
+		"width": func(d float64) string {
+			if longest == 0 {
+				return "0"
+			}
+			return fmt.Sprintf("%.1f", 100*d/longest)
+		},
+		"seconds": func(d float64) string { return fmt.Sprintf("%1.2fs", d) },
+		"percent": func(p float64) string { return fmt.Sprintf("%.1f%%", p) },
+	}
+	t, err := template.New("report").Funcs(funcs).Parse(htmlReport)
+	if err != nil {
+		return err
+	}
+	return t.Execute(w, struct {
+		*report
+		Version string
+	}{r, version})
+}
+
+// Private stuff.
+
+const htmlReport = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>pre-commit-go: {{if .Success}}success{{else}}failure{{end}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+td, th { padding: 0.2em 0.6em; text-align: left; }
+pre { background: #f4f4f4; padding: 1em; overflow-x: auto; }
+.success { color: #080; }
+.failure { color: #c00; }
+.warning { color: #a60; }
+.chart td.bar { width: 30em; }
+.chart div { height: 1em; background: #58c; }
+</style>
+</head>
+<body>
+<h1>pre-commit-go
+{{- range .Modes}} {{.}}{{end}}:
+{{if .Success}}<span class="success">success</span>{{else}}<span class="failure">failure</span>{{end}}
+in {{seconds .Duration}}</h1>
+<h2>Timings</h2>
+<table class="chart">
+{{- range .Checks}}
+<tr><td><a href="#{{.Name}}">{{.Name}}</a></td><td class="{{.Status}}">{{.Status}}</td><td>{{if .Cached}}cached{{else}}{{seconds .Duration}}{{end}}</td><td class="bar"><div style="width: {{width .Duration}}%"></div></td></tr>
+{{- end}}
+</table>
+{{- range .Checks}}
+<h2 id="{{.Name}}">{{.Name}}: <span class="{{.Status}}">{{.Status}}</span>{{if .Cached}} (cached){{else}} in {{seconds .Duration}}{{end}}</h2>
+{{- range .Commands}}
+<p><code>{{range $i, $a := .}}{{if $i}} {{end}}{{$a}}{{end}}</code></p>
+{{- end}}
+{{- if .Issues}}
+<table>
+<tr><th>Location</th><th>Severity</th><th>Message</th></tr>
+{{- range .Issues}}
+<tr><td>{{.File}}{{if .Line}}:{{.Line}}{{end}}{{if .Column}}:{{.Column}}{{end}}</td><td>{{.Severity}}</td><td>{{.Message}}</td></tr>
+{{- end}}
+</table>
+{{- range .Issues}}{{if .Diff}}
+<details><summary>Diff of {{.File}}</summary>
+<pre>{{.Diff}}</pre>
+</details>
+{{- end}}{{end}}
+{{- end}}
+{{- if .Coverage}}
+<table>
+<tr><th>Package</th><th>Coverage</th><th>Statements</th></tr>
+{{- range .Coverage}}
+<tr><td>{{.Package}}</td><td>{{percent .Percent}}</td><td>{{.Covered}}/{{.Total}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- if .Output}}
+<details><summary>Output</summary>
+<pre>{{.Output}}</pre>
+</details>
+{{- end}}
+{{- end}}
+<p>Generated by pre-commit-go {{.Version}}.</p>
+</body>
+</html>
+`
@@ -0,0 +1,44 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestReportHTML(t *testing.T) {
+	t.Parallel()
+	r := &report{
+		Modes:    []checks.Mode{checks.PrePush},
+		Duration: 4,
+		Checks: []checkReport{
+			{Name: "golint", Status: statusFailure, Duration: 1, Output: "golint failed:\na.go:1:2: <bad>", Issues: []checks.Issue{{File: "a.go", Line: 1, Column: 2, Message: "<bad>", Severity: checks.SeverityError}}},
+			{Name: "gofmt", Status: statusFailure, Issues: []checks.Issue{{File: "b.go", Message: "improperly formatted", Diff: "-func  f()\n+func f()\n"}}},
+			{Name: "coverage", Status: statusSuccess, Duration: 4, Commands: [][]string{{"go", "test", "-cover"}}, Coverage: []packageCoverage{{Package: "foo", Covered: 3, Total: 4, Percent: 75}}},
+		},
+	}
+	out := &bytes.Buffer{}
+	ut.AssertEqual(t, nil, r.write(out, "html"))
+	s := out.String()
+	ut.AssertEqual(t, true, strings.HasPrefix(s, "<!DOCTYPE html>"))
+	for _, expected := range []string{
+		"<title>pre-commit-go: failure</title>",
+		"<span class=\"failure\">failure</span>\nin 4.00s</h1>",
+		"<td class=\"bar\"><div style=\"width: 25.0%\"></div></td>",
+		"<td class=\"bar\"><div style=\"width: 100.0%\"></div></td>",
+		"<tr><td>a.go:1:2</td><td>error</td><td>&lt;bad&gt;</td></tr>",
+		"<p><code>go test -cover</code></p>",
+		"<tr><td>foo</td><td>75.0%</td><td>3/4</td></tr>",
+		"<details><summary>Diff of b.go</summary>\n<pre>-func  f()\n&#43;func f()\n</pre>",
+		"<pre>golint failed:\na.go:1:2: &lt;bad&gt;</pre>",
+	} {
+		ut.AssertEqual(t, true, strings.Contains(s, expected))
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// repoTraits is what 'pcg init' detected in a repository.
+type repoTraits struct {
+	// modules is true when the repository uses Go modules, false for GOPATH.
+	modules bool
+	protos  bool
+	docker  bool
+	shell   bool
+	// ci is the name of the CI provider detected, if any.
+	ci string
+	// ciFiles are the CI configuration files that may declare the Go version.
+	ciFiles []string
+}
+
+// ciProviders maps the CI configuration files or directories to the name of
+// the provider.
+var ciProviders = []struct {
+	prefix string
+	name   string
+}{
+	{".github/workflows/", "GitHub Actions"},
+	{".travis.yml", "Travis CI"},
+	{".circleci/", "CircleCI"},
+	{".gitlab-ci.yml", "GitLab CI"},
+	{".drone.yml", "Drone"},
+}
+
+// inspectRepo returns the traits of a repository given all its files.
+// Detection of source files skips the files matched by ignore; CI files are
+// always considered since they usually start with ".".
+func inspectRepo(files []string, ignore scm.IgnorePatterns) repoTraits {
+	t := repoTraits{}
+	for _, f := range files {
+		f = strings.Replace(f, "\\", "/", -1)
+		for _, p := range ciProviders {
+			if f == p.prefix || (strings.HasSuffix(p.prefix, "/") && strings.HasPrefix(f, p.prefix)) {
+				if t.ci == "" {
+					t.ci = p.name
+				}
+				if strings.HasSuffix(f, ".yml") || strings.HasSuffix(f, ".yaml") {
+					t.ciFiles = append(t.ciFiles, f)
+				}
+			}
+		}
+		if ignore.Match(f) {
+			continue
+		}
+		base := path.Base(f)
+		switch {
+		case base == "go.mod":
+			t.modules = true
+		case strings.HasSuffix(base, ".proto"):
+			t.protos = true
+		case base == "Dockerfile" || strings.HasPrefix(base, "Dockerfile.") || strings.HasSuffix(strings.ToLower(base), ".dockerfile"):
+			t.docker = true
+		case strings.HasSuffix(base, ".sh"):
+			t.shell = true
+		}
+	}
+	return t
+}
+
+// asker asks yes/no questions to the user.
+type asker struct {
+	in  *bufio.Reader
+	out io.Writer
+	// defaults answers all the questions with their default value without
+	// asking.
+	defaults bool
+}
+
+// ask asks question and returns the answer, def if none is given.
+func (a *asker) ask(question string, def bool) bool {
+	choices := "[y/N]"
+	if def {
+		choices = "[Y/n]"
+	}
+	if a.defaults {
+		fmt.Fprintf(a.out, "%s %s\n", question, choices)
+		return def
+	}
+	for {
+		fmt.Fprintf(a.out, "%s %s ", question, choices)
+		line, err := a.in.ReadString('\n')
+		switch strings.ToLower(strings.TrimSpace(line)) {
+		case "":
+			if err != nil {
+				fmt.Fprintf(a.out, "\n")
+			}
+			return def
+		case "y", "yes":
+			return true
+		case "n", "no":
+			return false
+		}
+	}
+}
+
+// initConfig returns a configuration tailored to the repository traits t,
+// asking the user which checks and modes to enable.
+func initConfig(t repoTraits, a *asker) *checks.Config {
+	config := checks.New(version)
+	add := func(mode checks.Mode, check checks.Check) {
+		config.Modes[mode].Checks[check.GetName()] = append(config.Modes[mode].Checks[check.GetName()], check)
+	}
+
+	if t.modules {
+		fmt.Fprintf(a.out, "Go modules detected.\n")
+		if a.ask("Verify go.mod and go.sum on push?", true) {
+			add(checks.PrePush, &checks.GoSum{})
+			add(checks.PrePush, &checks.ModReplace{})
+			add(checks.ContinuousIntegration, &checks.GoSum{})
+			add(checks.ContinuousIntegration, &checks.ModReplace{})
+			add(checks.ContinuousIntegration, &checks.GoDirective{Consistent: true, CIFiles: t.ciFiles})
+		}
+	} else {
+		fmt.Fprintf(a.out, "GOPATH project detected.\n")
+	}
+	if t.protos {
+		fmt.Fprintf(a.out, "Protocol buffers detected.\n")
+		if a.ask("Verify the generated .pb.go files are up to date on continuous integration?", true) {
+			add(checks.ContinuousIntegration, &checks.Generated{
+				Generators: []checks.Generator{
+					{
+						Name:    "protoc",
+						Command: []string{"go", "generate", "./..."},
+						Outputs: []string{"*.pb.go"},
+						Inputs:  []string{"*.proto"},
+					},
+				},
+			})
+		}
+	}
+	if t.docker {
+		fmt.Fprintf(a.out, "Dockerfiles detected.\n")
+		if a.ask("Lint them with hadolint on push?", true) {
+			add(checks.PrePush, &checks.Hadolint{})
+			add(checks.ContinuousIntegration, &checks.Hadolint{})
+		}
+	}
+	if t.shell {
+		fmt.Fprintf(a.out, "Shell scripts detected.\n")
+		if a.ask("Lint them with shellcheck on push?", true) {
+			add(checks.PrePush, &checks.Shellcheck{})
+			add(checks.ContinuousIntegration, &checks.Shellcheck{})
+		}
+	}
+
+	if !a.ask("Enforce a minimum test coverage on push?", true) {
+		delete(config.Modes[checks.PrePush].Checks, "coverage")
+	}
+	if t.ci != "" {
+		fmt.Fprintf(a.out, "%s detected.\n", t.ci)
+	} else {
+		fmt.Fprintf(a.out, "No CI detected, see CI_SETUP.md to set it up.\n")
+	}
+	if !a.ask("Upload the coverage to coveralls.io on continuous integration?", t.ci != "") {
+		for _, c := range config.Modes[checks.ContinuousIntegration].Checks["coverage"] {
+			c.(*checks.Coverage).UseCoveralls = false
+		}
+	}
+	if !a.ask("Enable the lint mode (errcheck, golint, govet), run with 'pcg run -m lint'?", true) {
+		delete(config.Modes, checks.Lint)
+	}
+	if a.ask("Validate the commit messages in the commit-msg hook?", false) {
+		config.Modes[checks.CommitMsg] = checks.Settings{
+			Options: checks.Options{MaxDuration: 5},
+			Checks:  checks.Checks{"commitmsg": {&checks.CommitMessage{MaxSubjectLength: 72}}},
+		}
+	}
+	return config
+}
@@ -0,0 +1,77 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestInspectRepo(t *testing.T) {
+	t.Parallel()
+	files := []string{
+		".github/workflows/test.yml",
+		"go.mod",
+		"api/api.proto",
+		"build/Dockerfile.prod",
+		"_tools/build.sh",
+		"main.go",
+	}
+	expected := repoTraits{
+		modules: true,
+		protos:  true,
+		docker:  true,
+		ci:      "GitHub Actions",
+		ciFiles: []string{".github/workflows/test.yml"},
+	}
+	ut.AssertEqual(t, expected, inspectRepo(files, checks.New(version).IgnorePatterns))
+	ut.AssertEqual(t, repoTraits{shell: true}, inspectRepo([]string{"a.sh", "foo.go"}, nil))
+}
+
+func TestInitConfigDefaults(t *testing.T) {
+	t.Parallel()
+	out := &bytes.Buffer{}
+	traits := repoTraits{modules: true, shell: true, ci: "Travis CI", ciFiles: []string{".travis.yml"}}
+	config := initConfig(traits, &asker{out: out, defaults: true})
+	pp := config.Modes[checks.PrePush].Checks
+	ut.AssertEqual(t, 1, len(pp["gosum"]))
+	ut.AssertEqual(t, 1, len(pp["shellcheck"]))
+	ut.AssertEqual(t, 1, len(pp["coverage"]))
+	ci := config.Modes[checks.ContinuousIntegration].Checks
+	ut.AssertEqual(t, &checks.GoDirective{Consistent: true, CIFiles: []string{".travis.yml"}}, ci["godirective"][0])
+	ut.AssertEqual(t, true, ci["coverage"][0].(*checks.Coverage).UseCoveralls)
+	_, ok := config.Modes[checks.Lint]
+	ut.AssertEqual(t, true, ok)
+	_, ok = config.Modes[checks.CommitMsg]
+	ut.AssertEqual(t, false, ok)
+	ut.AssertEqual(t, true, strings.Contains(out.String(), "Travis CI detected.\n"))
+}
+
+func TestInitConfigAnswers(t *testing.T) {
+	t.Parallel()
+	out := &bytes.Buffer{}
+	// coverage: no, coveralls: invalid then default, lint: n, commit-msg: yes.
+	a := &asker{in: bufio.NewReader(strings.NewReader("no\nmaybe\n\nn\ny\n")), out: out}
+	config := initConfig(repoTraits{}, a)
+	_, ok := config.Modes[checks.PrePush].Checks["coverage"]
+	ut.AssertEqual(t, false, ok)
+	ut.AssertEqual(t, false, config.Modes[checks.ContinuousIntegration].Checks["coverage"][0].(*checks.Coverage).UseCoveralls)
+	_, ok = config.Modes[checks.Lint]
+	ut.AssertEqual(t, false, ok)
+	ut.AssertEqual(t, &checks.CommitMessage{MaxSubjectLength: 72}, config.Modes[checks.CommitMsg].Checks["commitmsg"][0])
+	expected := "GOPATH project detected.\n" +
+		"Enforce a minimum test coverage on push? [Y/n] " +
+		"No CI detected, see CI_SETUP.md to set it up.\n" +
+		"Upload the coverage to coveralls.io on continuous integration? [y/N] " +
+		"Upload the coverage to coveralls.io on continuous integration? [y/N] " +
+		"Enable the lint mode (errcheck, golint, govet), run with 'pcg run -m lint'? [Y/n] " +
+		"Validate the commit messages in the commit-msg hook? [y/N] "
+	ut.AssertEqual(t, expected, out.String())
+}
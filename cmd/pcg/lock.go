@@ -0,0 +1,108 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Pinning of the approved configuration, set by 'pcg install -lock-config'.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// configHashKey is the git configuration key holding the hash of the
+// configuration approved with 'pcg install -lock-config'.
+const configHashKey = "pre-commit-go.confighash"
+
+// configHash returns the hex encoded SHA-256 of the configuration file p
+// merged over the base configurations it extends, followed by the
+// configurations named name nested in the subdirectories of root, each merged
+// over its own bases. A missing file hashes like an empty one. A configuration
+// split in a directory is hashed once merged.
+//
+// Like loadConfig, the nested configurations are ignored when name is an
+// absolute path.
+func configHash(root, name, p string) (string, error) {
+	content, err := resolvedConfig(p)
+	if err != nil {
+		return "", err
+	}
+	h := sha256.New()
+	h.Write(content)
+	if !filepath.IsAbs(name) {
+		config := &checks.Config{}
+		if err := yaml.Unmarshal(content, config); err != nil {
+			return "", fmt.Errorf("%s: %s", p, err)
+		}
+		for _, dir := range findNestedConfigs(root, name, config.IgnorePatterns) {
+			d := filepath.Join(root, filepath.FromSlash(dir))
+			nested, err := resolvedConfig(filepath.Join(d, findConfigName(d, name)))
+			if err != nil {
+				return "", err
+			}
+			fmt.Fprintf(h, "\x00%s\x00%d\x00", dir, len(nested))
+			h.Write(nested)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// lockConfig records the hash of the configuration file p, looked up as
+// name, as the approved one.
+func lockConfig(repo scm.Repo, name, p string) error {
+	h, err := configHash(repo.Root(), name, p)
+	if err != nil {
+		return err
+	}
+	return repo.SetConfig(configHashKey, h)
+}
+
+// verifyConfig returns an error if a configuration was approved and the
+// configuration file p, looked up as name, its bases or the nested
+// configurations don't match it anymore, so the hooks never run with a
+// silently weakened configuration.
+func verifyConfig(repo scm.ReadOnlyRepo, name, p string) error {
+	pinned := repo.Config(configHashKey)
+	if pinned == "" {
+		return nil
+	}
+	h, err := configHash(repo.Root(), name, p)
+	if err != nil {
+		return err
+	}
+	if h != pinned {
+		return errors.New("the configuration changed since it was approved; review the change then run 'pcg install -lock-config' to approve it")
+	}
+	return nil
+}
+
+// Private stuff.
+
+// resolvedConfig returns the configuration file p converted to YAML and merged
+// over its base configurations, nil if it doesn't exist.
+func resolvedConfig(p string) ([]byte, error) {
+	var content []byte
+	var err error
+	if fi, err2 := os.Stat(p); err2 == nil && fi.IsDir() {
+		content, err = loadConfigDir(p)
+	} else if content, err = ioutil.ReadFile(p); err == nil {
+		content, err = decodeConfig(p, content)
+	}
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return newExtendsLoader().resolve(p, content)
+}
@@ -0,0 +1,69 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestLockConfig(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init", "-q")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	name := "pre-commit-go.yml"
+	p := filepath.Join(td, name)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("version: 2\n"), 0666))
+
+	// Nothing is enforced until the configuration is locked.
+	ut.AssertEqual(t, nil, verifyConfig(repo, name, p))
+	ut.AssertEqual(t, nil, lockConfig(repo, name, p))
+	ut.AssertEqual(t, nil, verifyConfig(repo, name, p))
+
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("version: 2\nmodes: {}\n"), 0666))
+	expected := errors.New("the configuration changed since it was approved; review the change then run 'pcg install -lock-config' to approve it")
+	ut.AssertEqual(t, expected, verifyConfig(repo, name, p))
+	ut.AssertEqual(t, expected, verifyConfig(repo, name, filepath.Join(td, "missing.yml")))
+
+	// Approving again.
+	ut.AssertEqual(t, nil, lockConfig(repo, name, p))
+	ut.AssertEqual(t, nil, verifyConfig(repo, name, p))
+
+	// The base configurations are part of the approved configuration.
+	base := filepath.Join(td, "base.yml")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(base, []byte("version: 2\n"), 0666))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("extends: base.yml\n"), 0666))
+	ut.AssertEqual(t, nil, lockConfig(repo, name, p))
+	ut.AssertEqual(t, nil, verifyConfig(repo, name, p))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(base, []byte("version: 2\nmodes: {}\n"), 0666))
+	ut.AssertEqual(t, expected, verifyConfig(repo, name, p))
+
+	// So are the nested configurations.
+	ut.AssertEqual(t, nil, lockConfig(repo, name, p))
+	ut.AssertEqual(t, nil, os.Mkdir(filepath.Join(td, "sub"), 0777))
+	nested := filepath.Join(td, "sub", name)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(nested, []byte("version: 2\n"), 0666))
+	ut.AssertEqual(t, expected, verifyConfig(repo, name, p))
+	ut.AssertEqual(t, nil, lockConfig(repo, name, p))
+	ut.AssertEqual(t, nil, verifyConfig(repo, name, p))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(nested, []byte("version: 2\nmodes: {}\n"), 0666))
+	ut.AssertEqual(t, expected, verifyConfig(repo, name, p))
+	// Unless the configuration is given as an absolute path.
+	ut.AssertEqual(t, nil, lockConfig(repo, p, p))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(nested, []byte("version: 2\n"), 0666))
+	ut.AssertEqual(t, nil, verifyConfig(repo, p, p))
+}
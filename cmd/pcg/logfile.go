@@ -0,0 +1,40 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Log file to debug the hooks after the fact.
+
+package main
+
+import (
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+// openLogFile appends the log to the file at p, in addition to stderr when
+// verbose is true. The commands run are logged to it with their environment
+// and complete output, see internal.CommandLog. The values of the variables
+// that likely hold a secret are redacted since CI uploads the log.
+//
+// The file is left open until the process exits.
+func openLogFile(p string, verbose bool) error {
+	f, err := os.OpenFile(p, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if verbose {
+		log.SetOutput(io.MultiWriter(os.Stderr, f))
+	} else {
+		log.SetOutput(f)
+	}
+	internal.CommandLog = log.New(f, "", log.Flags())
+	env := internal.RedactEnv(os.Environ())
+	sort.Strings(env)
+	internal.CommandLog.Printf("pcg %s: %s\nenvironment:\n  %s", version, strings.Join(os.Args, " "), strings.Join(env, "\n  "))
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestOpenLogFile(t *testing.T) {
+	// Not parallel since the log output is global.
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	old := log.Writer()
+	defer func() {
+		log.SetOutput(old)
+		internal.CommandLog = nil
+	}()
+	ut.AssertEqual(t, nil, os.Setenv("PCG_TEST_TOKEN", "hunter2"))
+	defer os.Unsetenv("PCG_TEST_TOKEN")
+	p := filepath.Join(td, "pcg.log")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte("previous run\n"), 0600))
+	ut.AssertEqual(t, nil, openLogFile(p, false))
+	log.Printf("hello")
+	_, _, err := internal.Capture(td, nil, "go", "version")
+	ut.AssertEqual(t, nil, err)
+	content, err := ioutil.ReadFile(p)
+	ut.AssertEqual(t, nil, err)
+	lines := strings.Split(string(content), "\n")
+	ut.AssertEqual(t, "previous run", lines[0])
+	ut.AssertEqual(t, true, strings.Contains(lines[1], "pcg "+version+": "+strings.Join(os.Args, " ")))
+	ut.AssertEqual(t, "environment:", lines[2])
+	ut.AssertEqual(t, true, strings.Contains(string(content), "\n  PCG_TEST_TOKEN=<redacted>\n"))
+	ut.AssertEqual(t, false, strings.Contains(string(content), "hunter2"))
+	ut.AssertEqual(t, true, strings.Contains(string(content), " hello\n"))
+	ut.AssertEqual(t, true, strings.Contains(string(content), td+"$ go version\n"))
+}
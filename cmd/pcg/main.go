@@ -10,6 +10,8 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -17,10 +19,12 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"os/exec"
 	"os/user"
 	"path/filepath"
 	"regexp"
 	"runtime"
+	"runtime/debug"
 	"sort"
 	"strconv"
 	"strings"
@@ -42,6 +46,9 @@ import (
 // version.
 const version = "0.4.7"
 
+// hookContent is the git hook installed by pcg. All the logic, e.g. the
+// initial commit, the empty change and checking only the index, is in 'pcg
+// run-hook' so the shell only replaces itself with pcg.
 const hookContent = `#!/bin/sh
 # AUTOGENERATED BY pcg.
 #
@@ -50,13 +57,19 @@ const hookContent = `#!/bin/sh
 #
 # or visit https://github.com/maruel/pre-commit-go
 
-set -e
-pcg run-hook %s
+exec pcg run-hook %s "$@"
 `
 
+// postCheckout and postMerge are the names of the hooks refreshing the
+// prerequisites. They are not modes since they run no check.
+const (
+	postCheckout = "post-checkout"
+	postMerge    = "post-merge"
+)
+
 const gitNilCommit = "0000000000000000000000000000000000000000"
 
-const helpModes = "Supported modes (with shortcut names):\n- pre-commit / fast / pc\n- pre-push / slow / pp  (default)\n- continous-integration / full / ci\n- lint\n- all: includes both continuous-integration and lint"
+const helpModes = "Supported modes (with shortcut names):\n- pre-commit / fast / pc\n- pre-push / slow / pp  (default)\n- continous-integration / full / ci\n- lint\n- commit-msg\n- all: includes both continuous-integration and lint\n- any custom mode declared in pre-commit-go.yml"
 
 // http://git-scm.com/docs/githooks#_pre_push
 var rePrePush = regexp.MustCompile("^(.+?) ([0-9a-f]{40}) (.+?) ([0-9a-f]{40})$")
@@ -64,22 +77,70 @@ var rePrePush = regexp.MustCompile("^(.+?) ([0-9a-f]{40}) (.+?) ([0-9a-f]{40})$"
 var helpText = template.Must(template.New("help").Parse(`pcg: runs pre-commit checks on Go projects, fast.
 
 Supported commands are:
+  baseline    - runs the lint checks on all the files and records their
+                findings in pre-commit-go-baseline.json; the findings recorded
+                are not reported anymore, only new ones are
+  bench       - runs the benchmarks of the modified packages and records the
+                results in pre-commit-go-bench.json; the 'go test' flags are
+                recorded too and reused on the next run
+  clean       - removes the timings history and stats kept in .git/ and the
+                temporary directories left behind by interrupted runs; use
+                -dry-run to only print what would be removed
+  daemon      - keeps a process serving the hooks of the repository over a
+                unix socket in .git/, with the repository, the configuration
+                and the results of the checks in memory, until Ctrl-C; the
+                hooks forward to it when it is running
+  explain     - prints what a check does, the commands it would run on the
+                modified files, its prerequisites with their version and how
+                to fix its failures, e.g. 'pcg explain govet'
+  fix         - fixes in the working tree the issues reported by the checks
+                that can, e.g. gofmt, then reports the remaining ones; use
+                -stage to add the fixed files to the index
   help        - this page
   prereq      - installs prerequisites, e.g.: errcheck, golint, goimports,
-                govet, etc as applicable for the enabled checks
+                govet, etc as applicable for the enabled checks; 'prereq list'
+                prints where each is installed, its version and the checks
+                requiring it; 'prereq outdated' reports the ones not matching
+                the versions pinned in pre-commit-go.yml
   info        - prints the current configuration used
-  install     - runs 'prereq' then installs the git commit hook as
-                .git/hooks/pre-commit
+  init        - inspects the repository and asks which checks to enable, then
+                writes a tailored pre-commit-go.yml
+  install     - runs 'prereq' then installs the git hooks listed in hooks in
+                pre-commit-go.yml in .git/hooks/, by default all of them;
+                -lock-config makes the hooks refuse
+                to run once pre-commit-go.yml is modified until approved again
   installrun  - runs 'prereq', 'install' then 'run'
-  run         - runs all enabled checks
-  run-hook    - used by hooks (pre-commit, pre-push) exclusively
-  version     - print the tool version number
-  writeconfig - writes (or rewrite) a pre-commit-go.yml
+  migrate-config
+              - upgrades in place the configuration file written for an older
+                version to the current format and bumps min_version
+  run         - runs all enabled checks; use -pr to run them on a pull request
+                or -rev to run them on a revision range like HEAD~1..HEAD,
+                and -i to triage the failures interactively; -profile
+                records the duration of each check and package for 'stats';
+                -files restricts them to the files passed as arguments or on
+                stdin with '-files -'; -dry-run prints what would run instead;
+                -format json prints the results as JSON, -format sarif as
+                SARIF for code scanning and -format html as a standalone
+                page; -output writes them to a file
+  run-hook    - used by hooks (pre-commit, pre-push, commit-msg,
+                post-checkout, post-merge) exclusively
+  schema      - prints the JSON Schema of pre-commit-go.yml, including the
+                options of every check, for editors and CI to validate it
+  selftest    - verifies in a temporary clone that the installed pre-commit
+                hook permits a good commit and blocks bad ones
+  stats       - prints the slowest checks and packages over the last runs
+                profiled with 'run -profile'
+  uninstall   - removes the git hooks installed by 'install' and restores the
+                hooks they replaced, if any
+  version     - prints the tool version, build information and the oldest
+                configuration version supported
+  writeconfig - writes (or rewrite) a pre-commit-go.yml; use -c with a .toml or
+                .json file name to write it in TOML or JSON instead
 
 When executed without command, it does the equivalent of 'installrun'.
 
-Supported flags are:
-{{.Usage}}
+Each command has its own flags, use 'pcg help <command>' to list them.
+
 Supported checks:
   Native checks that only depends on the stdlib:{{range .NativeChecks}}
     - {{printf "%-*s" $.Max .GetName}} : {{.GetDescription}}{{end}}
@@ -87,7 +148,7 @@ Supported checks:
   Checks that have prerequisites (which will be automatically installed):{{range .OtherChecks}}
     - {{printf "%-*s" $.Max .GetName}} : {{.GetDescription}}{{end}}
 
-No check ever modify any file.
+No check ever modify any file, unless explicitly requested with 'fix'.
 `))
 
 const yamlHeader = `# https://github.com/maruel/pre-commit-go configuration file to run checks
@@ -99,6 +160,19 @@ const yamlHeader = `# https://github.com/maruel/pre-commit-go configuration file
 
 `
 
+// minConfigVersion is the oldest min_version of a configuration file this
+// version still understands. Bump when the configuration file format changes
+// in a backward incompatible way.
+const minConfigVersion = "0.4.0"
+
+// commit and buildDate are set at build time, e.g.:
+//
+//	go build -ldflags "-X main.commit=$(git rev-parse HEAD) -X main.buildDate=$(date -u +%Y-%m-%d)"
+var (
+	commit    string
+	buildDate string
+)
+
 var parsedVersion []int
 
 // Utils.
@@ -124,149 +198,650 @@ func parseVersion(v string) ([]int, error) {
 	return out, nil
 }
 
-// loadConfigFile returns a Config with defaults set then loads the config from
-// file "pathname".
-func loadConfigFile(pathname string) *checks.Config {
-	content, err := ioutil.ReadFile(pathname)
-	if err != nil {
+// compareVersions returns -1, 0 or 1 if a is respectively older, equal or
+// newer than b. Missing components are 0, e.g. 3.0 == 3.0.0.
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		x, y := 0, 0
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x < y {
+			return -1
+		}
+		if x > y {
+			return 1
+		}
+	}
+	return 0
+}
+
+// orUnknown returns s or "unknown" if s is empty.
+func orUnknown(s string) string {
+	if s == "" {
+		return "unknown"
+	}
+	return s
+}
+
+// checkMinVersion returns an error if the configuration requires a newer
+// version of pcg.
+func checkMinVersion(pathname string, config *checks.Config) error {
+	if config.MinVersion == "" {
 		return nil
 	}
+	configVersion, err := parseVersion(config.MinVersion)
+	if err != nil {
+		return fmt.Errorf("%s: invalid min_version \"%s\"", pathname, config.MinVersion)
+	}
+	if compareVersions(parsedVersion, configVersion) < 0 {
+		return fmt.Errorf("%s requires pcg %s or later but this is pcg %s; upgrade with:\n  go get -u github.com/maruel/pre-commit-go/cmd/pcg", pathname, config.MinVersion, version)
+	}
+	if v, _ := parseVersion(minConfigVersion); compareVersions(configVersion, v) < 0 {
+		fmt.Printf("warning: %s has min_version %s, older than %s; refresh it with 'pcg writeconfig'\n", pathname, config.MinVersion, minConfigVersion)
+	}
+	return nil
+}
+
+// loadConfigFile returns a Config with defaults set then loads the config from
+// file "pathname". It returns nil if the file can't be read or parsed.
+//
+// Unless asWritten is true, the base configuration is merged in and the
+// environment variables are expanded.
+func loadConfigFile(pathname string, asWritten bool) (*checks.Config, error) {
+	var content []byte
+	var err error
+	if fi, err2 := os.Stat(pathname); err2 == nil && fi.IsDir() {
+		// Unlike a broken file, a broken piece is an error since the others
+		// would silently apply without it.
+		if content, err = loadConfigDir(pathname); err != nil {
+			return nil, err
+		}
+	} else {
+		if content, err = ioutil.ReadFile(pathname); err != nil {
+			return nil, nil
+		}
+		if content, err = decodeConfig(pathname, content); err != nil {
+			log.Printf("failed to parse %s", err)
+			return nil, nil
+		}
+	}
 	config := &checks.Config{}
 	if err := yaml.Unmarshal(content, config); err != nil {
 		// Log but ignore the error, recreate a new config instance.
 		log.Printf("failed to parse %s: %s", pathname, err)
-		return nil
-	}
-	configVersion, err := parseVersion(config.MinVersion)
-	if err != nil {
-		log.Printf("invalid version %s", config.MinVersion)
+		return nil, nil
 	}
-	for i, v := range configVersion {
-		if len(parsedVersion) <= i {
-			if v == 0 {
-				// 3.0 == 3.0.0
-				continue
-			}
-			log.Printf("requires newer version %s", config.MinVersion)
-			return nil
+	if asWritten {
+		if err := checkUnknownKeys(pathname, content, config); err != nil {
+			return nil, err
 		}
-		if parsedVersion[i] > v {
-			break
+		return config, checkMinVersion(pathname, config)
+	}
+	if config.Extends != "" {
+		// Unlike a broken file, a base that can't be loaded is an error; the
+		// shared policy must not be silently bypassed.
+		if content, err = newExtendsLoader().resolve(pathname, content); err != nil {
+			return nil, err
 		}
-		if parsedVersion[i] < v {
-			log.Printf("requires newer version %s", config.MinVersion)
-			return nil
+		config = &checks.Config{}
+		if err := yaml.Unmarshal(content, config); err != nil {
+			return nil, fmt.Errorf("%s: %s", pathname, err)
 		}
 	}
-	return config
+	if err := checkUnknownKeys(pathname, content, config); err != nil {
+		return nil, err
+	}
+	if err := checkMinVersion(pathname, config); err != nil {
+		return nil, err
+	}
+	config.ExpandEnv(os.LookupEnv)
+	return config, nil
 }
 
-// loadConfig loads the on disk configuration or use the default configuration
-// if none is found. See CONFIGURATION.md for the logic.
-func loadConfig(repo scm.ReadOnlyRepo, path string) (string, *checks.Config) {
+// checkUnknownKeys returns an error listing the unknown keys in content, the
+// configuration file at pathname as parsed in config, unless it sets
+// allow_unknown_keys. A typo like "extra_arg" would otherwise silently disable
+// what was intended.
+func checkUnknownKeys(pathname string, content []byte, config *checks.Config) error {
+	if config.AllowUnknownKeys {
+		return nil
+	}
+	if err := checks.ValidateConfig(content); err != nil {
+		return fmt.Errorf("%s: fix the keys below or set allow_unknown_keys: true\n  %s", pathname, strings.Replace(err.Error(), "\n", "\n  ", -1))
+	}
+	return nil
+}
+
+// configCandidates returns the paths where the configuration file is looked
+// for, in decreasing order of preference. In each directory, the alternative
+// encodings are tried after YAML, then the configuration split in a
+// directory. See CONFIGURATION.md for the logic.
+func configCandidates(repo scm.ReadOnlyRepo, path string) []string {
 	if filepath.IsAbs(path) {
-		if config := loadConfigFile(path); config != nil {
-			return path, config
+		return []string{path}
+	}
+	var dirs []string
+	// <repo root>/.git/<path>
+	if scmDir, err := repo.ScmDir(); err == nil {
+		dirs = append(dirs, scmDir)
+	}
+	// <repo root>/<path>
+	dirs = append(dirs, repo.Root())
+	if user, err := user.Current(); err == nil && user.HomeDir != "" {
+		if runtime.GOOS == "windows" {
+			// ~/<path>
+			dirs = append(dirs, user.HomeDir)
+		} else {
+			// ~/.config/<path>
+			dirs = append(dirs, filepath.Join(user.HomeDir, ".config"))
 		}
-	} else {
-		// <repo root>/.git/<path>
-		if scmDir, err := repo.ScmDir(); err == nil {
-			file := filepath.Join(scmDir, path)
-			if config := loadConfigFile(file); config != nil {
-				return file, config
-			}
+	}
+	var out []string
+	for _, d := range dirs {
+		for _, name := range configNames(path) {
+			out = append(out, filepath.Join(d, name))
 		}
-
-		// <repo root>/<path>
-		file := filepath.Join(repo.Root(), path)
-		if config := loadConfigFile(file); config != nil {
-			return file, config
+		if name := configDirName(path); name != "" {
+			out = append(out, filepath.Join(d, name))
 		}
+	}
+	return out
+}
 
-		if user, err := user.Current(); err == nil && user.HomeDir != "" {
-			if runtime.GOOS == "windows" {
-				// ~/<path>
-				file = filepath.Join(user.HomeDir, path)
-			} else {
-				// ~/.config/<path>
-				file = filepath.Join(user.HomeDir, ".config", path)
-			}
-			if config := loadConfigFile(file); config != nil {
-				return file, config
-			}
+// loadConfig loads the on disk configuration or use the default configuration
+// if none is found.
+func loadConfig(repo scm.ReadOnlyRepo, path string, asWritten bool) (string, *checks.Config, error) {
+	for _, file := range configCandidates(repo, path) {
+		if config, err := loadConfigFile(file, asWritten); config != nil || err != nil {
+			return file, config, err
 		}
 	}
-	return "<N/A>", checks.New(version)
+	return "<N/A>", checks.New(version), nil
+}
+
+// timeoutGrace is how long a check that timed out has to return once the
+// processes it started are killed. A check stuck in process is abandoned.
+const timeoutGrace = 5 * time.Second
+
+// checkScope returns the part of change in the paths of the check, nil if no
+// modified file is.
+func checkScope(check checks.Check, change scm.Change) scm.Change {
+	if l, ok := check.(checks.Limiter); ok {
+		return l.Scope(change)
+	}
+	return change
 }
 
-func callRun(check checks.Check, change scm.Change, options *checks.Options) (time.Duration, error) {
+// callRun runs the check on the files in its paths. If the check has a
+// timeout and exceeds it, or ctx is canceled, the processes it started are
+// killed and a timeout or cancellation error is returned.
+func callRun(ctx context.Context, check checks.Check, change scm.Change, options *checks.Options) (time.Duration, checks.Result) {
+	options = options.ForCheck(check)
+	var timeout time.Duration
+	if l, ok := check.(checks.Limiter); ok {
+		if change = l.Scope(change); change == nil {
+			log.Printf("%s: no modified file in its paths", check.GetName())
+			return 0, checks.Result{}
+		}
+		timeout = l.GetTimeout()
+	}
 	if l, ok := check.(sync.Locker); ok {
 		l.Lock()
 		defer l.Unlock()
 	}
 	start := time.Now()
-	err := check.Run(change, options)
-	return time.Now().Sub(start), err
+	if timeout <= 0 && ctx.Done() == nil {
+		r := check.Run(change, options)
+		return time.Now().Sub(start), withSeverity(check, r)
+	}
+	if err := ctx.Err(); err != nil {
+		return 0, checks.Result{Err: fmt.Errorf("%s canceled", check.GetName())}
+	}
+	var cancel context.CancelFunc
+	if timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	} else {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
+	done := make(chan checks.Result, 1)
+	go func() {
+		done <- check.Run(change, options.WithContext(ctx))
+	}()
+	select {
+	case r := <-done:
+		if ctx.Err() == nil {
+			return time.Now().Sub(start), withSeverity(check, r)
+		}
+	case <-ctx.Done():
+		select {
+		case <-done:
+		case <-time.After(timeoutGrace):
+		}
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		return time.Now().Sub(start), checks.Result{Err: fmt.Errorf("%s timed out after %s", check.GetName(), timeout)}
+	}
+	return time.Now().Sub(start), checks.Result{Err: fmt.Errorf("%s canceled", check.GetName())}
+}
+
+// withSeverity marks the issues of r as warnings for a non-blocking check.
+func withSeverity(check checks.Check, r checks.Result) checks.Result {
+	if isWarning(check) {
+		for i := range r.Issues {
+			r.Issues[i].Severity = checks.SeverityWarning
+		}
+	}
+	return r
 }
 
-func runChecks(config *checks.Config, change scm.Change, modes []checks.Mode, prereqReady *sync.WaitGroup) error {
+// runChecks runs the checks enabled in modes, except the ones listed in skip.
+func runChecks(s *session, config *checks.Config, change scm.Change, modes []checks.Mode, skip []string, prereqReady *sync.WaitGroup) error {
 	enabledChecks, options := config.EnabledChecks(modes)
+	return runSelectedChecks(s, config, change, modes, filterSkipped(s, enabledChecks, skip), options, prereqReady)
+}
+
+// runSelectedChecks runs enabledChecks, the checks of modes already filtered
+// by filterSkipped.
+func runSelectedChecks(s *session, config *checks.Config, change scm.Change, modes []checks.Mode, enabledChecks []checks.Check, options *checks.Options, prereqReady *sync.WaitGroup) error {
 	log.Printf("mode: %s; %d checks; %d max seconds allowed", modes, len(enabledChecks), options.MaxDuration)
 	if change == nil {
 		log.Printf("no change")
 		return nil
 	}
+	start := time.Now()
+	results := runAllChecks(s, enabledChecks, options, change, prereqReady)
+	duration := time.Now().Sub(start)
+	err := printResults(s, results, options, duration)
+	notifyResults(s, config, change.Repo(), modes, results, duration)
+	return err
+}
+
+// result is the outcome of running a check.
+type result struct {
+	check    checks.Check
+	duration time.Duration
+	err      error
+	// issues are the locations parsed from err by the check.
+	issues []checks.Issue
+	// coverage is the coverage measured by the check, if any.
+	coverage checks.CoverageProfile
+	// cached is true when the check was skipped because it already passed on
+	// the same content, see resultCache.
+	cached bool
+}
+
+// blocking returns true if the check failed and its failure fails the run,
+// i.e. the check doesn't have severity "warning" and it didn't only report
+// issues with severity "warning".
+func (r *result) blocking() bool {
+	if r.err == nil || isWarning(r.check) {
+		return false
+	}
+	for _, i := range r.issues {
+		if i.Severity != checks.SeverityWarning {
+			return true
+		}
+	}
+	return len(r.issues) == 0
+}
+
+// runEnabledChecks runs the checks concurrently and prints the errors.
+func runEnabledChecks(s *session, enabledChecks []checks.Check, options *checks.Options, change scm.Change, prereqReady *sync.WaitGroup) error {
+	start := time.Now()
+	results := runAllChecks(s, enabledChecks, options, change, prereqReady)
+	return printResults(s, results, options, time.Now().Sub(start))
+}
+
+// parallelism returns the number of checks that can run concurrently.
+func parallelism(options *checks.Options) int {
+	if options.MaxParallel > 0 {
+		return options.MaxParallel
+	}
+	return runtime.NumCPU()
+}
+
+// checkWeight returns the number of parallelism slots used by check, at most
+// workers.
+func checkWeight(check checks.Check, workers int) int {
+	w := 1
+	if l, ok := check.(checks.Limiter); ok {
+		w = l.GetWeight()
+	}
+	if w > workers {
+		return workers
+	}
+	return w
+}
+
+// runAllChecks runs the checks concurrently and returns their results in
+// completion order. A check is only started once the checks it depends on
+// completed and enough parallelism slots are free for its weight. A
+// heavyweight check isn't started while another one runs, unless no other
+// check can use the free slots. With
+// fail_fast, the first blocking check to fail cancels the running checks and
+// the ones not started yet are skipped.
+func runAllChecks(s *session, enabledChecks []checks.Check, options *checks.Options, change scm.Change, prereqReady *sync.WaitGroup) []result {
+	if options.Baseline == nil {
+		// The lint findings grandfathered by 'pcg baseline' are not reported.
+		p := filepath.Join(change.Repo().Root(), checks.BaselineFile)
+		b, err := checks.LoadBaseline(p)
+		if err != nil {
+			fmt.Fprintf(s.stdout, "warning: ignoring %s: %s\n", p, err)
+		}
+		options.Baseline = b
+	}
+	// Start the longest checks first, based on the previous runs.
+	hist := loadHistory(change.Repo())
+	if shard != nil && options.Shard == nil {
+		enabledChecks = shardChecks(enabledChecks, shard)
+		options.Shard = shard
+		log.Printf("shard %s: %d checks", shard, len(enabledChecks))
+	}
+	// The commands run with the environment of the session, e.g. the one of the
+	// hook forwarded to 'pcg daemon'.
+	if env := s.envOverrides(); len(env) != 0 {
+		options.Env = append(env, options.Env...)
+	}
+	cache := loadResultCache(change.Repo())
+	// The coverage check reuses the coverage of the packages whose inputs didn't
+	// change only when the result cache is enabled.
+	options.NoCache = !useCache
+	workers := parallelism(options)
+	eta := hist.schedule(enabledChecks, workers)
+	heavy := hist.heavy(enabledChecks)
+	log.Printf("%d workers; estimated %1.2fs", workers, eta.Seconds())
+	if s.progress && options.PackageTimings == nil {
+		// Used to count the packages processed by each running check.
+		options.PackageTimings = checks.NewPackageTimings()
+	}
+	live := newLiveProgress(s.stderr, options.PackageTimings, eta)
+	ctx, cancel := context.Background(), context.CancelFunc(func() {})
+	if options.FailFast {
+		ctx, cancel = context.WithCancel(ctx)
+	}
+	defer cancel()
 	var wg sync.WaitGroup
-	errs := make(chan error, len(enabledChecks))
-	warnings := make(chan error, len(enabledChecks))
+	results := make(chan result, len(enabledChecks))
+	queue := make(chan checks.Check)
 	start := time.Now()
-	for _, c := range enabledChecks {
+	for i := 0; i < workers; i++ {
 		wg.Add(1)
-		go func(check checks.Check) {
+		go func() {
 			defer wg.Done()
-			if len(check.GetPrerequisites()) != 0 {
-				// If this check has prerequisites, wait for all prerequisites to be
-				// checked for presence.
-				prereqReady.Wait()
+			for check := range queue {
+				if len(check.GetPrerequisites()) != 0 {
+					// If this check has prerequisites, wait for all prerequisites to be
+					// checked for presence.
+					prereqReady.Wait()
+				}
+				key, cached := cache.lookup(check, change, options.ForCheck(check))
+				if cached != nil {
+					log.Printf("... %s cached", check.GetName())
+					results <- result{check: check, coverage: cached.Coverage, cached: true}
+					continue
+				}
+				log.Printf("%s...", check.GetName())
+				live.start(check)
+				duration, r := callRun(ctx, check, change, options)
+				live.finish(check)
+				if ctx.Err() == nil {
+					hist.record(check, duration)
+				}
+				cache.record(key, r)
+				err := r.Err
+				results <- result{check: check, duration: duration, err: err, issues: r.Issues, coverage: r.Coverage}
+				if err != nil {
+					log.Printf("... %s in %1.2fs FAILED\n%s", check.GetName(), duration.Seconds(), err)
+					continue
+				}
+				remaining := eta - time.Now().Sub(start)
+				if remaining < 0 {
+					remaining = 0
+				}
+				log.Printf("... %s in %1.2fs; ETA %1.2fs", check.GetName(), duration.Seconds(), remaining.Seconds())
 			}
-			log.Printf("%s...", check.GetName())
-			duration, err := callRun(check, change, options)
-			if err != nil {
-				log.Printf("... %s in %1.2fs FAILED\n%s", check.GetName(), duration.Seconds(), err)
-				errs <- err
-				return
+		}()
+	}
+	out := make([]result, 0, len(enabledChecks))
+	deps := newDepGraph(enabledChecks)
+	pending := append([]checks.Check{}, enabledChecks...)
+	running := 0
+	used := 0
+	heavyRunning := 0
+	// stopped is the name of the check that failed the run with fail_fast.
+	stopped := ""
+	for len(pending) != 0 || running != 0 {
+		if stopped != "" {
+			for _, check := range pending {
+				out = append(out, result{check: check, err: fmt.Errorf("%s skipped: %s failed", check.GetName(), stopped)})
+			}
+			pending = nil
+		}
+		// Start the checks ready to run that fit in the free slots, in scheduling
+		// order, and skip the ones depending on a failed check.
+		launch := func(i int) {
+			check := pending[i]
+			queue <- check
+			running++
+			used += checkWeight(check, workers)
+			if heavy[check] {
+				heavyRunning++
+			}
+			pending = append(pending[:i], pending[i+1:]...)
+		}
+		deferred := -1
+		for i := 0; i < len(pending) && used < workers; {
+			check := pending[i]
+			ready, failedDep := deps.state(check)
+			switch {
+			case failedDep != "":
+				r := result{check: check, err: fmt.Errorf("%s skipped: %s failed", check.GetName(), failedDep)}
+				deps.done(&r)
+				out = append(out, r)
+				// It may unblock checks already passed over.
+				pending = append(pending[:i], pending[i+1:]...)
+				i = 0
+				deferred = -1
+			case ready && used+checkWeight(check, workers) <= workers:
+				if heavy[check] && heavyRunning != 0 {
+					if deferred == -1 {
+						deferred = i
+					}
+					i++
+					continue
+				}
+				launch(i)
+			default:
+				i++
 			}
-			log.Printf("... %s in %1.2fs", check.GetName(), duration.Seconds())
-			// A check that took too long is a check that failed.
-			max := time.Duration(options.MaxDuration) * time.Second
-			if duration > max {
-				warnings <- fmt.Errorf("check %s took %1.2fs -> IT IS TOO SLOW (limit: %s)", check.GetName(), duration.Seconds(), max)
+		}
+		if deferred != -1 && used+checkWeight(pending[deferred], workers) <= workers {
+			// Nothing lighter can use the free slots.
+			launch(deferred)
+		}
+		if running == 0 {
+			// The checks left depend on each other.
+			for _, check := range pending {
+				out = append(out, result{check: check, err: fmt.Errorf("%s skipped: circular depends_on", check.GetName())})
 			}
-		}(c)
+			break
+		}
+		r := <-results
+		running--
+		used -= checkWeight(r.check, workers)
+		if heavy[r.check] {
+			heavyRunning--
+		}
+		deps.done(&r)
+		switch {
+		case stopped != "" && r.err != nil:
+			r.err = fmt.Errorf("%s canceled: %s failed", r.check.GetName(), stopped)
+		case options.FailFast && r.blocking():
+			log.Printf("%s failed; canceling the other checks", r.check.GetName())
+			stopped = r.check.GetName()
+			cancel()
+		}
+		out = append(out, r)
 	}
+	close(queue)
 	wg.Wait()
+	live.stop()
+	if err := hist.save(change.Repo()); err != nil {
+		log.Printf("failed to save history: %s", err)
+	}
+	if err := cache.save(change.Repo()); err != nil {
+		log.Printf("failed to save cache: %s", err)
+	}
+	notifyDesktop(filepath.Base(change.Repo().Root()), out, time.Now().Sub(start))
+	return out
+}
 
-	var err error
-	for {
-		select {
-		case err = <-errs:
-			fmt.Printf("%s\n", err)
-		case warning := <-warnings:
-			fmt.Printf("warning: %s\n", warning)
-		default:
-			if err != nil {
-				duration := time.Now().Sub(start)
-				return fmt.Errorf("checks failed in %1.2fs", duration.Seconds())
+// printResults prints the errors and the checks that were too slow. Returns an
+// error if any check failed, except the ones with severity "warning".
+func printResults(s *session, results []result, options *checks.Options, duration time.Duration) error {
+	return writeResults(s.stdout, s.colors, outputLevel, results, options, duration)
+}
+
+// writeResults is printResults writing to w with the palette p at the output
+// level. When a check failed, an aligned summary of all the checks follows
+// the errors.
+func writeResults(w io.Writer, p palette, level int, results []result, options *checks.Options, duration time.Duration) error {
+	failed := false
+	warnings := 0
+	// A check that took too long is a check that failed.
+	max := time.Duration(options.MaxDuration) * time.Second
+	switch level {
+	case outputSummary:
+		writeSummary(w, p, results, max)
+		if hasFailure(results) {
+			return checksFailed(fmt.Errorf("checks failed in %1.2fs", duration.Seconds()))
+		}
+		return nil
+	case outputQuiet:
+		if !hasFailure(results) {
+			return nil
+		}
+	}
+	for _, r := range results {
+		if r.err != nil {
+			if !r.blocking() {
+				fmt.Fprintf(w, "%s\n%s\n", p.yellow(fmt.Sprintf("warning: %s (non-blocking):", r.check.GetName())), condenseOutput(r.err.Error(), maxOutputLines))
+				warnings++
+				continue
 			}
-			return err
+			// The first line names the check that failed.
+			lines := strings.SplitN(condenseOutput(r.err.Error(), maxOutputLines), "\n", 2)
+			lines[0] = p.red(lines[0])
+			fmt.Fprintf(w, "%s\n", strings.Join(lines, "\n"))
+			failed = true
+		} else if r.duration > max {
+			fmt.Fprintf(w, "%s\n", p.yellow(fmt.Sprintf("warning: check %s took %1.2fs -> IT IS TOO SLOW (limit: %s)", r.check.GetName(), r.duration.Seconds(), max)))
+		}
+	}
+	if failed || warnings != 0 {
+		fmt.Fprintf(w, "\n")
+		writeSummary(w, p, results, max)
+	}
+	if warnings != 0 {
+		fmt.Fprintf(w, "%d non-blocking check(s) failed\n", warnings)
+	}
+	if failed {
+		return checksFailed(fmt.Errorf("checks failed in %1.2fs", duration.Seconds()))
+	}
+	return nil
+}
+
+// hasFailure returns true if a blocking check failed.
+func hasFailure(results []result) bool {
+	for _, r := range results {
+		if r.blocking() {
+			return true
+		}
+	}
+	return false
+}
+
+// writeSummary writes one aligned line per check with its status and
+// duration, sorted by name.
+func writeSummary(w io.Writer, p palette, results []result, max time.Duration) {
+	sorted := make(resultsByName, len(results))
+	copy(sorted, results)
+	sort.Stable(sorted)
+	width := 0
+	for _, r := range sorted {
+		if l := len(r.check.GetName()); l > width {
+			width = l
 		}
 	}
+	for _, r := range sorted {
+		status := p.green("PASS")
+		switch {
+		case r.err != nil && !r.blocking():
+			status = p.yellow("WARN")
+		case r.err != nil:
+			status = p.red("FAIL")
+		case r.duration > max:
+			status = p.yellow("SLOW")
+		}
+		if r.cached {
+			fmt.Fprintf(w, "  %s  %-*s  cached\n", status, width, r.check.GetName())
+			continue
+		}
+		fmt.Fprintf(w, "  %s  %-*s %7.2fs\n", status, width, r.check.GetName(), r.duration.Seconds())
+	}
+}
+
+// isWarning returns true if the failure of the check doesn't fail the run.
+func isWarning(check checks.Check) bool {
+	l, ok := check.(checks.Limiter)
+	return ok && l.GetSeverity() == checks.SeverityWarning
+}
+
+func runPreCommit(s *session, repo scm.Repo, config *checks.Config) error {
+	// The commit message isn't known yet, only the environment variable is
+	// respected. The checks are filtered once so the skipped ones are announced
+	// once.
+	enabledChecks, options := config.EnabledChecks([]checks.Mode{checks.PreCommit})
+	enabledChecks = filterSkipped(s, enabledChecks, skippedChecks(s, ""))
+	// Skip everything, including the checkout, when no staged file matters to the
+	// enabled checks, e.g. for a documentation only commit. The ignored files
+	// are included since some checks read them, e.g. .golangci.yml.
+	if files, err := repo.Staged(nil); err == nil && !checks.Relevant(enabledChecks, repo.Root(), files) {
+		log.Printf("none of the %d staged files is relevant to the %d checks", len(files), len(enabledChecks))
+		return nil
+	}
+	if repo.HEAD() == scm.GitInitialCommit {
+		// There's no commit to create a worktree on yet.
+		return runPreCommitStashed(s, repo, config, enabledChecks, options)
+	}
+	// Check the index in a temporary worktree so the checkout is never touched.
+	w, remove, err := checkoutIndex(repo)
+	if err != nil {
+		log.Printf("stashing instead: %s", err)
+		return runPreCommitStashed(s, repo, config, enabledChecks, options)
+	}
+	var change scm.Change
+	change, err = w.Between(scm.Current, w.HEAD(), config.IgnorePatterns)
+	if change != nil {
+		err = runSelectedChecks(s, config, change, []checks.Mode{checks.PreCommit}, enabledChecks, worktreeOptions(options), &sync.WaitGroup{})
+	}
+	if err2 := remove(); err2 != nil {
+		fmt.Fprintf(s.stdout, "warning: %s\n", err2)
+	}
+	return err
 }
 
-func runPreCommit(repo scm.Repo, config *checks.Config) error {
+// runPreCommitStashed runs enabledChecks, the pre-commit checks not skipped,
+// in the checkout after stashing the changes not in the index.
+func runPreCommitStashed(s *session, repo scm.Repo, config *checks.Config, enabledChecks []checks.Check, options *checks.Options) error {
 	// First, stash index and work dir, keeping only the to-be-committed changes
 	// in the working directory.
-	stashed, err := repo.Stash()
+	stashed, err := repo.Stash(config.Untracked)
 	if err != nil {
 		return err
 	}
@@ -274,7 +849,7 @@ func runPreCommit(repo scm.Repo, config *checks.Config) error {
 	var change scm.Change
 	change, err = repo.Between(scm.Current, repo.HEAD(), config.IgnorePatterns)
 	if change != nil {
-		err = runChecks(config, change, []checks.Mode{checks.PreCommit}, &sync.WaitGroup{})
+		err = runSelectedChecks(s, config, change, []checks.Mode{checks.PreCommit}, enabledChecks, options, &sync.WaitGroup{})
 	}
 	// If stashed is false, everything was in the index so no stashing was needed.
 	if stashed {
@@ -285,7 +860,95 @@ func runPreCommit(repo scm.Repo, config *checks.Config) error {
 	return err
 }
 
-func runPrePush(repo scm.Repo, config *checks.Config) (err error) {
+// runPostCheckout refreshes the prerequisites of the checks run by the
+// pre-commit and pre-push hooks, and the hooks themselves, when the
+// configuration changed, e.g. after switching to a branch enabling a new check
+// or merging one. hook is post-checkout or post-merge and args the arguments
+// git passes to it.
+//
+// The configuration last refreshed is recorded in the prerequisites cache. A
+// failure is only a warning since git already updated the checkout; the
+// refresh is then attempted again by the next hook.
+func runPostCheckout(s *session, repo scm.ReadOnlyRepo, config *checks.Config, hook string, args []string, noUpdate bool) error {
+	if hook == postCheckout {
+		// git passes the previous HEAD, the new HEAD and 1 for a branch checkout.
+		if len(args) != 3 {
+			return errors.New("post-checkout hook requires 3 arguments")
+		}
+		if args[2] != "1" {
+			// File checkout.
+			return nil
+		}
+	}
+	stamp, err := configStamp(config)
+	if err != nil {
+		return err
+	}
+	if loadPrereqCache(repo).Config == stamp {
+		return nil
+	}
+	log.Printf("configuration changed; refreshing the prerequisites and the hooks")
+	ok := true
+	if err := cmdInstallPrereq(s, repo, config, []checks.Mode{checks.PreCommit, checks.PrePush}, noUpdate); err != nil {
+		fmt.Fprintf(s.stdout, "warning: %s; run 'pcg prereq'\n", err)
+		ok = false
+	}
+	if err := refreshHooks(repo, config); err != nil {
+		fmt.Fprintf(s.stdout, "warning: %s; run 'pcg install'\n", err)
+		ok = false
+	}
+	if !ok {
+		return nil
+	}
+	// cmdInstallPrereq saved the cache, so it is loaded again.
+	prereqs := loadPrereqCache(repo)
+	prereqs.setConfig(stamp)
+	return prereqs.save(repo)
+}
+
+// configStamp returns a hash of the configuration as loaded, including the
+// base configuration it extends.
+func configStamp(config *checks.Config) (string, error) {
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	return sha256Hex(content), nil
+}
+
+// runCommitMsg runs the checks in mode commit-msg on the staged files, with
+// the commit message in msgFile.
+func runCommitMsg(s *session, repo scm.Repo, config *checks.Config, msgFile string) error {
+	msg, err := ioutil.ReadFile(msgFile)
+	if err != nil {
+		return err
+	}
+	enabledChecks, options := config.EnabledChecks([]checks.Mode{checks.CommitMsg})
+	enabledChecks = filterSkipped(s, enabledChecks, skippedChecks(s, string(msg)))
+	options.CommitMessageFile = msgFile
+	log.Printf("mode: %s; %d checks; %d max seconds allowed", checks.CommitMsg, len(enabledChecks), options.MaxDuration)
+	change, err := repo.Between(scm.Current, repo.HEAD(), config.IgnorePatterns)
+	if err != nil {
+		return err
+	}
+	if change == nil {
+		// Nothing is staged, e.g. "git commit --amend" or "--allow-empty". Only
+		// the commit message can be checked.
+		log.Printf("no change; only running commitmsg")
+		for _, c := range enabledChecks {
+			if _, ok := c.(*checks.CommitMessage); ok {
+				if err2 := c.Run(nil, options).Err; err2 != nil {
+					fmt.Fprintf(s.stdout, "%s\n", err2)
+					err = checksFailed(errors.New("checks failed"))
+				}
+			}
+		}
+		return err
+	}
+	return runEnabledChecks(s, enabledChecks, options, change, &sync.WaitGroup{})
+}
+
+func runPrePush(s *session, repo scm.Repo, config *checks.Config, stdin io.Reader) (err error) {
 	previous := repo.HEAD()
 	// Will be "" if the current checkout was detached.
 	previousRef := repo.Ref()
@@ -308,9 +971,12 @@ func runPrePush(repo scm.Repo, config *checks.Config) (err error) {
 		}
 	}()
 
-	bio := bufio.NewReader(os.Stdin)
+	bio := bufio.NewReader(stdin)
 	line := ""
 	triedToStash := false
+	// The ranges already checked, e.g. when the same commit is pushed to
+	// multiple refs.
+	checked := map[string]bool{}
 	for {
 		if line, err = bio.ReadString('\n'); err != nil {
 			break
@@ -319,18 +985,30 @@ func runPrePush(repo scm.Repo, config *checks.Config) (err error) {
 		if len(matches) != 5 {
 			return fmt.Errorf("unexpected stdin for pre-push: %q", line)
 		}
-		from := scm.Commit(matches[4])
 		to := scm.Commit(matches[2])
 		if to == gitNilCommit {
 			// It's being deleted.
 			continue
 		}
+		var from scm.Commit
+		if from, err = pushBase(repo, to, scm.Commit(matches[4])); err != nil {
+			return
+		}
+		if from == to {
+			log.Printf("%s: already pushed", matches[1])
+			continue
+		}
+		r := string(from) + ".." + string(to)
+		if checked[r] {
+			continue
+		}
+		checked[r] = true
 		if to != curr {
 			// Stash, checkout, run tests.
 			if !triedToStash {
 				// Only try to stash once.
 				triedToStash = true
-				if stashed, err = repo.Stash(); err != nil {
+				if stashed, err = repo.Stash(config.Untracked); err != nil {
 					return
 				}
 			}
@@ -339,14 +1017,15 @@ func runPrePush(repo scm.Repo, config *checks.Config) (err error) {
 				return
 			}
 		}
-		if from == gitNilCommit {
-			from = scm.GitInitialCommit
-		}
 		change, err := repo.Between(to, from, config.IgnorePatterns)
 		if err != nil {
 			return err
 		}
-		if err = runChecks(config, change, []checks.Mode{checks.PrePush}, &sync.WaitGroup{}); err != nil {
+		msg, err := repo.Message(to)
+		if err != nil {
+			return err
+		}
+		if err = runChecks(s, config, change, []checks.Mode{checks.PrePush}, skippedChecks(s, msg), &sync.WaitGroup{}); err != nil {
 			return err
 		}
 	}
@@ -356,7 +1035,30 @@ func runPrePush(repo scm.Repo, config *checks.Config) (err error) {
 	return
 }
 
-func processModes(modeFlag string) ([]checks.Mode, error) {
+// pushBase returns the commit to diff to against when pushing it over the
+// remote commit remote; to itself if no commit is pushed.
+//
+// Only the commits the remote doesn't have are checked: when the remote ref is
+// created or the remote commit is unknown locally, e.g. it was fetched by
+// someone else, the commits already in a remote-tracking branch are skipped.
+func pushBase(repo scm.ReadOnlyRepo, to, remote scm.Commit) (scm.Commit, error) {
+	if remote != gitNilCommit {
+		if _, err := repo.Eval(string(remote)); err == nil {
+			if n, err := repo.CountCommits(remote, to); err != nil || n == 0 {
+				// Nothing new, e.g. the ref is rewound.
+				return to, err
+			}
+			if base, err := repo.MergeBase(to, remote); err == nil {
+				return base, nil
+			}
+		}
+	}
+	return repo.Unpushed(to)
+}
+
+// processModes parses the -m flag. Besides the predefined modes and their
+// shortcuts, the custom modes declared in config are accepted.
+func processModes(modeFlag string, config *checks.Config) ([]checks.Mode, error) {
 	if len(modeFlag) == 0 {
 		return nil, nil
 	}
@@ -374,7 +1076,13 @@ func processModes(modeFlag string) ([]checks.Mode, error) {
 				modes = append(modes, checks.ContinuousIntegration)
 			case string(checks.Lint):
 				modes = append(modes, checks.Lint)
+			case string(checks.CommitMsg):
+				modes = append(modes, checks.CommitMsg)
 			default:
+				if _, ok := config.Modes[checks.Mode(p)]; ok {
+					modes = append(modes, checks.Mode(p))
+					continue
+				}
 				return nil, fmt.Errorf("invalid mode \"%s\"\n\n%s", p, helpModes)
 			}
 		}
@@ -382,6 +1090,12 @@ func processModes(modeFlag string) ([]checks.Mode, error) {
 	return modes, nil
 }
 
+type resultsByName []result
+
+func (r resultsByName) Len() int           { return len(r) }
+func (r resultsByName) Swap(i, j int)      { r[i], r[j] = r[j], r[i] }
+func (r resultsByName) Less(i, j int) bool { return r[i].check.GetName() < r[j].check.GetName() }
+
 type sortedChecks []checks.Check
 
 func (s sortedChecks) Len() int           { return len(s) }
@@ -390,14 +1104,12 @@ func (s sortedChecks) Less(i, j int) bool { return s[i].GetName() < s[j].GetName
 
 // Commands.
 
-func cmdHelp(repo scm.ReadOnlyRepo, config *checks.Config, usage string) error {
+func cmdHelp() error {
 	s := &struct {
-		Usage        string
 		Max          int
 		NativeChecks sortedChecks
 		OtherChecks  sortedChecks
 	}{
-		usage,
 		0,
 		sortedChecks{},
 		sortedChecks{},
@@ -430,8 +1142,17 @@ func cmdInfo(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode,
 	}
 	fmt.Printf("IgnorePatterns:\n%s", content)
 
+	languages := make([]string, 0, len(config.Languages))
+	for name := range config.Languages {
+		languages = append(languages, name)
+	}
+	sort.Strings(languages)
+	for _, name := range languages {
+		fmt.Printf("Language %s: %s\n", name, strings.Join(config.Languages[name].Extensions, " "))
+	}
+
 	if len(modes) == 0 {
-		modes = checks.AllModes
+		modes = config.AllModes()
 	}
 	for _, mode := range modes {
 		settings := config.Modes[mode]
@@ -466,32 +1187,42 @@ func cmdInfo(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode,
 }
 
 // cmdInstallPrereq installs all the packages needed to run the enabled checks.
-func cmdInstallPrereq(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, noUpdate bool) error {
+func cmdInstallPrereq(s *session, repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, noUpdate bool) error {
 	var wg sync.WaitGroup
 	enabledChecks, _ := config.EnabledChecks(modes)
-	number := 0
-	c := make(chan string, len(enabledChecks))
+	var all []checks.CheckPrerequisite
 	for _, check := range enabledChecks {
-		for _, p := range check.GetPrerequisites() {
-			number++
-			wg.Add(1)
-			go func(prereq checks.CheckPrerequisite) {
-				defer wg.Done()
-				if !prereq.IsPresent() {
-					c <- prereq.URL
-				}
-			}(p)
-		}
+		all = append(all, check.GetPrerequisites()...)
+	}
+	// The channel is only drained once all the prerequisites are checked.
+	c := make(chan checks.CheckPrerequisite, len(all))
+	prereqs := loadPrereqCache(repo)
+	for _, p := range all {
+		wg.Add(1)
+		go func(prereq checks.CheckPrerequisite) {
+			defer wg.Done()
+			if !prereqs.isPresent(prereq) {
+				c <- prereq
+			}
+		}(p)
 	}
 	wg.Wait()
-	log.Printf("Checked for %d prerequisites", number)
+	log.Printf("Checked for %d prerequisites", len(all))
+	if err := prereqs.save(repo); err != nil {
+		log.Printf("failed to save %s: %s", prereqCacheFile, err)
+	}
 	loop := true
-	// Use a map to remove duplicates.
+	// Use maps to remove duplicates.
 	m := map[string]bool{}
+	h := map[string]bool{}
 	for loop {
 		select {
-		case url := <-c:
-			m[url] = true
+		case prereq := <-c:
+			if prereq.URL != "" {
+				m[prereq.URL] = true
+			} else {
+				h[fmt.Sprintf("%s: %s", prereqName(prereq), prereq.InstallHint)] = true
+			}
 		default:
 			loop = false
 		}
@@ -500,6 +1231,11 @@ func cmdInstallPrereq(repo scm.ReadOnlyRepo, config *checks.Config, modes []chec
 	for url := range m {
 		urls = append(urls, url)
 	}
+	hints := make([]string, 0, len(h))
+	for hint := range h {
+		hints = append(hints, hint)
+	}
+	sort.Strings(hints)
 	wd, err := os.Getwd()
 	if err != nil {
 		return err
@@ -511,35 +1247,76 @@ func cmdInstallPrereq(repo scm.ReadOnlyRepo, config *checks.Config, modes []chec
 			for _, url := range urls {
 				out += "  " + url + "\n"
 			}
-			return errors.New(out)
+			for _, hint := range hints {
+				out += "  " + hint + "\n"
+			}
+			return environmentError(errors.New(out))
 		}
-		fmt.Printf("Installing:\n")
+		fmt.Fprintf(s.stdout, "Installing:\n")
 		for _, url := range urls {
-			fmt.Printf("  %s\n", url)
+			fmt.Fprintf(s.stdout, "  %s\n", url)
 		}
 
-		out, _, err := internal.Capture(wd, nil, append([]string{"go", "get"}, urls...)...)
+		out, _, err := internal.Capture(wd, s.envOverrides(), append([]string{"go", "get"}, urls...)...)
 		if len(out) != 0 {
-			return fmt.Errorf("prerequisites installation failed: %s", out)
+			return environmentError(fmt.Errorf("prerequisites installation failed: %s", out))
 		}
 		if err != nil {
-			return fmt.Errorf("prerequisites installation failed: %s", err)
+			return environmentError(fmt.Errorf("prerequisites installation failed: %s", err))
 		}
 	}
+	if len(hints) != 0 {
+		return environmentError(fmt.Errorf("prerequisites must be installed manually:\n  %s", strings.Join(hints, "\n  ")))
+	}
 	log.Printf("Prerequisites installation succeeded")
 	return nil
 }
 
-// cmdInstall first calls cmdInstallPrereq() then install the
-// .git/hooks/pre-commit and pre-push hooks.
-//
+// cmdListPrereq prints the prerequisites of the enabled checks, where they are
+// installed and their version.
+func cmdListPrereq(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode) error {
+	enabledChecks, _ := config.EnabledChecks(modes)
+	infos := collectPrereqs(enabledChecks)
+	if len(infos) == 0 {
+		fmt.Printf("No prerequisite.\n")
+		return nil
+	}
+	prereqs := loadPrereqCache(repo)
+	detectAll(infos, prereqs)
+	printPrereqs(os.Stdout, infos)
+	return prereqs.save(repo)
+}
+
+// cmdOutdatedPrereq returns an error listing the prerequisites of the enabled
+// checks not matching the version pinned in the configuration.
+func cmdOutdatedPrereq(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode) error {
+	if len(config.Prerequisites) == 0 {
+		return errors.New("no prerequisite is pinned, see prerequisites in CONFIGURATION.md")
+	}
+	enabledChecks, _ := config.EnabledChecks(modes)
+	infos := collectPrereqs(enabledChecks)
+	prereqs := loadPrereqCache(repo)
+	detectAll(infos, prereqs)
+	if err := prereqs.save(repo); err != nil {
+		log.Printf("failed to save %s: %s", prereqCacheFile, err)
+	}
+	if bad := outdatedPrereqs(infos, config.Prerequisites); len(bad) != 0 {
+		return environmentError(errors.New("outdated prerequisites:\n  " + strings.Join(bad, "\n  ")))
+	}
+	fmt.Printf("All pinned prerequisites are up to date.\n")
+	return nil
+}
+
+// cmdInstall first calls cmdInstallPrereq() then install the
+// .git/hooks/pre-commit and pre-push hooks.
+//
 // Silently ignore installing the hooks when running under a CI. In
 // particular, circleci.com doesn't create the directory .git/hooks.
 func cmdInstall(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, noUpdate bool, prereqReady *sync.WaitGroup) (err error) {
 	errCh := make(chan error, 1)
 	go func() {
 		defer prereqReady.Done()
-		errCh <- cmdInstallPrereq(repo, config, modes, noUpdate)
+		errCh <- cmdInstallPrereq(processSession(), repo, config, modes, noUpdate)
 	}()
 
 	defer func() {
@@ -557,20 +1334,32 @@ func cmdInstall(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mod
 	if err2 != nil {
 		return err2
 	}
-	for _, t := range []string{"pre-commit", "pre-push"} {
-		// Always remove hook first if it exists, in case it's a symlink.
-		p := filepath.Join(hookDir, t)
-		_ = os.Remove(p)
-		if err = ioutil.WriteFile(p, []byte(fmt.Sprintf(hookContent, t)), 0777); err != nil {
-			return err
-		}
+	hooks, err2 := enabledHooks(config)
+	if err2 != nil {
+		return err2
+	}
+	if err = installHooks(hookDir, hooks); err != nil {
+		return err
 	}
 	log.Printf("Installation done")
 	return nil
 }
 
+// cmdUninstall removes the git hooks installed by cmdInstall.
+func cmdUninstall(repo scm.ReadOnlyRepo) error {
+	hookDir, err := repo.HookPath()
+	if err != nil {
+		return err
+	}
+	if err := uninstallHooks(hookDir); err != nil {
+		return err
+	}
+	log.Printf("Uninstallation done")
+	return nil
+}
+
 // cmdRun runs all the enabled checks.
-func cmdRun(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, against string, prereqReady *sync.WaitGroup) error {
+func cmdRun(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, against string, rf *runFlags, prereqReady *sync.WaitGroup) error {
 	var err error
 	var old scm.Commit
 	if against != "" {
@@ -586,255 +1375,1509 @@ func cmdRun(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, a
 	if err != nil {
 		return err
 	}
-	return runChecks(config, change, modes, prereqReady)
+	return runChange(repo, config, modes, change, rf, prereqReady)
 }
 
-// cmdRunHook runs the checks in a git repository.
-//
-// Use a precise "stash, run checks, unstash" to ensure that the check is
-// properly run on the data in the index.
-func cmdRunHook(repo scm.Repo, config *checks.Config, mode string, noUpdate bool) error {
-	switch checks.Mode(mode) {
-	case checks.PreCommit:
-		return runPreCommit(repo, config)
-
-	case checks.PrePush:
-		return runPrePush(repo, config)
+// cmdRunFiles runs all the enabled checks on the specified files only, as
+// found in the working tree. files are relative to the repository root.
+func cmdRunFiles(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, files []string, rf *runFlags) error {
+	if len(files) == 0 {
+		return errors.New("no file specified")
+	}
+	change, err := repo.Files(files, config.IgnorePatterns)
+	if err != nil {
+		return err
+	}
+	return runChange(repo, config, modes, change, rf, &sync.WaitGroup{})
+}
 
-	case checks.ContinuousIntegration:
-		// Always runs all tests on CI.
-		change, err := repo.Between(scm.Current, scm.GitInitialCommit, config.IgnorePatterns)
+// runChange runs the enabled checks on change as requested by rf.
+func runChange(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, change scm.Change, rf *runFlags, prereqReady *sync.WaitGroup) error {
+	if rf.dryRun {
+		enabledChecks, options := config.EnabledChecks(modes)
+		enabledChecks = filterSkipped(processSession(), enabledChecks, skippedChecks(processSession(), ""))
+		printPlan(os.Stdout, modes, enabledChecks, options, change, loadHistory(repo), parallelism(options))
+		return nil
+	}
+	if rf.format == "json" || rf.format == "ndjson" || rf.format == "sarif" || rf.format == "html" {
+		if rf.output == "" {
+			w := rf.stdout
+			if w == nil {
+				w = os.Stdout
+			}
+			return runChangeReport(w, config, modes, change, rf.format, prereqReady)
+		}
+		f, err := os.Create(rf.output)
 		if err != nil {
 			return err
 		}
-		mode := []checks.Mode{checks.ContinuousIntegration}
-
-		// This is a special case, some users want reproducible builds and in this
-		// case they do not want any external reference and want to enforce
-		// noUpdate, but many people may not care (yet). So default to fetching but
-		// it can be overriden.
-		var prereqReady sync.WaitGroup
-		errCh := make(chan error, 1)
-		prereqReady.Add(1)
-		go func() {
-			defer prereqReady.Done()
-			errCh <- cmdInstallPrereq(repo, config, mode, noUpdate)
-		}()
-		err = runChecks(config, change, mode, &prereqReady)
-		if err2 := <-errCh; err2 != nil {
-			return err2
+		err = runChangeReport(f, config, modes, change, rf.format, prereqReady)
+		if err2 := f.Close(); err == nil {
+			err = err2
 		}
 		return err
-
-	default:
-		return errors.New("unsupported hook type for run-hook")
 	}
+	if (!rf.interactive && !rf.profile) || change == nil {
+		return runChecks(processSession(), config, change, modes, skippedChecks(processSession(), ""), prereqReady)
+	}
+	enabledChecks, options := config.EnabledChecks(modes)
+	enabledChecks = filterSkipped(processSession(), enabledChecks, skippedChecks(processSession(), ""))
+	if rf.profile {
+		options.PackageTimings = checks.NewPackageTimings()
+	}
+	start := time.Now()
+	results := runAllChecks(processSession(), enabledChecks, options, change, prereqReady)
+	duration := time.Now().Sub(start)
+	if rf.profile {
+		run := newStatsRun(start, duration, modes, results, options.PackageTimings)
+		s := loadStats(repo)
+		s.add(run)
+		if err := s.save(repo); err != nil {
+			log.Printf("failed to save stats: %s", err)
+		}
+		last := &stats{Runs: []*statsRun{run}}
+		checkTimings, packageTimings := last.slowest(0)
+		printTimings(os.Stdout, "Checks", checkTimings, 0)
+		printTimings(os.Stdout, "Slowest packages", packageTimings, 10)
+	}
+	if err := printResults(processSession(), results, options, duration); err == nil || !rf.interactive {
+		return err
+	}
+	return triage(results, change, options, os.Stdin, os.Stdout)
 }
 
-func cmdWriteConfig(repo scm.ReadOnlyRepo, config *checks.Config, configPath string) error {
-	config.MinVersion = version
-	content, err := yaml.Marshal(config)
-	if err != nil {
-		return fmt.Errorf("internal error when marshaling config: %s", err)
+// runChangeReport runs the enabled checks on change and writes their results
+// to w in format, see report.write().
+func runChangeReport(w io.Writer, config *checks.Config, modes []checks.Mode, change scm.Change, format string, prereqReady *sync.WaitGroup) error {
+	enabledChecks, options := config.EnabledChecks(modes)
+	enabledChecks = filterSkipped(processSession(), enabledChecks, skippedChecks(processSession(), ""))
+	var results []result
+	start := time.Now()
+	if change != nil {
+		results = runAllChecks(processSession(), enabledChecks, options, change, prereqReady)
 	}
-	_ = os.Remove(configPath)
-	return ioutil.WriteFile(configPath, append([]byte(yamlHeader), content...), 0666)
+	duration := time.Now().Sub(start)
+	if change != nil {
+		notifyResults(processSession(), config, change.Repo(), modes, results, duration)
+	}
+	r := newReport(modes, results, options, change, duration)
+	if err := r.write(w, format); err != nil {
+		return err
+	}
+	if !r.Success {
+		return checksFailed(fmt.Errorf("checks failed in %1.2fs", duration.Seconds()))
+	}
+	return nil
 }
 
-// mainImpl implements pcg.
-func mainImpl() error {
-	if len(os.Args) == 1 {
-		if checks.IsContinuousIntegration() {
-			os.Args = append(os.Args, "run-hook", "continuous-integration")
-		} else {
-			os.Args = append(os.Args, "installrun")
+// cmdFix applies the fixes of the enabled checks implementing checks.Fixer
+// to the working tree, then runs all the enabled checks to report the issues
+// left. If stage is true, the fixed files are added to the index.
+func cmdFix(repo scm.Repo, config *checks.Config, modes []checks.Mode, against string, stage bool) error {
+	var err error
+	var old scm.Commit
+	if against != "" {
+		if old, err = repo.Eval(against); err != nil {
+			return err
+		}
+	} else {
+		if old, err = repo.Upstream(); err != nil {
+			return err
 		}
 	}
-
-	cmd := os.Args[1]
-	copy(os.Args[1:], os.Args[2:])
-	os.Args = os.Args[:len(os.Args)-1]
-
-	verboseFlag := flag.Bool("v", checks.IsContinuousIntegration() || os.Getenv("VERBOSE") != "", "enables verbose logging output")
-	allFlag := flag.Bool("a", false, "runs checks as if all files had been modified")
-	againstFlag := flag.String("r", "", "runs checks on files modified since this revision, as evaluated by your scm repo")
-	noUpdateFlag := flag.Bool("n", false, "disallow using go get even if a prerequisite is missing; bail out instead")
-	configPathFlag := flag.String("c", "pre-commit-go.yml", "file name of the config to load")
-	modeFlag := flag.String("m", "", "coma separated list of modes to process; default depends on the command")
-	flag.Parse()
-
-	if *allFlag {
-		if *againstFlag != "" {
-			return errors.New("-a can't be used with -r")
+	change, err := repo.Between(scm.Current, old, config.IgnorePatterns)
+	if err != nil || change == nil {
+		return err
+	}
+	enabledChecks, options := config.EnabledChecks(modes)
+	enabledChecks = filterSkipped(processSession(), enabledChecks, skippedChecks(processSession(), ""))
+	// Fixers are run sequentially since they may modify the same files.
+	var fixed []string
+	for _, c := range enabledChecks {
+		fixer, ok := c.(checks.Fixer)
+		if !ok {
+			continue
+		}
+		scoped := checkScope(c, change)
+		if scoped == nil {
+			continue
+		}
+		log.Printf("fixing %s...", c.GetName())
+		files, err := fixer.Fix(scoped, options.ForCheck(c))
+		if err != nil {
+			return err
+		}
+		for _, f := range files {
+			fmt.Printf("fixed by %s: %s\n", c.GetName(), f)
+			if !contains(fixed, f) {
+				fixed = append(fixed, f)
+			}
+		}
+	}
+	if stage && len(fixed) != 0 {
+		if err := repo.Add(fixed...); err != nil {
+			return err
+		}
+	}
+	if len(fixed) != 0 {
+		// Reload the change since its content is cached.
+		if change, err = repo.Between(scm.Current, old, config.IgnorePatterns); err != nil || change == nil {
+			return err
 		}
-		*againstFlag = string(scm.GitInitialCommit)
 	}
+	return runEnabledChecks(processSession(), enabledChecks, options, change, &sync.WaitGroup{})
+}
 
-	log.SetFlags(log.Lmicroseconds)
-	if !*verboseFlag {
-		log.SetOutput(ioutil.Discard)
+// cmdStats prints the slowest checks and packages over the last n profiled
+// runs.
+func cmdStats(repo scm.ReadOnlyRepo, n, top int) error {
+	s := loadStats(repo)
+	if len(s.Runs) == 0 {
+		return errors.New("no profiled run recorded, use 'pcg run -profile' first")
 	}
+	runs := len(s.Runs)
+	if n > 0 && runs > n {
+		runs = n
+	}
+	fmt.Printf("Last %d profiled runs:\n", runs)
+	checkTimings, packageTimings := s.slowest(n)
+	printTimings(os.Stdout, "Slowest checks", checkTimings, top)
+	printTimings(os.Stdout, "Slowest packages", packageTimings, top)
+	return nil
+}
 
-	modes, err := processModes(*modeFlag)
-	if err != nil {
+// cmdBench runs the benchmarks of the packages affected by the files modified
+// since against and records them in the baseline file. args are the flags
+// passed to 'go test'; when empty, the ones recorded in the baseline are
+// reused.
+func cmdBench(repo scm.ReadOnlyRepo, config *checks.Config, against string, args []string) error {
+	var err error
+	var old scm.Commit
+	if against != "" {
+		if old, err = repo.Eval(against); err != nil {
+			return err
+		}
+	} else if old, err = repo.Upstream(); err != nil {
 		return err
 	}
-
-	cwd, err := os.Getwd()
+	change, err := repo.Between(scm.Current, old, config.IgnorePatterns)
 	if err != nil {
 		return err
 	}
-	repo, err := scm.GetRepo(cwd, "")
+	if change == nil {
+		fmt.Printf("No change.\n")
+		return nil
+	}
+	p := baselinePath(repo.Root())
+	baseline, err := loadBaseline(p)
 	if err != nil {
-		return err
+		return fmt.Errorf("%s: %s", p, err)
 	}
+	if len(args) == 0 {
+		args = baseline.Args
+	}
+	if len(args) == 0 {
+		args = defaultBenchArgs
+	}
+	baseline.Args = args
+	// Benchmarks are run one package at a time so they don't compete for the
+	// CPU.
+	for _, pkg := range change.Indirect().TestPackages() {
+		cmd := append(append([]string{"go", "test"}, args...), pkg)
+		out, exitCode, err := internal.Capture(repo.Root(), []string{"GOPATH=" + repo.GOPATH()}, cmd...)
+		if err != nil || exitCode != 0 {
+			return fmt.Errorf("%s failed:\n%s", strings.Join(cmd, " "), out)
+		}
+		results := parseBench(out)
+		names := make([]string, 0, len(results))
+		for name := range results {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Printf("%s.%s: %.1f ns/op\n", pkg, name, results[name].NsPerOp)
+		}
+		baseline.update(pkg, results)
+	}
+	return baseline.save(p)
+}
 
-	configPath, config := loadConfig(repo, *configPathFlag)
-	log.Printf("config: %s", configPath)
-
-	switch cmd {
-	case "help", "-help", "-h":
-		cmd = "help"
-		if *allFlag != false {
-			return fmt.Errorf("-a can't be used with %s", cmd)
-		}
-		if *againstFlag != "" {
-			return fmt.Errorf("-r can't be used with %s", cmd)
-		}
-		if *noUpdateFlag != false {
-			return fmt.Errorf("-n can't be used with %s", cmd)
-		}
-		if *configPathFlag != "pre-commit-go.yml" {
-			return fmt.Errorf("-m can't be used with %s", cmd)
-		}
-		if *modeFlag != "" {
-			return fmt.Errorf("-m can't be used with %s", cmd)
-		}
-		b := &bytes.Buffer{}
-		flag.CommandLine.SetOutput(b)
-		flag.CommandLine.PrintDefaults()
-		return cmdHelp(repo, config, b.String())
-
-	case "info":
-		if *allFlag != false {
-			return fmt.Errorf("-a can't be used with %s", cmd)
-		}
-		if *againstFlag != "" {
-			return fmt.Errorf("-r can't be used with %s", cmd)
+// cmdBaseline runs the lint checks enabled in modes on all the files and
+// records their findings in the baseline file, so they are not reported
+// anymore.
+func cmdBaseline(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode) error {
+	change, err := repo.Between(scm.Current, scm.GitInitialCommit, config.IgnorePatterns)
+	if err != nil {
+		return err
+	}
+	if change == nil {
+		fmt.Printf("No file to check.\n")
+		return nil
+	}
+	enabledChecks, options := config.EnabledChecks(modes)
+	var lint []checks.Check
+	for _, c := range enabledChecks {
+		if checks.UsesBaseline(c) {
+			lint = append(lint, c)
 		}
-		if *noUpdateFlag != false {
-			return fmt.Errorf("-n can't be used with %s", cmd)
+	}
+	if len(lint) == 0 {
+		return errors.New("no check supporting the baseline is enabled; they are errcheck, golangci-lint, golint and govet")
+	}
+	options.Baseline = checks.NewBaseline(true)
+	// All the findings are recorded, so an error is a failure to run the
+	// check, e.g. a missing prerequisite.
+	for _, r := range runAllChecks(processSession(), lint, options, change, &sync.WaitGroup{}) {
+		if r.err != nil {
+			return fmt.Errorf("%s failed: %s", r.check.GetName(), r.err)
 		}
-		return cmdInfo(repo, config, modes, configPath)
+	}
+	p := filepath.Join(repo.Root(), checks.BaselineFile)
+	count := 0
+	for _, items := range options.Baseline.Findings() {
+		count += len(items)
+	}
+	fmt.Printf("Recorded %d finding(s) in %s\n", count, p)
+	return options.Baseline.Save(p)
+}
 
-	case "install", "i":
-		cmd = "install"
-		if *allFlag != false {
-			return fmt.Errorf("-a can't be used with %s", cmd)
-		}
-		if *againstFlag != "" {
-			return fmt.Errorf("-r can't be used with %s", cmd)
+// cmdClean deletes the state kept by pcg. Prerequisites are installed in
+// $GOPATH/bin like any other tool and are not removed.
+func cmdClean(repo scm.ReadOnlyRepo, dryRun bool) error {
+	paths := cleanPaths(repo, os.TempDir(), time.Now())
+	if len(paths) == 0 {
+		fmt.Printf("Nothing to clean.\n")
+		return nil
+	}
+	for _, p := range paths {
+		if dryRun {
+			fmt.Printf("Would remove %s\n", p)
+			continue
 		}
-		if len(modes) == 0 {
-			modes = checks.AllModes
+		fmt.Printf("Removing %s\n", p)
+		if err := internal.RemoveAll(p); err != nil {
+			return err
 		}
-		var prereqReady sync.WaitGroup
-		prereqReady.Add(1)
-		return cmdInstall(repo, config, modes, *noUpdateFlag, &prereqReady)
+	}
+	return nil
+}
 
-	case "installrun":
-		if len(modes) == 0 {
-			modes = []checks.Mode{checks.PrePush}
-		}
-		// Start running all checks that do not have a prerequisite before
-		// installation is completed.
-		var prereqReady sync.WaitGroup
-		prereqReady.Add(1)
-		errCh := make(chan error, 1)
-		go func() {
-			errCh <- cmdInstall(repo, config, modes, *noUpdateFlag, &prereqReady)
-		}()
-		err := cmdRun(repo, config, modes, *againstFlag, &prereqReady)
-		if err2 := <-errCh; err2 != nil {
-			return err2
+// cmdExplain prints the details of the check name, as configured for modes,
+// on the files modified since against.
+func cmdExplain(repo scm.ReadOnlyRepo, config *checks.Config, modes []checks.Mode, against, name string) error {
+	var err error
+	var old scm.Commit
+	if against != "" {
+		if old, err = repo.Eval(against); err != nil {
+			return err
 		}
+	} else if old, err = repo.Upstream(); err != nil {
+		log.Printf("%s; using all files", err)
+		old = scm.GitInitialCommit
+	}
+	change, err := repo.Between(scm.Current, old, config.IgnorePatterns)
+	if err != nil {
 		return err
+	}
+	if len(modes) == 0 {
+		modes = config.AllModes()
+	}
+	return explain(os.Stdout, name, findInstances(config, modes, name), change)
+}
 
-	case "prereq", "p":
-		cmd = "prereq"
-		if *allFlag != false {
-			return fmt.Errorf("-a can't be used with %s", cmd)
-		}
-		if *againstFlag != "" {
-			return fmt.Errorf("-r can't be used with %s", cmd)
+// cmdRunRev runs the checks on the changes in the revision range rev, e.g.
+// "HEAD~1..HEAD", on the tree of its head. "base...head" uses the merge base of
+// base and head.
+func cmdRunRev(repo scm.Repo, config *checks.Config, modes []checks.Mode, rev string) error {
+	head, old, err := evalRange(repo, rev)
+	if err != nil {
+		return err
+	}
+	return withCheckout(repo, config.Untracked, head, func() error {
+		change, err := repo.Between(head, old, config.IgnorePatterns)
+		if err != nil {
+			return err
 		}
-		if len(modes) == 0 {
-			modes = checks.AllModes
+		msg, err := repo.Message(head)
+		if err != nil {
+			return err
 		}
-		return cmdInstallPrereq(repo, config, modes, *noUpdateFlag)
+		return runChecks(processSession(), config, change, modes, skippedChecks(processSession(), msg), &sync.WaitGroup{})
+	})
+}
 
-	case "run", "r":
-		cmd = "run"
-		if *noUpdateFlag != false {
-			return fmt.Errorf("-n can't be used with %s", cmd)
-		}
-		if len(modes) == 0 {
-			modes = []checks.Mode{checks.PrePush}
-		}
-		return cmdRun(repo, config, modes, *againstFlag, &sync.WaitGroup{})
+// setOnlyChanged enables only_changed on modes.
+func setOnlyChanged(config *checks.Config, modes []checks.Mode) {
+	for _, m := range modes {
+		settings := config.Modes[m]
+		settings.Options.OnlyChanged = true
+		config.Modes[m] = settings
+	}
+}
 
-	case "run-hook":
-		if modes != nil {
-			return fmt.Errorf("-m can't be used with %s", cmd)
-		}
-		if *allFlag != false {
-			return fmt.Errorf("-a can't be used with %s", cmd)
-		}
-		if *againstFlag != "" {
-			return fmt.Errorf("-r can't be used with %s", cmd)
-		}
-		if flag.NArg() != 1 {
-			return errors.New("run-hook is only meant to be used by hooks")
-		}
-		return cmdRunHook(repo, config, flag.Arg(0), *noUpdateFlag)
+// setFailFast enables fail_fast on modes.
+func setFailFast(config *checks.Config, modes []checks.Mode) {
+	for _, m := range modes {
+		settings := config.Modes[m]
+		settings.Options.FailFast = true
+		config.Modes[m] = settings
+	}
+}
 
-	case "version":
-		if modes != nil {
-			return fmt.Errorf("-m can't be used with %s", cmd)
-		}
-		if *allFlag != false {
-			return fmt.Errorf("-a can't be used with %s", cmd)
-		}
-		if *againstFlag != "" {
-			return fmt.Errorf("-r can't be used with %s", cmd)
+// applyProfiles applies the coma separated list of profiles to config, in
+// order.
+func applyProfiles(config *checks.Config, profiles string) error {
+	for _, p := range strings.Split(profiles, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
 		}
-		if *noUpdateFlag != false {
-			return fmt.Errorf("-n can't be used with %s", cmd)
+		log.Printf("profile: %s", p)
+		if err := config.ApplyProfile(p); err != nil {
+			return err
 		}
-		fmt.Println(version)
-		return nil
+	}
+	return nil
+}
+
+// setMaxParallel overrides max_parallel on modes.
+func setMaxParallel(config *checks.Config, modes []checks.Mode, n int) {
+	for _, m := range modes {
+		settings := config.Modes[m]
+		settings.Options.MaxParallel = n
+		config.Modes[m] = settings
+	}
+}
 
-	case "writeconfig", "w":
-		if modes != nil {
-			return fmt.Errorf("-m can't be used with %s", cmd)
+// readFileList returns the non-empty lines of r, e.g. the list of files
+// piped to 'run -files -'.
+func readFileList(r io.Reader) ([]string, error) {
+	var out []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" {
+			out = append(out, line)
 		}
-		if *allFlag != false {
-			return fmt.Errorf("-a can't be used with %s", cmd)
+	}
+	return out, scanner.Err()
+}
+
+// relToRoot converts files relative to cwd or absolute to be relative to the
+// repository root.
+func relToRoot(root, cwd string, files []string) ([]string, error) {
+	out := make([]string, 0, len(files))
+	for _, f := range files {
+		if !filepath.IsAbs(f) {
+			f = filepath.Join(cwd, f)
 		}
-		if *againstFlag != "" {
-			return fmt.Errorf("-r can't be used with %s", cmd)
+		rel, err := filepath.Rel(root, f)
+		if err != nil {
+			return nil, err
 		}
-		// Note that in that case, configPath is ignored and not overritten.
-		return cmdWriteConfig(repo, config, *configPathFlag)
+		out = append(out, filepath.ToSlash(rel))
+	}
+	return out, nil
+}
 
-	default:
-		return errors.New("unknown command, try 'help'")
+// evalRange returns the head and base commits of the revision range rev.
+func evalRange(repo scm.ReadOnlyRepo, rev string) (scm.Commit, scm.Commit, error) {
+	sep := ".."
+	if strings.Contains(rev, "...") {
+		sep = "..."
+	}
+	parts := strings.SplitN(rev, sep, 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("invalid revision range \"%s\", expected <base>..<head>", rev)
+	}
+	if parts[1] == "" {
+		parts[1] = "HEAD"
+	}
+	head, err := repo.Eval(parts[1])
+	if err != nil {
+		return "", "", err
+	}
+	old, err := repo.Eval(parts[0])
+	if err != nil {
+		return "", "", err
+	}
+	if sep == "..." {
+		if old, err = repo.MergeBase(old, head); err != nil {
+			return "", "", err
+		}
 	}
+	return head, old, nil
 }
 
-func main() {
-	if err := mainImpl(); err != nil {
-		fmt.Fprintf(os.Stderr, "pcg: %s\n", err)
-		os.Exit(1)
+// withCheckout stashes the local changes and checks out head, runs f, then
+// restores the checkout. Nothing is checked out if head is already checked
+// out. untracked defines what happens to the untracked files.
+func withCheckout(repo scm.Repo, untracked scm.Untracked, head scm.Commit, f func() error) (err error) {
+	previous := repo.HEAD()
+	// Will be "" if the current checkout was detached.
+	previousRef := repo.Ref()
+	stashed, err := repo.Stash(untracked)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if head != previous {
+			p := previousRef
+			if p == "" {
+				p = string(previous)
+			}
+			if err2 := repo.Checkout(p); err == nil {
+				err = err2
+			}
+		}
+		if stashed {
+			if err2 := repo.Restore(); err == nil {
+				err = err2
+			}
+		}
+	}()
+	if head != previous {
+		if err = repo.Checkout(string(head)); err != nil {
+			return err
+		}
+	}
+	return f()
+}
+
+// cmdRunPR fetches a pull request from the forge and runs the checks on the
+// changes it contains.
+//
+// Like pre-push, it stashes the local changes and checks out the pull
+// request's head, then restores the checkout.
+func cmdRunPR(repo scm.Repo, config *checks.Config, modes []checks.Mode, number int, post bool) (err error) {
+	client, err := newForgeClient(config.Forge)
+	if err != nil {
+		return err
+	}
+	pr, err := client.getPullRequest(number)
+	if err != nil {
+		return err
+	}
+	log.Printf("pull request %d: %s", pr.Number, pr.Title)
+	if err = repo.Fetch(client.remote, fmt.Sprintf("pull/%d/head", number), pr.Base.Ref); err != nil {
+		return err
+	}
+	head := scm.Commit(pr.Head.SHA)
+	old, err := repo.MergeBase(head, scm.Commit(pr.Base.SHA))
+	if err != nil {
+		return err
+	}
+	err = withCheckout(repo, config.Untracked, head, func() error {
+		change, err := repo.Between(head, old, config.IgnorePatterns)
+		if err != nil {
+			return err
+		}
+		return runChecks(processSession(), config, change, modes, skippedChecks(processSession(), ""), &sync.WaitGroup{})
+	})
+	if post {
+		body := fmt.Sprintf("pcg %s: checks in mode %s passed on %s.", version, modes, head)
+		if err != nil {
+			body = fmt.Sprintf("pcg %s: checks in mode %s failed on %s: %s", version, modes, head, err)
+		}
+		if err2 := client.postComment(number, body); err == nil {
+			err = err2
+		}
+	}
+	return err
+}
+
+// cmdRunHook runs the checks in a git repository.
+//
+// pre-commit checks the data in the index in a temporary worktree, falling
+// back to a precise "stash, run checks, unstash" when the worktree can't be
+// created.
+func cmdRunHook(s *session, repo scm.Repo, config *checks.Config, mode string, args []string, noUpdate bool) error {
+	// git passes the refs being pushed on stdin to pre-push; they are read
+	// first so both the chained hook and pcg get them.
+	var stdin []byte
+	if checks.Mode(mode) == checks.PrePush {
+		var err error
+		if stdin, err = ioutil.ReadAll(s.stdin); err != nil {
+			return err
+		}
+	}
+	if contains(managedHooks, mode) {
+		hookDir, err := repo.HookPath()
+		if err != nil {
+			return err
+		}
+		if err := runLocalHook(s, hookDir, mode, args, stdin); err != nil {
+			return err
+		}
+	}
+	switch checks.Mode(mode) {
+	case checks.PreCommit:
+		return runPreCommit(s, repo, config)
+
+	case checks.CommitMsg:
+		// git passes the path to the file containing the commit message.
+		if len(args) != 1 {
+			return errors.New("commit-msg hook requires the commit message file")
+		}
+		return runCommitMsg(s, repo, config, args[0])
+
+	case checks.PrePush:
+		return runPrePush(s, repo, config, bytes.NewReader(stdin))
+
+	case postCheckout, postMerge:
+		return runPostCheckout(s, repo, config, mode, args, noUpdate)
+
+	case checks.ContinuousIntegration:
+		// Always runs all tests on CI.
+		change, err := repo.Between(scm.Current, scm.GitInitialCommit, config.IgnorePatterns)
+		if err != nil {
+			return err
+		}
+		mode := []checks.Mode{checks.ContinuousIntegration}
+
+		// This is a special case, some users want reproducible builds and in this
+		// case they do not want any external reference and want to enforce
+		// noUpdate, but many people may not care (yet). So default to fetching but
+		// it can be overriden.
+		var prereqReady sync.WaitGroup
+		errCh := make(chan error, 1)
+		prereqReady.Add(1)
+		go func() {
+			defer prereqReady.Done()
+			errCh <- cmdInstallPrereq(s, repo, config, mode, noUpdate)
+		}()
+		msg, err := repo.Message(repo.HEAD())
+		if err != nil {
+			return err
+		}
+		err = runChecks(s, config, change, mode, skippedChecks(s, msg), &prereqReady)
+		if err2 := <-errCh; err2 != nil {
+			return err2
+		}
+		return err
+
+	default:
+		return errors.New("unsupported hook type for run-hook")
+	}
+}
+
+// cmdInit inspects the repository and asks which checks and modes to enable,
+// then writes the resulting configuration to configPath.
+func cmdInit(repo scm.ReadOnlyRepo, configPath string, a *asker) error {
+	var files []string
+	change, err := repo.Between(scm.Current, scm.GitInitialCommit, nil)
+	if err != nil {
+		return err
+	}
+	if change != nil {
+		files = change.All().Files()
+	}
+	config := initConfig(inspectRepo(files, checks.New(version).IgnorePatterns), a)
+	if err := cmdWriteConfig(repo, config, configPath); err != nil {
+		return err
+	}
+	fmt.Fprintf(a.out, "Wrote %s\n", configPath)
+	return nil
+}
+
+// cmdMigrateConfig upgrades in place the first configuration file found to the
+// current format.
+func cmdMigrateConfig(repo scm.ReadOnlyRepo, configPath string) error {
+	for _, file := range configCandidates(repo, configPath) {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		if content, err = decodeConfig(file, content); err != nil {
+			return err
+		}
+		migrated, changes, err := checks.MigrateConfig(content, version)
+		if err != nil {
+			return fmt.Errorf("failed to migrate %s: %s", file, err)
+		}
+		if len(changes) == 0 {
+			fmt.Printf("%s is up to date\n", file)
+			return nil
+		}
+		for _, c := range changes {
+			fmt.Printf("%s: %s\n", file, c)
+		}
+		if err := checks.ValidateConfig(migrated); err != nil {
+			fmt.Printf("warning: %s still needs manual changes:\n%s\n", file, err)
+		}
+		if commentLines(content) != commentLines(migrated) {
+			fmt.Printf("warning: only the comments at the top of %s were preserved\n", file)
+		}
+		if migrated, err = encodeConfig(file, migrated); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(file, migrated, 0666)
+	}
+	return fmt.Errorf("no %s found", configPath)
+}
+
+// commentLines returns the number of YAML comment lines in content.
+func commentLines(content []byte) int {
+	n := 0
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			n++
+		}
+	}
+	return n
+}
+
+func cmdWriteConfig(repo scm.ReadOnlyRepo, config *checks.Config, configPath string) error {
+	config.MinVersion = version
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("internal error when marshaling config: %s", err)
+	}
+	if content, err = encodeConfig(configPath, append([]byte(yamlHeader), content...)); err != nil {
+		return fmt.Errorf("internal error when encoding config: %s", err)
+	}
+	_ = os.Remove(configPath)
+	return ioutil.WriteFile(configPath, content, 0666)
+}
+
+// Command line handling.
+
+// command is a pcg subcommand. Each command defines its own flags.
+type command struct {
+	name        string
+	aliases     []string
+	description string
+	// run parses the command's flags from args then runs the command.
+	run func(c *command, args []string) error
+}
+
+// flagSet returns a new flag.FlagSet for this command, which usage is printed
+// with 'pcg help <command>' and '-help'.
+func (c *command) flagSet() *flag.FlagSet {
+	f := flag.NewFlagSet(c.name, flag.ContinueOnError)
+	// The parse errors are returned and printed by main; only the usage is
+	// printed here.
+	f.SetOutput(ioutil.Discard)
+	f.Usage = func() {
+		f.SetOutput(os.Stderr)
+		defer f.SetOutput(ioutil.Discard)
+		fmt.Fprintf(os.Stderr, "usage: pcg %s [flags]\n\n%s\n\nFlags:\n", c.name, c.description)
+		f.PrintDefaults()
+	}
+	return f
+}
+
+// parse parses args, returning errSilent on -help.
+func (c *command) parse(f *flag.FlagSet, args []string) error {
+	if err := f.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return errSilent
+		}
+		return configError(err)
+	}
+	return nil
+}
+
+// errSilent is returned when the process must exit successfully without
+// printing more, e.g. on -help.
+var errSilent = errors.New("silent error")
+
+// repoFlags are the flags shared by all the commands working on a repository.
+type repoFlags struct {
+	verbose bool
+	// logFile is the file receiving the complete verbose log, including the
+	// output of the commands run. Defaults to $PRECOMMITGO_LOG_FILE.
+	logFile    string
+	configPath string
+	mode       string
+	// profiles is the coma separated list of profiles to apply. Defaults to
+	// $PRECOMMITGO_PROFILE.
+	profiles string
+	// options are the check options overridden with -set, applied after the
+	// profiles.
+	options stringsFlag
+	// asWritten loads the configuration without merging its base nor expanding
+	// the environment variables, e.g. to rewrite it.
+	asWritten bool
+
+	// Initialized by load().
+	logsReady  bool
+	repo       scm.Repo
+	configFile string
+	config     *checks.Config
+	modes      []checks.Mode
+}
+
+// stringsFlag is a flag that can be repeated.
+type stringsFlag []string
+
+func (s *stringsFlag) String() string {
+	return strings.Join(*s, ", ")
+}
+
+func (s *stringsFlag) Set(v string) error {
+	*s = append(*s, v)
+	return nil
+}
+
+// register registers the flags. -m, -p and -set are only registered when
+// withModes is true.
+func (r *repoFlags) register(f *flag.FlagSet, withModes bool) {
+	f.BoolVar(&r.verbose, "v", checks.IsContinuousIntegration() || os.Getenv("VERBOSE") != "", "enables verbose logging output")
+	f.StringVar(&r.logFile, "log-file", os.Getenv("PRECOMMITGO_LOG_FILE"), "appends the complete verbose log, including every command run with its environment and output, to this file; defaults to $PRECOMMITGO_LOG_FILE")
+	f.StringVar(&r.configPath, "c", "pre-commit-go.yml", "file name of the config to load")
+	if withModes {
+		f.StringVar(&r.mode, "m", "", "coma separated list of modes to process; default depends on the command")
+		f.StringVar(&r.mode, "mode", "", "same as -m")
+		f.StringVar(&r.profiles, "p", "", "coma separated list of profiles to apply over the modes, e.g. quick; defaults to $PRECOMMITGO_PROFILE")
+		f.Var(&r.options, "set", "overrides an option of a check in all the modes, e.g. coverage.global.min_coverage=70; can be repeated")
+	}
+}
+
+// load sets up logging, then loads the repository and its configuration.
+func (r *repoFlags) load() error {
+	if err := r.initLogs(); err != nil {
+		return err
+	}
+	cwd, err := os.Getwd()
+	if err != nil {
+		return environmentError(err)
+	}
+	if r.repo, err = scm.GetRepo(cwd, ""); err != nil {
+		return environmentError(err)
+	}
+	if r.configFile, r.config, err = loadConfig(r.repo, r.configPath, r.asWritten); err != nil {
+		return configError(err)
+	}
+	log.Printf("config: %s", r.configFile)
+	if !r.asWritten && !filepath.IsAbs(r.configPath) {
+		nested, err := loadNestedConfigs(r.repo.Root(), r.configPath, r.config)
+		if err != nil {
+			return configError(err)
+		}
+		r.config = r.config.Nest(nested)
+		if r.profiles == "" {
+			r.profiles = os.Getenv("PRECOMMITGO_PROFILE")
+		}
+		if err := applyProfiles(r.config, r.profiles); err != nil {
+			return configError(err)
+		}
+		for _, o := range r.options {
+			log.Printf("set: %s", o)
+			if err := r.config.SetOption(o); err != nil {
+				return usageErrorf("-set %s", err)
+			}
+		}
+	}
+	// Custom modes are declared in the configuration.
+	if r.modes, err = processModes(r.mode, r.config); err != nil {
+		return configError(err)
+	}
+	return nil
+}
+
+// againstFlags are the flags to select the revision to diff against.
+type againstFlags struct {
+	all     bool
+	against string
+}
+
+// initLogs enables the logs with -v and opens -log-file, once.
+func (r *repoFlags) initLogs() error {
+	if r.logsReady {
+		return nil
+	}
+	r.logsReady = true
+	if !r.verbose {
+		log.SetOutput(ioutil.Discard)
+	} else {
+		// The logs already tell which checks are running.
+		showProgress = false
+	}
+	if r.logFile != "" {
+		if err := openLogFile(r.logFile, r.verbose); err != nil {
+			return environmentError(err)
+		}
+	}
+	return nil
+}
+
+func (a *againstFlags) register(f *flag.FlagSet) {
+	f.BoolVar(&a.all, "a", false, "runs checks as if all files had been modified")
+	f.StringVar(&a.against, "r", "", "runs checks on files modified since this revision, as evaluated by your scm repo")
+}
+
+// Output levels of writeResults.
+const (
+	// outputQuiet prints nothing unless a blocking check failed.
+	outputQuiet = iota
+	// outputNormal prints the failures and the warnings, then the summary
+	// if any.
+	outputNormal
+	// outputSummary only prints one line per check with its status and
+	// duration.
+	outputSummary
+)
+
+// outputLevel is the output level of writeResults, set with 'pcg run -quiet'
+// or '-summary'.
+var outputLevel = outputNormal
+
+// runFlags are the flags changing how the checks are run by 'run'.
+type runFlags struct {
+	interactive bool
+	profile     bool
+	dryRun      bool
+	// format is "text", "json", "ndjson", "sarif" or "html".
+	format string
+	// output is the file to write the report to when format is not "text",
+	// stdout if empty.
+	output string
+	// stdout is the real standard output when the report is written to it;
+	// os.Stdout is then stderr so nothing else corrupts the report.
+	stdout *os.File
+	// color is "auto", "always" or "never".
+	color string
+	// notify shows a desktop notification when the run finishes.
+	notify bool
+	// full prints the complete output of the failed checks, see
+	// condenseOutput().
+	full bool
+	// quiet and summary select the output level, see outputLevel.
+	quiet   bool
+	summary bool
+	// noCache disables the result cache, see useCache.
+	noCache bool
+}
+
+func (r *runFlags) register(f *flag.FlagSet) {
+	f.BoolVar(&r.interactive, "i", false, "asks what to do about each failed check: view its output, re-run it, fix or suppress it")
+	f.BoolVar(&r.profile, "profile", false, "prints the duration of each check and package and records them for 'pcg stats'")
+	f.BoolVar(&r.dryRun, "dry-run", false, "prints which checks would run, on which files and packages and with which command lines, without running them")
+	f.BoolVar(&r.quiet, "quiet", false, "prints nothing unless a check fails")
+	f.BoolVar(&r.summary, "summary", false, "only prints one line per check with its status and duration")
+	f.BoolVar(&r.noCache, "no-cache", false, "runs all the checks, including the ones that already passed on the same content; set $PRECOMMITGO_NO_CACHE for the hooks")
+	f.BoolVar(&r.full, "full", false, "prints the complete output of the failed checks instead of grouping the identical findings and truncating it; set $PRECOMMITGO_FULL_OUTPUT for the hooks")
+	f.BoolVar(&r.notify, "notify", false, "shows a desktop notification when the checks finish; set $PRECOMMITGO_NOTIFY to enable it in the hooks")
+	f.StringVar(&r.color, "color", "auto", "colorizes the output: auto when printing to a terminal and NO_COLOR is not set, always or never")
+	f.StringVar(&r.format, "format", "text", "output format: text, json for a single JSON document, ndjson for one JSON document per check, sarif for code scanning or html for a standalone report")
+	f.StringVar(&r.output, "output", "", "writes the report to this file instead of stdout; requires -format")
+}
+
+// revision returns the revision to diff against, "" meaning upstream.
+func (a *againstFlags) revision() (string, error) {
+	if a.all {
+		if a.against != "" {
+			return "", usageErrorf("-a can't be used with -r")
+		}
+		return string(scm.GitInitialCommit), nil
+	}
+	return a.against, nil
+}
+
+func runBench(c *command, args []string) error {
+	r := &repoFlags{}
+	a := &againstFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	a.register(f)
+	flags := f.String("flags", "", "space separated flags passed to 'go test'; default is the ones recorded in "+benchFile+" or \""+strings.Join(defaultBenchArgs, " ")+"\"")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	against, err := a.revision()
+	if err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdBench(r.repo, r.config, against, strings.Fields(*flags))
+}
+
+func runBaseline(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	if len(r.modes) == 0 {
+		r.modes = []checks.Mode{checks.ContinuousIntegration, checks.Lint}
+	}
+	return cmdBaseline(r.repo, r.config, r.modes)
+}
+
+func runClean(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	dryRun := f.Bool("dry-run", false, "prints what would be removed without removing anything")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdClean(r.repo, *dryRun)
+}
+
+func runDaemon(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if f.NArg() != 0 {
+		return usageErrorf("unexpected arguments %s", f.Args())
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdDaemon(r)
+}
+
+func runExplain(c *command, args []string) error {
+	r := &repoFlags{}
+	a := &againstFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	a.register(f)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if f.NArg() != 1 {
+		return usageErrorf("explain requires exactly one check name")
+	}
+	against, err := a.revision()
+	if err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdExplain(r.repo, r.config, r.modes, against, f.Arg(0))
+}
+
+func runHelp(c *command, args []string) error {
+	f := c.flagSet()
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	switch f.NArg() {
+	case 0:
+		return cmdHelp()
+	case 1:
+		sub := findCommand(f.Arg(0))
+		if sub == nil {
+			return usageErrorf("unknown command \"%s\", try 'help'", f.Arg(0))
+		}
+		// The simplest way to print the flags of a command is to ask for it.
+		if err := sub.run(sub, []string{"-help"}); err != errSilent {
+			return err
+		}
+		return nil
+	default:
+		return usageErrorf("help accepts at most one command")
+	}
+}
+
+func runInfo(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdInfo(r.repo, r.config, r.modes, r.configFile)
+}
+
+func runInit(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	defaults := f.Bool("y", false, "answers all the questions with their default value")
+	force := f.Bool("f", false, "overwrites the configuration file if it exists")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if !*defaults && !isTerminal(os.Stdin) {
+		return usageErrorf("init is interactive, use -y to accept the defaults")
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	configPath := r.configPath
+	if !filepath.IsAbs(configPath) {
+		configPath = filepath.Join(r.repo.Root(), configPath)
+	}
+	if _, err := os.Stat(configPath); err == nil && !*force {
+		return fmt.Errorf("%s already exists, use -f to overwrite it", configPath)
+	}
+	return cmdInit(r.repo, configPath, &asker{in: bufio.NewReader(os.Stdin), out: os.Stdout, defaults: *defaults})
+}
+
+func runInstall(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	noUpdate := f.Bool("n", false, "disallow using go get even if a prerequisite is missing; bail out instead")
+	f.BoolVar(&refreshPrereqs, "refresh-prereqs", false, "probes the prerequisites again instead of using the cached detection")
+	lock := f.Bool("lock-config", false, "records the hash of the configuration in .git/config; the hooks then refuse to run once it is modified until approved again")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	if len(r.modes) == 0 {
+		r.modes = r.config.AllModes()
+	}
+	var prereqReady sync.WaitGroup
+	prereqReady.Add(1)
+	if err := cmdInstall(r.repo, r.config, r.modes, *noUpdate, &prereqReady); err != nil {
+		return err
+	}
+	if *lock {
+		return lockConfig(r.repo, r.configPath, r.configFile)
+	}
+	return nil
+}
+
+func runInstallRun(c *command, args []string) error {
+	r := &repoFlags{}
+	a := &againstFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	a.register(f)
+	noUpdate := f.Bool("n", false, "disallow using go get even if a prerequisite is missing; bail out instead")
+	f.BoolVar(&refreshPrereqs, "refresh-prereqs", false, "probes the prerequisites again instead of using the cached detection")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	against, err := a.revision()
+	if err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	if len(r.modes) == 0 {
+		r.modes = []checks.Mode{checks.PrePush}
+	}
+	// Start running all checks that do not have a prerequisite before
+	// installation is completed.
+	var prereqReady sync.WaitGroup
+	prereqReady.Add(1)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- cmdInstall(r.repo, r.config, r.modes, *noUpdate, &prereqReady)
+	}()
+	err = cmdRun(r.repo, r.config, r.modes, against, &runFlags{}, &prereqReady)
+	if err2 := <-errCh; err2 != nil {
+		return err2
+	}
+	return err
+}
+
+func runMigrateConfig(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdMigrateConfig(r.repo, r.configPath)
+}
+
+func runPrereq(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	noUpdate := f.Bool("n", false, "disallow using go get even if a prerequisite is missing; bail out instead")
+	f.BoolVar(&refreshPrereqs, "refresh-prereqs", false, "probes the prerequisites again instead of using the cached detection")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if f.NArg() > 1 {
+		return usageErrorf("prereq accepts at most one subcommand")
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	if len(r.modes) == 0 {
+		r.modes = r.config.AllModes()
+	}
+	switch f.Arg(0) {
+	case "":
+		return cmdInstallPrereq(processSession(), r.repo, r.config, r.modes, *noUpdate)
+	case "list":
+		return cmdListPrereq(r.repo, r.config, r.modes)
+	case "outdated":
+		return cmdOutdatedPrereq(r.repo, r.config, r.modes)
+	default:
+		return usageErrorf("unknown prereq subcommand \"%s\"; supported are list and outdated", f.Arg(0))
+	}
+}
+
+func runRun(c *command, args []string) error {
+	r := &repoFlags{}
+	a := &againstFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	a.register(f)
+	pr := f.Int("pr", 0, "runs checks on this pull request, fetched from the forge configured in pre-commit-go.yml")
+	post := f.Bool("post", false, "posts the results back on the pull request specified with -pr")
+	rev := f.String("rev", "", "runs checks on the files modified in this revision range, e.g. HEAD~1..HEAD, on the tree of its head")
+	onlyChanged := f.Bool("only-changed", false, "scopes all the checks to the modified packages and their reverse dependencies; see only_changed in CONFIGURATION.md")
+	files := f.Bool("files", false, "runs checks only on the files specified as arguments; use - to read the list from stdin, one per line")
+	shardFlag := f.String("shard", "", "runs only the part N/M of the checks and of the packages tested, to split the run across M parallel CI jobs")
+	failFast := f.Bool("fail-fast", false, "cancels the other checks as soon as one fails; see fail_fast in CONFIGURATION.md")
+	jobs := f.Int("jobs", 0, "maximum number of checks running concurrently; overrides max_parallel, see CONFIGURATION.md")
+	rf := &runFlags{}
+	rf.register(f)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	against, err := a.revision()
+	if err != nil {
+		return err
+	}
+	if *files && (*pr != 0 || *rev != "" || against != "") {
+		return usageErrorf("-files can't be used with -a, -r, -pr or -rev")
+	}
+	if !*files && f.NArg() != 0 {
+		return usageErrorf("unexpected arguments %s; use -files to specify files", f.Args())
+	}
+	if *pr != 0 && against != "" {
+		return usageErrorf("-a or -r can't be used with -pr")
+	}
+	if *pr == 0 && *post {
+		return usageErrorf("-post can only be used with -pr")
+	}
+	if *rev != "" && (*pr != 0 || against != "") {
+		return usageErrorf("-rev can't be used with -a, -r or -pr")
+	}
+	if (rf.interactive || rf.profile) && (*pr != 0 || *rev != "") {
+		return usageErrorf("-i and -profile can't be used with -pr or -rev")
+	}
+	if rf.dryRun && (*pr != 0 || *rev != "") {
+		return usageErrorf("-dry-run can't be used with -pr or -rev")
+	}
+	if rf.dryRun && (rf.interactive || rf.profile) {
+		return usageErrorf("-dry-run can't be used with -i or -profile")
+	}
+	if rf.format != "text" && rf.format != "json" && rf.format != "ndjson" && rf.format != "sarif" && rf.format != "html" {
+		return usageErrorf("invalid -format \"%s\", expected text, json, ndjson, sarif or html", rf.format)
+	}
+	if rf.output != "" && rf.format == "text" {
+		return usageErrorf("-output requires -format")
+	}
+	if rf.format != "text" && (*pr != 0 || *rev != "" || rf.interactive || rf.profile || rf.dryRun) {
+		return usageErrorf("-format can't be used with -pr, -rev, -i, -profile or -dry-run")
+	}
+	if rf.format != "text" && rf.output == "" {
+		// The warnings printed while loading the configuration and running the
+		// checks go to stderr.
+		rf.stdout = os.Stdout
+		os.Stdout = os.Stderr
+		defer func() {
+			os.Stdout = rf.stdout
+		}()
+	}
+	if err := setColor(rf.color); err != nil {
+		return configError(err)
+	}
+	if rf.notify {
+		desktopNotification = true
+	}
+	if rf.full {
+		fullOutput = true
+	}
+	if rf.noCache {
+		useCache = false
+	}
+	if rf.quiet && rf.summary {
+		return usageErrorf("-quiet can't be used with -summary")
+	}
+	if rf.quiet {
+		outputLevel = outputQuiet
+		showProgress = false
+	} else if rf.summary {
+		outputLevel = outputSummary
+	}
+	if rf.interactive && !isTerminal(os.Stdin) {
+		return usageErrorf("-i requires a terminal")
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	if len(r.modes) == 0 {
+		r.modes = []checks.Mode{checks.PrePush}
+	}
+	if *shardFlag != "" {
+		if shard, err = checks.ParseShard(*shardFlag); err != nil {
+			return configError(err)
+		}
+	}
+	if *failFast {
+		setFailFast(r.config, r.modes)
+	}
+	if *onlyChanged {
+		setOnlyChanged(r.config, r.modes)
+	}
+	if *jobs > 0 {
+		setMaxParallel(r.config, r.modes, *jobs)
+	}
+	if *pr != 0 {
+		return cmdRunPR(r.repo, r.config, r.modes, *pr, *post)
+	}
+	if *rev != "" {
+		return cmdRunRev(r.repo, r.config, r.modes, *rev)
+	}
+	if *files {
+		list := f.Args()
+		if len(list) == 1 && list[0] == "-" {
+			if list, err = readFileList(os.Stdin); err != nil {
+				return err
+			}
+		}
+		cwd, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		if list, err = relToRoot(r.repo.Root(), cwd, list); err != nil {
+			return err
+		}
+		return cmdRunFiles(r.repo, r.config, r.modes, list, rf)
+	}
+	return cmdRun(r.repo, r.config, r.modes, against, rf, &sync.WaitGroup{})
+}
+
+func runFix(c *command, args []string) error {
+	r := &repoFlags{}
+	a := &againstFlags{}
+	f := c.flagSet()
+	r.register(f, true)
+	a.register(f)
+	stage := f.Bool("stage", false, "adds the fixed files to the index")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	against, err := a.revision()
+	if err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	if len(r.modes) == 0 {
+		r.modes = []checks.Mode{checks.PrePush}
+	}
+	return cmdFix(r.repo, r.config, r.modes, against, *stage)
+}
+
+func runStats(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	n := f.Int("n", 20, "number of the last profiled runs to consider; 0 for all")
+	top := f.Int("top", 10, "number of checks and packages to print; 0 for all")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdStats(r.repo, *n, *top)
+}
+
+func runRunHook(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	noUpdate := f.Bool("n", false, "disallow using go get even if a prerequisite is missing; bail out instead")
+	f.BoolVar(&refreshPrereqs, "refresh-prereqs", false, "probes the prerequisites again instead of using the cached detection")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if f.NArg() == 0 {
+		return usageErrorf("run-hook is only meant to be used by hooks")
+	}
+	if err := r.initLogs(); err != nil {
+		return err
+	}
+	if forwarded, err := forwardToDaemon(f.Arg(0), f.Args()[1:], *noUpdate); forwarded {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	if err := verifyConfig(r.repo, r.configPath, r.configFile); err != nil {
+		return configError(err)
+	}
+	return cmdRunHook(processSession(), r.repo, r.config, f.Arg(0), f.Args()[1:], *noUpdate)
+}
+
+func runSchema(c *command, args []string) error {
+	f := c.flagSet()
+	output := f.String("o", "", "writes the schema to this file instead of stdout")
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(checks.Schema(), "", "  ")
+	if err != nil {
+		return fmt.Errorf("internal error when marshaling schema: %s", err)
+	}
+	content = append(content, '\n')
+	if *output != "" {
+		return ioutil.WriteFile(*output, content, 0666)
+	}
+	_, err = os.Stdout.Write(content)
+	return err
+}
+
+func runSelfTest(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	// The hook runs "pcg", make sure it is this executable that is used.
+	exe, err := exec.LookPath(os.Args[0])
+	if err != nil {
+		return environmentError(err)
+	}
+	return cmdSelfTest(r.repo, r.config, r.configFile, exe)
+}
+
+func runUninstall(c *command, args []string) error {
+	r := &repoFlags{}
+	f := c.flagSet()
+	r.register(f, false)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	return cmdUninstall(r.repo)
+}
+
+func runVersion(c *command, args []string) error {
+	f := c.flagSet()
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	fmt.Printf("pcg %s\n", version)
+	fmt.Printf("commit: %s\n", orUnknown(commit))
+	fmt.Printf("build date: %s\n", orUnknown(buildDate))
+	fmt.Printf("go: %s\n", runtime.Version())
+	fmt.Printf("min config version: %s\n", minConfigVersion)
+	return nil
+}
+
+func runWriteConfig(c *command, args []string) error {
+	r := &repoFlags{asWritten: true}
+	f := c.flagSet()
+	r.register(f, false)
+	if err := c.parse(f, args); err != nil {
+		return err
+	}
+	if err := r.load(); err != nil {
+		return err
+	}
+	// Note that in that case, configFile is ignored and not overritten.
+	return cmdWriteConfig(r.repo, r.config, r.configPath)
+}
+
+// commands is the list of all supported commands.
+var commands []*command
+
+func init() {
+	commands = []*command{
+		{"baseline", nil, "records the current lint findings so only new ones are reported", runBaseline},
+		{"bench", nil, "runs the benchmarks of the modified packages and records them as the baseline", runBench},
+		{"clean", nil, "removes the state kept by pcg and the temporary files left behind", runClean},
+		{"daemon", nil, "keeps a warm process serving the hooks of the repository", runDaemon},
+		{"explain", nil, "prints the details of a check, including the commands it would run", runExplain},
+		{"fix", []string{"f"}, "fixes the issues reported by the checks that can, then runs all enabled checks", runFix},
+		{"help", []string{"-help", "-h"}, "prints the help page, or the flags of a command with 'help <command>'", runHelp},
+		{"info", nil, "prints the current configuration used", runInfo},
+		{"init", nil, "inspects the repository and writes a tailored pre-commit-go.yml", runInit},
+		{"install", []string{"i"}, "runs 'prereq' then installs the git commit hooks", runInstall},
+		{"installrun", nil, "runs 'prereq', 'install' then 'run'", runInstallRun},
+		{"migrate-config", nil, "upgrades the configuration file written for an older version to the current format", runMigrateConfig},
+		{"prereq", []string{"p"}, "installs prerequisites as applicable for the enabled checks", runPrereq},
+		{"run", []string{"r"}, "runs all enabled checks", runRun},
+		{"run-hook", nil, "used by the installed git hooks exclusively", runRunHook},
+		{"schema", nil, "prints the JSON Schema of pre-commit-go.yml", runSchema},
+		{"selftest", nil, "verifies the pre-commit hook blocks bad commits in a temporary clone", runSelfTest},
+		{"stats", nil, "prints the slowest checks and packages of the runs profiled with 'run -profile'", runStats},
+		{"uninstall", nil, "removes the git hooks installed by 'install' and restores the hooks they replaced", runUninstall},
+		{"version", nil, "prints the tool version and build information", runVersion},
+		{"writeconfig", []string{"w"}, "writes (or rewrite) a pre-commit-go.yml", runWriteConfig},
+	}
+}
+
+// findCommand returns the command by name or alias, nil if not found.
+func findCommand(name string) *command {
+	for _, c := range commands {
+		if c.name == name {
+			return c
+		}
+		for _, a := range c.aliases {
+			if a == name {
+				return c
+			}
+		}
+	}
+	return nil
+}
+
+// mainImpl implements pcg.
+func mainImpl(args []string) error {
+	if len(args) == 0 {
+		if checks.IsContinuousIntegration() {
+			args = []string{"run-hook", "continuous-integration"}
+		} else {
+			args = []string{"installrun"}
+		}
+	}
+	log.SetFlags(log.Lmicroseconds)
+	c := findCommand(args[0])
+	if c == nil {
+		return usageErrorf("unknown command, try 'help'")
+	}
+	return c.run(c, args[1:])
+}
+
+func main() {
+	defer func() {
+		// Don't let a crash be confused with a configuration error, which also
+		// exits with 2.
+		if v := recover(); v != nil {
+			fmt.Fprintf(os.Stderr, "pcg: internal error: %v\n%s", v, debug.Stack())
+			os.Exit(exitInternalError)
+		}
+	}()
+	if err := mainImpl(os.Args[1:]); err != nil {
+		log.Printf("exiting: %s", err)
+		if err != errSilent {
+			fmt.Fprintf(os.Stderr, "pcg: %s\n", err)
+		}
+		os.Exit(exitCode(err))
 	}
 }
@@ -5,11 +5,22 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
 	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
 )
 
 func TestProcessModes(t *testing.T) {
@@ -26,11 +37,379 @@ func TestProcessModes(t *testing.T) {
 		{"slow", []checks.Mode{checks.PrePush}, nil},
 		{"ci", []checks.Mode{checks.ContinuousIntegration}, nil},
 		{"full", []checks.Mode{checks.ContinuousIntegration}, nil},
+		{"commit-msg", []checks.Mode{checks.CommitMsg}, nil},
+		{"nightly", []checks.Mode{"nightly"}, nil},
+		{"pc,nightly", []checks.Mode{checks.PreCommit, "nightly"}, nil},
 		{"foo", nil, errors.New("invalid mode \"foo\"\n\n" + helpModes)},
 	}
+	config := checks.New(version)
+	config.Modes["nightly"] = checks.Settings{}
 	for i, line := range data {
-		actual, err := processModes(line.in)
+		actual, err := processModes(line.in, config)
 		ut.AssertEqualIndex(t, i, line.expected, actual)
 		ut.AssertEqualIndex(t, i, line.err, err)
 	}
 }
+
+func TestFindCommand(t *testing.T) {
+	ut.AssertEqual(t, "run", findCommand("run").name)
+	ut.AssertEqual(t, "run", findCommand("r").name)
+	ut.AssertEqual(t, "help", findCommand("-h").name)
+	ut.AssertEqual(t, (*command)(nil), findCommand("foo"))
+}
+
+func TestMainImplErrors(t *testing.T) {
+	ut.AssertEqual(t, usageErrorf("unknown command, try 'help'"), mainImpl([]string{"foo"}))
+	ut.AssertEqual(t, usageErrorf("-a can't be used with -r"), mainImpl([]string{"run", "-a", "-r", "HEAD"}))
+	ut.AssertEqual(t, usageErrorf("-post can only be used with -pr"), mainImpl([]string{"run", "-post"}))
+	ut.AssertEqual(t, usageErrorf("-rev can't be used with -a, -r or -pr"), mainImpl([]string{"run", "-a", "-rev", "HEAD~1..HEAD"}))
+	ut.AssertEqual(t, usageErrorf("-i and -profile can't be used with -pr or -rev"), mainImpl([]string{"run", "-profile", "-rev", "HEAD~1..HEAD"}))
+	ut.AssertEqual(t, usageErrorf("-files can't be used with -a, -r, -pr or -rev"), mainImpl([]string{"run", "-files", "-r", "HEAD", "a.go"}))
+	ut.AssertEqual(t, usageErrorf("unexpected arguments [a.go]; use -files to specify files"), mainImpl([]string{"run", "a.go"}))
+	ut.AssertEqual(t, usageErrorf("help accepts at most one command"), mainImpl([]string{"help", "run", "info"}))
+	ut.AssertEqual(t, usageErrorf("run-hook is only meant to be used by hooks"), mainImpl([]string{"run-hook"}))
+}
+
+func TestCommandParseError(t *testing.T) {
+	// Not parallel since it redirects os.Stderr.
+	r, w, err := os.Pipe()
+	ut.AssertEqual(t, nil, err)
+	old := os.Stderr
+	os.Stderr = w
+	c := &command{name: "foo", description: "does foo"}
+	f := c.flagSet()
+	f.Bool("bar", false, "enables bar")
+	err = c.parse(f, []string{"-baz"})
+	os.Stderr = old
+	ut.AssertEqual(t, nil, w.Close())
+	out, _ := ioutil.ReadAll(r)
+	// The error is only printed by main.
+	ut.AssertEqual(t, configError(errors.New("flag provided but not defined: -baz")), err)
+	ut.AssertEqual(t, "usage: pcg foo [flags]\n\ndoes foo\n\nFlags:\n  -bar\n    \tenables bar\n", string(out))
+}
+
+func TestCompareVersions(t *testing.T) {
+	data := []struct {
+		a, b     []int
+		expected int
+	}{
+		{[]int{0, 4, 7}, []int{0, 4, 7}, 0},
+		{[]int{3, 0}, []int{3, 0, 0}, 0},
+		{[]int{0, 4, 7}, []int{0, 5}, -1},
+		{[]int{1}, []int{0, 9, 9}, 1},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, compareVersions(line.a, line.b))
+	}
+}
+
+func TestCheckMinVersion(t *testing.T) {
+	ut.AssertEqual(t, nil, checkMinVersion("p", &checks.Config{}))
+	ut.AssertEqual(t, nil, checkMinVersion("p", &checks.Config{MinVersion: version}))
+	ut.AssertEqual(t, errors.New("p: invalid min_version \"a.b\""), checkMinVersion("p", &checks.Config{MinVersion: "a.b"}))
+	expected := errors.New("p requires pcg 999.0 or later but this is pcg " + version + "; upgrade with:\n  go get -u github.com/maruel/pre-commit-go/cmd/pcg")
+	ut.AssertEqual(t, expected, checkMinVersion("p", &checks.Config{MinVersion: "999.0"}))
+}
+
+func TestCheckUnknownKeys(t *testing.T) {
+	t.Parallel()
+	content := []byte("modes:\n  pre-commit:\n    checks:\n      golint:\n      - extra_arg: []\n")
+	ut.AssertEqual(t, nil, checkUnknownKeys("p", []byte("modes: {}\n"), &checks.Config{}))
+	expected := errors.New("p: fix the keys below or set allow_unknown_keys: true\n  modes.pre-commit.checks.golint[0].extra_arg: unknown key")
+	ut.AssertEqual(t, expected, checkUnknownKeys("p", content, &checks.Config{}))
+	ut.AssertEqual(t, nil, checkUnknownKeys("p", content, &checks.Config{AllowUnknownKeys: true}))
+}
+
+func TestApplyProfiles(t *testing.T) {
+	t.Parallel()
+	config := &checks.Config{
+		Modes: map[checks.Mode]checks.Settings{
+			checks.PreCommit: {Checks: checks.Checks{"test": {&checks.Test{}}}},
+		},
+		Profiles: map[string]*checks.Profile{
+			"quick": {Checks: map[string]map[interface{}]interface{}{"test": {"extra_args": []interface{}{"-short"}}}},
+			"race":  {Checks: map[string]map[interface{}]interface{}{"test": {"timeout": 600}}},
+		},
+	}
+	ut.AssertEqual(t, nil, applyProfiles(config, ""))
+	ut.AssertEqual(t, nil, applyProfiles(config, "quick, race"))
+	ut.AssertEqual(t, checks.Checks{"test": {&checks.Test{Limits: checks.Limits{Timeout: 600}, ExtraArgs: []string{"-short"}}}}, config.Modes[checks.PreCommit].Checks)
+	ut.AssertEqual(t, errors.New("unknown profile \"foo\""), applyProfiles(config, "foo"))
+}
+
+func TestEvalRange(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	gitRun := func(args ...string) string {
+		out, code, err := internal.Capture(td, nil, append([]string{"git", "-c", "user.email=nobody@localhost", "-c", "user.name=nobody"}, args...)...)
+		ut.AssertEqualf(t, 0, code, "%s", out)
+		ut.AssertEqual(t, nil, err)
+		return strings.TrimSpace(out)
+	}
+	gitRun("init", "-q")
+	gitRun("commit", "-q", "--allow-empty", "-m", "first")
+	first := scm.Commit(gitRun("rev-parse", "HEAD"))
+	gitRun("commit", "-q", "--allow-empty", "-m", "second")
+	second := scm.Commit(gitRun("rev-parse", "HEAD"))
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+
+	head, old, err := evalRange(repo, "HEAD~1..HEAD")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, second, head)
+	ut.AssertEqual(t, first, old)
+	head, old, err = evalRange(repo, "HEAD~1..")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, second, head)
+	ut.AssertEqual(t, first, old)
+	head, old, err = evalRange(repo, "HEAD...HEAD~1")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, first, head)
+	ut.AssertEqual(t, first, old)
+	_, _, err = evalRange(repo, "HEAD")
+	ut.AssertEqual(t, errors.New("invalid revision range \"HEAD\", expected <base>..<head>"), err)
+	_, _, err = evalRange(repo, "invalid..HEAD")
+	ut.AssertEqual(t, errors.New("couldn't evaluate invalid"), err)
+}
+
+func TestPushBase(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	gitRun := func(args ...string) string {
+		out, code, err := internal.Capture(td, nil, append([]string{"git", "-c", "user.email=nobody@localhost", "-c", "user.name=nobody"}, args...)...)
+		ut.AssertEqualf(t, 0, code, "%s", out)
+		ut.AssertEqual(t, nil, err)
+		return strings.TrimSpace(out)
+	}
+	gitRun("init", "-q")
+	gitRun("commit", "-q", "--allow-empty", "-m", "first")
+	first := scm.Commit(gitRun("rev-parse", "HEAD"))
+	gitRun("commit", "-q", "--allow-empty", "-m", "second")
+	second := scm.Commit(gitRun("rev-parse", "HEAD"))
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+
+	// New ref on a remote without anything pushed yet.
+	base, err := pushBase(repo, second, gitNilCommit)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, scm.GitInitialCommit, base)
+	// Fast forward.
+	base, err = pushBase(repo, second, first)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, first, base)
+	// Rewind.
+	base, err = pushBase(repo, first, second)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, first, base)
+
+	// New ref with the commits already in a remote-tracking branch skipped.
+	gitRun("update-ref", "refs/remotes/origin/master", string(first))
+	base, err = pushBase(repo, second, gitNilCommit)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, first, base)
+	// Unknown remote commit.
+	base, err = pushBase(repo, second, "1111111111111111111111111111111111111111")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, first, base)
+	gitRun("update-ref", "refs/remotes/origin/master", string(second))
+	base, err = pushBase(repo, second, gitNilCommit)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, second, base)
+}
+
+func TestSetOnlyChanged(t *testing.T) {
+	t.Parallel()
+	config := checks.New(version)
+	_, options := config.EnabledChecks([]checks.Mode{checks.PrePush})
+	ut.AssertEqual(t, false, options.OnlyChanged)
+	setOnlyChanged(config, []checks.Mode{checks.PrePush})
+	_, options = config.EnabledChecks([]checks.Mode{checks.PrePush})
+	ut.AssertEqual(t, true, options.OnlyChanged)
+	ut.AssertEqual(t, 15, options.MaxDuration)
+}
+
+func TestReadFileList(t *testing.T) {
+	t.Parallel()
+	files, err := readFileList(strings.NewReader("a.go\n\n  b/c.go \r\n"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"a.go", "b/c.go"}, files)
+}
+
+func TestRelToRoot(t *testing.T) {
+	t.Parallel()
+	root := filepath.Join("/", "repo")
+	files, err := relToRoot(root, filepath.Join(root, "sub"), []string{"a.go", filepath.Join("..", "b.go"), filepath.Join(root, "c", "d.go")})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"sub/a.go", "b.go", "c/d.go"}, files)
+}
+
+func TestCallRunTimeout(t *testing.T) {
+	t.Parallel()
+	if _, err := exec.LookPath("sleep"); err != nil {
+		t.Skip("sleep is not installed")
+	}
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a.go"), []byte("package a\n"), 0600))
+	change, err := repo.Files([]string{"a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	c := &checks.Custom{Limits: checks.Limits{Timeout: 1}, DisplayName: "sleep", Command: []string{"sleep", "30"}, CheckExitCode: true}
+	duration, r := callRun(context.Background(), c, change, &checks.Options{})
+	ut.AssertEqual(t, errors.New("custom timed out after 1s"), r.Err)
+	ut.AssertEqual(t, true, duration < 10*time.Second)
+}
+
+func TestPrintResultsSeverity(t *testing.T) {
+	failure := errors.New("failure")
+	results := []result{
+		{check: &checks.Golint{Limits: checks.Limits{Severity: checks.SeverityWarning}}, err: failure},
+		{check: &checks.Govet{}},
+	}
+	ut.AssertEqual(t, nil, printResults(processSession(), results, &checks.Options{MaxDuration: 10}, time.Second))
+	results = append(results, result{check: &checks.Build{}, err: failure})
+	ut.AssertEqual(t, checksFailed(errors.New("checks failed in 1.00s")), printResults(processSession(), results, &checks.Options{MaxDuration: 10}, time.Second))
+}
+
+func TestResultBlocking(t *testing.T) {
+	t.Parallel()
+	failure := errors.New("failure")
+	warning := checks.Issue{File: "a.go", Message: "bad", Severity: checks.SeverityWarning}
+	bad := checks.Issue{File: "a.go", Message: "bad", Severity: checks.SeverityError}
+	data := []struct {
+		r        result
+		expected bool
+	}{
+		{result{check: &checks.ASTRule{}}, false},
+		{result{check: &checks.ASTRule{}, err: failure}, true},
+		{result{check: &checks.ASTRule{Limits: checks.Limits{Severity: checks.SeverityWarning}}, err: failure}, false},
+		// A check only reporting warnings doesn't fail the run.
+		{result{check: &checks.ASTRule{}, err: failure, issues: []checks.Issue{warning}}, false},
+		{result{check: &checks.ASTRule{}, err: failure, issues: []checks.Issue{warning, bad}}, true},
+	}
+	for i, line := range data {
+		ut.AssertEqualIndex(t, i, line.expected, line.r.blocking())
+	}
+}
+
+func TestWriteResults(t *testing.T) {
+	t.Parallel()
+	results := []result{
+		{check: &checks.Govet{}, duration: time.Second},
+		{check: &checks.Golint{Limits: checks.Limits{Severity: checks.SeverityWarning}}, duration: 2 * time.Second, err: errors.New("golint failed:\na.go:1: bad")},
+		{check: &checks.Build{}, duration: 20 * time.Second},
+	}
+	out := &bytes.Buffer{}
+	ut.AssertEqual(t, nil, writeResults(out, palette{}, outputNormal, results, &checks.Options{MaxDuration: 10}, time.Second))
+	expected := "warning: golint (non-blocking):\ngolint failed:\na.go:1: bad\n" +
+		"warning: check build took 20.00s -> IT IS TOO SLOW (limit: 10s)\n" +
+		"\n" +
+		"  SLOW  build    20.00s\n" +
+		"  WARN  golint    2.00s\n" +
+		"  PASS  govet     1.00s\n" +
+		"1 non-blocking check(s) failed\n"
+	ut.AssertEqual(t, expected, out.String())
+
+	results = []result{
+		{check: &checks.Govet{}, duration: time.Second},
+		{check: &checks.Build{}, err: errors.New("build failed:\nboom")},
+	}
+	out.Reset()
+	ut.AssertEqual(t, checksFailed(errors.New("checks failed in 1.00s")), writeResults(out, palette{enabled: true}, outputNormal, results, &checks.Options{MaxDuration: 10}, time.Second))
+	expected = "\x1b[1;31mbuild failed:\x1b[0m\nboom\n" +
+		"\n" +
+		"  \x1b[1;31mFAIL\x1b[0m  build    0.00s\n" +
+		"  \x1b[32mPASS\x1b[0m  govet    1.00s\n"
+	ut.AssertEqual(t, expected, out.String())
+
+	// Nothing is printed when all the checks passed.
+	out.Reset()
+	ut.AssertEqual(t, nil, writeResults(out, palette{enabled: true}, outputNormal, results[:1], &checks.Options{MaxDuration: 10}, time.Second))
+	ut.AssertEqual(t, "", out.String())
+}
+
+func TestWriteResultsLevels(t *testing.T) {
+	t.Parallel()
+	warning := result{check: &checks.Golint{Limits: checks.Limits{Severity: checks.SeverityWarning}}, duration: 2 * time.Second, err: errors.New("golint failed")}
+	slow := result{check: &checks.Build{}, duration: 20 * time.Second}
+	failure := result{check: &checks.Govet{}, duration: time.Second, err: errors.New("govet failed:\na.go:1: bad")}
+	options := &checks.Options{MaxDuration: 10}
+	out := &bytes.Buffer{}
+
+	// Quiet: the warnings are not printed.
+	ut.AssertEqual(t, nil, writeResults(out, palette{}, outputQuiet, []result{warning, slow}, options, time.Second))
+	ut.AssertEqual(t, "", out.String())
+	ut.AssertEqual(t, checksFailed(errors.New("checks failed in 1.00s")), writeResults(out, palette{}, outputQuiet, []result{slow, failure}, options, time.Second))
+	ut.AssertEqual(t, "warning: check build took 20.00s -> IT IS TOO SLOW (limit: 10s)\ngovet failed:\na.go:1: bad\n\n  SLOW  build   20.00s\n  FAIL  govet    1.00s\n", out.String())
+
+	// Summary: only one line per check, even when they all passed.
+	out.Reset()
+	ut.AssertEqual(t, nil, writeResults(out, palette{}, outputSummary, []result{warning, slow}, options, time.Second))
+	ut.AssertEqual(t, "  SLOW  build    20.00s\n  WARN  golint    2.00s\n", out.String())
+	out.Reset()
+	ut.AssertEqual(t, checksFailed(errors.New("checks failed in 1.00s")), writeResults(out, palette{}, outputSummary, []result{failure}, options, time.Second))
+	ut.AssertEqual(t, "  FAIL  govet    1.00s\n", out.String())
+
+	// A cached check has no duration.
+	out.Reset()
+	ut.AssertEqual(t, nil, writeResults(out, palette{}, outputSummary, []result{{check: &checks.Build{}, cached: true}}, options, time.Second))
+	ut.AssertEqual(t, "  PASS  build  cached\n", out.String())
+}
+
+func TestSetColor(t *testing.T) {
+	ut.AssertEqual(t, errors.New("invalid -color \"blue\", expected auto, always or never"), setColor("blue"))
+}
+
+func TestHookContentShellcheck(t *testing.T) {
+	// The emitted hook is a shell script too.
+	if _, err := exec.LookPath("shellcheck"); err != nil {
+		t.Skip("shellcheck is not installed")
+	}
+	f, err := ioutil.TempFile("", "pre-commit-go")
+	ut.AssertEqual(t, nil, err)
+	defer os.Remove(f.Name())
+	_, err = f.WriteString(fmt.Sprintf(hookContent, "pre-commit"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, f.Close())
+	out, err := exec.Command("shellcheck", f.Name()).CombinedOutput()
+	ut.AssertEqualf(t, nil, err, "%s", out)
+}
+
+func TestRunPreCommitRelevantIgnored(t *testing.T) {
+	// Not parallel since the checks are run with the global settings.
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	git := func(args ...string) {
+		_, code, err := internal.Capture(td, nil, append([]string{"git"}, args...)...)
+		ut.AssertEqual(t, 0, code)
+		ut.AssertEqual(t, nil, err)
+	}
+	git("init", "-q")
+	git("config", "user.email", "nobody@localhost")
+	git("config", "user.name", "nobody")
+	ci := filepath.Join(td, ".github", "workflows", "test.yml")
+	ut.AssertEqual(t, nil, os.MkdirAll(filepath.Dir(ci), 0700))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "go.mod"), []byte("module foo\n\ngo 1.20\n"), 0600))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(ci, []byte("go-version: '1.20'\n"), 0600))
+	git("add", ".")
+	git("commit", "-q", "-m", "initial")
+	repo, err := scm.GetRepo(td, "")
+	ut.AssertEqual(t, nil, err)
+
+	// Only the CI file, ignored by the default patterns, is staged. It still
+	// matters to godirective.
+	ut.AssertEqual(t, nil, ioutil.WriteFile(ci, []byte("go-version: '1.21'\n"), 0600))
+	git("add", ".")
+	config := checks.New(version)
+	config.Modes = map[checks.Mode]checks.Settings{
+		checks.PreCommit: {Checks: checks.Checks{"godirective": {&checks.GoDirective{CIFiles: []string{".github/workflows/test.yml"}}}}},
+	}
+	ut.AssertEqual(t, true, runPreCommit(processSession(), repo, config) != nil)
+}
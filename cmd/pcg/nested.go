@@ -0,0 +1,115 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Configurations nested in the subdirectories of the repository.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// findNestedConfigs returns the directories below root containing a
+// configuration file named name, or one of its alternative encodings, relative to root and using "/". The
+// directories matching the ignore patterns, ".git", "testdata" and "vendor"
+// are skipped.
+func findNestedConfigs(root, name string, ignorePatterns scm.IgnorePatterns) []string {
+	var out []string
+	_ = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+		if err != nil || p == root {
+			return nil
+		}
+		if info.IsDir() {
+			base := info.Name()
+			rel, _ := filepath.Rel(root, p)
+			if base == ".git" || base == "testdata" || base == "vendor" || ignorePatterns.Match(rel) {
+				return filepath.SkipDir
+			}
+			if findConfigName(p, name) != "" {
+				out = append(out, filepath.ToSlash(rel))
+			}
+		}
+		return nil
+	})
+	sort.Strings(out)
+	return out
+}
+
+// loadNestedConfigs loads the configuration files named name in the
+// subdirectories of root, each merged over config.
+func loadNestedConfigs(root, name string, config *checks.Config) (map[string]*checks.Config, error) {
+	dirs := findNestedConfigs(root, name, config.IgnorePatterns)
+	if len(dirs) == 0 {
+		return nil, nil
+	}
+	// Each nested configuration is merged over the root one as loaded, i.e. with
+	// its base configuration merged in.
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	var base map[interface{}]interface{}
+	if err := yaml.Unmarshal(content, &base); err != nil {
+		return nil, err
+	}
+	out := make(map[string]*checks.Config, len(dirs))
+	for _, dir := range dirs {
+		d := filepath.Join(root, filepath.FromSlash(dir))
+		pathname := filepath.Join(d, findConfigName(d, name))
+		log.Printf("nested config: %s", pathname)
+		nested, err := loadNestedConfig(pathname, base)
+		if err != nil {
+			return nil, err
+		}
+		out[dir] = nested
+	}
+	return out, nil
+}
+
+// Private stuff.
+
+// loadNestedConfig loads the configuration at pathname, with its own base
+// configuration merged in, merged over base.
+func loadNestedConfig(pathname string, base map[interface{}]interface{}) (*checks.Config, error) {
+	content, err := ioutil.ReadFile(pathname)
+	if err != nil {
+		return nil, err
+	}
+	if content, err = decodeConfig(pathname, content); err != nil {
+		return nil, err
+	}
+	raw, err := newExtendsLoader().load(pathname, content, 0)
+	if err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		if err := yaml.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("%s: %s", pathname, err)
+		}
+	}
+	if content, err = yaml.Marshal(checks.MergeYAML(base, raw)); err != nil {
+		return nil, err
+	}
+	config := &checks.Config{}
+	if err := yaml.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("%s: %s", pathname, err)
+	}
+	if err := checkUnknownKeys(pathname, content, config); err != nil {
+		return nil, err
+	}
+	if err := checkMinVersion(pathname, config); err != nil {
+		return nil, err
+	}
+	config.ExpandEnv(os.LookupEnv)
+	return config, nil
+}
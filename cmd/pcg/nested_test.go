@@ -0,0 +1,52 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestLoadNestedConfigs(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"pre-commit-go.yml":                "ignored: true\n",
+		"svc/a/pre-commit-go.yml":          "modes:\n  pre-commit:\n    checks:\n      golint: null\n",
+		"svc/a/b/pre-commit-go.yml":        "ignore_patterns:\n- foo\n",
+		"svc/a/testdata/pre-commit-go.yml": "",
+		"vendor/x/pre-commit-go.yml":       "",
+		".hidden/pre-commit-go.yml":        "",
+		".git/pre-commit-go.yml":           "",
+	}
+	for name, content := range files {
+		p := filepath.Join(td, filepath.FromSlash(name))
+		ut.AssertEqual(t, nil, os.MkdirAll(filepath.Dir(p), 0700))
+		ut.AssertEqual(t, nil, ioutil.WriteFile(p, []byte(content), 0600))
+	}
+	root := &checks.Config{
+		Modes: map[checks.Mode]checks.Settings{
+			checks.PreCommit: {Checks: checks.Checks{"gofmt": {&checks.Gofmt{}}, "golint": {&checks.Golint{}}}},
+		},
+		IgnorePatterns: []string{".*"},
+	}
+	nested, err := loadNestedConfigs(td, "pre-commit-go.yml", root)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 2, len(nested))
+	ut.AssertEqual(t, checks.Checks{"gofmt": {&checks.Gofmt{}}}, nested["svc/a"].Modes[checks.PreCommit].Checks)
+	ut.AssertEqual(t, []string{".*"}, nested["svc/a"].IgnorePatterns)
+	ut.AssertEqual(t, 2, len(nested["svc/a/b"].Modes[checks.PreCommit].Checks))
+	ut.AssertEqual(t, []string{"foo"}, nested["svc/a/b"].IgnorePatterns)
+
+	// The configuration in .git is the root one, even without ignore patterns.
+	ut.AssertEqual(t, []string{".hidden", "svc/a", "svc/a/b"}, findNestedConfigs(td, "pre-commit-go.yml", nil))
+}
@@ -0,0 +1,171 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Webhook notifications of the results of the continuous integration runs.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// notificationSummary is the summary of a run sent to the webhooks. It is the
+// data of the payload templates.
+type notificationSummary struct {
+	// Repo is the forge repository, e.g. "owner/name", or the name of the
+	// repository directory when no forge is configured.
+	Repo    string        `json:"repo"`
+	Modes   []checks.Mode `json:"modes"`
+	Success bool          `json:"success"`
+	// Failed are the names of the checks that failed the run.
+	Failed []string `json:"failed"`
+	// Duration is in seconds.
+	Duration float64 `json:"duration"`
+}
+
+// defaultSlackTemplate is the text of the Slack message.
+const defaultSlackTemplate = `pre-commit-go {{join .Modes}} on {{.Repo}}: {{if .Success}}passed{{else}}failed{{end}} in {{printf "%1.2f" .Duration}}s{{if .Failed}}: {{join .Failed}}{{end}}`
+
+// notificationTimeout is the maximum duration of a webhook request.
+const notificationTimeout = 10 * time.Second
+
+// notifyResults sends the summary of results to the webhooks of config when
+// modes include the continuous-integration mode. The failures to notify are
+// printed as warnings and don't fail the run.
+func notifyResults(s *session, config *checks.Config, repo scm.ReadOnlyRepo, modes []checks.Mode, results []result, duration time.Duration) {
+	if len(config.Notifications) == 0 || !hasMode(modes, checks.ContinuousIntegration) {
+		return
+	}
+	sum := &notificationSummary{Modes: modes, Success: true, Failed: []string{}, Duration: duration.Seconds()}
+	if config.Forge != nil && config.Forge.Repo != "" {
+		sum.Repo = config.Forge.Repo
+	} else {
+		sum.Repo = filepath.Base(repo.Root())
+	}
+	for _, r := range results {
+		if r.blocking() {
+			sum.Success = false
+			sum.Failed = append(sum.Failed, r.check.GetName())
+		}
+	}
+	client := &http.Client{Timeout: notificationTimeout}
+	for _, n := range config.Notifications {
+		if n.OnlyFailures && sum.Success {
+			continue
+		}
+		if err := notify(client, n, sum); err != nil {
+			fmt.Fprintf(s.stdout, "warning: failed to notify %s: %s\n", redactURL(n.URL), err)
+		}
+	}
+}
+
+// notify POSTs the summary s to the webhook n.
+func notify(client *http.Client, n *checks.Notification, s *notificationSummary) error {
+	if n.URL == "" {
+		return errors.New("url is required")
+	}
+	body, err := notificationPayload(n, s)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(n.URL, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		out, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// notificationPayload returns the body posted to the webhook n.
+func notificationPayload(n *checks.Notification, s *notificationSummary) ([]byte, error) {
+	switch n.Type {
+	case "", "json":
+		if n.Template == "" {
+			return json.Marshal(s)
+		}
+		return executeTemplate(n.Template, s)
+	case "slack":
+		tmpl := n.Template
+		if tmpl == "" {
+			tmpl = defaultSlackTemplate
+		}
+		text, err := executeTemplate(tmpl, s)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(map[string]string{"text": string(text)})
+	}
+	return nil, fmt.Errorf("unsupported notification type \"%s\"", n.Type)
+}
+
+// Private stuff.
+
+// executeTemplate executes the payload template tmpl with s. Besides the
+// builtins, "join" joins a list with ", " and "json" encodes a value as JSON.
+func executeTemplate(tmpl string, s *notificationSummary) ([]byte, error) {
+	funcs := template.FuncMap{
+		"join": func(v interface{}) string {
+			var items []string
+			switch t := v.(type) {
+			case []string:
+				items = t
+			case []checks.Mode:
+				for _, m := range t {
+					items = append(items, string(m))
+				}
+			}
+			return strings.Join(items, ", ")
+		},
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}
+	t, err := template.New("payload").Funcs(funcs).Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	b := &bytes.Buffer{}
+	if err := t.Execute(b, s); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// hasMode returns true if mode is in modes.
+func hasMode(modes []checks.Mode, mode checks.Mode) bool {
+	for _, m := range modes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
+// redactURL returns the scheme and host of u, since webhook URLs embed their
+// secret in the path.
+func redactURL(u string) string {
+	if i := strings.Index(u, "://"); i != -1 {
+		if j := strings.IndexByte(u[i+3:], '/'); j != -1 {
+			return u[:i+3+j] + "/..."
+		}
+	}
+	return u
+}
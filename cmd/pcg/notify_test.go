@@ -0,0 +1,61 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestNotificationPayload(t *testing.T) {
+	t.Parallel()
+	s := &notificationSummary{Repo: "owner/name", Modes: []checks.Mode{checks.ContinuousIntegration}, Failed: []string{"golint", "test"}, Duration: 1.5}
+	data := []struct {
+		n        checks.Notification
+		expected string
+	}{
+		{checks.Notification{}, `{"repo":"owner/name","modes":["continuous-integration"],"success":false,"failed":["golint","test"],"duration":1.5}`},
+		{checks.Notification{Type: "slack"}, `{"text":"pre-commit-go continuous-integration on owner/name: failed in 1.50s: golint, test"}`},
+		{checks.Notification{Type: "slack", Template: "{{.Repo}} {{len .Failed}}"}, `{"text":"owner/name 2"}`},
+		{checks.Notification{Type: "json", Template: `{"msg": {{json .Repo}}}`}, `{"msg": "owner/name"}`},
+	}
+	for i, line := range data {
+		out, err := notificationPayload(&line.n, s)
+		ut.AssertEqualIndex(t, i, nil, err)
+		ut.AssertEqualIndex(t, i, line.expected, string(out))
+	}
+	_, err := notificationPayload(&checks.Notification{Type: "irc"}, s)
+	ut.AssertEqual(t, errors.New("unsupported notification type \"irc\""), err)
+}
+
+func TestNotify(t *testing.T) {
+	t.Parallel()
+	var body []byte
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ut.AssertEqual(t, "POST", r.Method)
+		body, _ = ioutil.ReadAll(r.Body)
+		if r.URL.Path == "/bad" {
+			http.Error(w, "no such hook", http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	s := &notificationSummary{Repo: "r", Success: true, Failed: []string{}}
+	ut.AssertEqual(t, nil, notify(ts.Client(), &checks.Notification{URL: ts.URL + "/hook", Type: "slack", Template: "ok"}, s))
+	ut.AssertEqual(t, `{"text":"ok"}`, string(body))
+	ut.AssertEqual(t, errors.New("404 Not Found: no such hook"), notify(ts.Client(), &checks.Notification{URL: ts.URL + "/bad"}, s))
+	ut.AssertEqual(t, errors.New("url is required"), notify(ts.Client(), &checks.Notification{}, s))
+}
+
+func TestRedactURL(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, "https://hooks.slack.com/...", redactURL("https://hooks.slack.com/services/T0/B0/secret"))
+	ut.AssertEqual(t, "https://example.com", redactURL("https://example.com"))
+}
@@ -0,0 +1,78 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Execution plan printed by 'pcg run -dry-run'.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// printPlan prints what running enabledChecks on change would do, without
+// running anything: the files and packages considered after the ignore
+// patterns, then the checks in the order they would be started, unless they
+// depend on another check, with their command lines.
+func printPlan(w io.Writer, modes []checks.Mode, enabledChecks []checks.Check, options *checks.Options, change scm.Change, hist *history, workers int) {
+	names := make([]string, len(modes))
+	for i, m := range modes {
+		names[i] = string(m)
+	}
+	fmt.Fprintf(w, "Modes: %s\n", strings.Join(names, ", "))
+	if change == nil {
+		fmt.Fprintf(w, "No change, nothing would run.\n")
+		return
+	}
+	printList(w, "Modified files", change.Changed().Files())
+	printList(w, "Modified packages", change.Changed().Packages())
+	printList(w, "Packages affected, including the ones importing a modified package", change.Indirect().Packages())
+	if options.OnlyChanged {
+		fmt.Fprintf(w, "only_changed is enabled.\n")
+	}
+	eta := hist.schedule(enabledChecks, workers)
+	fmt.Fprintf(w, "%d checks, started in this order on %d workers; estimated %1.2fs:\n", len(enabledChecks), workers, eta.Seconds())
+	for _, c := range enabledChecks {
+		header := fmt.Sprintf("  %s (~%1.2fs):", c.GetName(), hist.estimate(c).Seconds())
+		if deps := dependencies(c); len(deps) != 0 {
+			header = fmt.Sprintf("  %s (~%1.2fs, after %s):", c.GetName(), hist.estimate(c).Seconds(), strings.Join(deps, ", "))
+		}
+		scoped := checkScope(c, change)
+		if scoped == nil {
+			fmt.Fprintf(w, "%s no modified file in its paths\n", header)
+			continue
+		}
+		cmder, ok := c.(checks.Commander)
+		if !ok {
+			fmt.Fprintf(w, "%s in process\n", header)
+			continue
+		}
+		cmds := cmder.Commands(scoped, options)
+		if len(cmds) == 0 {
+			fmt.Fprintf(w, "%s nothing to run\n", header)
+			continue
+		}
+		fmt.Fprintf(w, "%s\n", header)
+		for _, cmd := range cmds {
+			fmt.Fprintf(w, "      %s\n", strings.Join(cmd, " "))
+		}
+	}
+}
+
+// Private stuff.
+
+func printList(w io.Writer, title string, items []string) {
+	if len(items) == 0 {
+		fmt.Fprintf(w, "%s: none\n", title)
+		return
+	}
+	fmt.Fprintf(w, "%s:\n", title)
+	for _, i := range items {
+		fmt.Fprintf(w, "  %s\n", i)
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestPrintPlan(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	ut.AssertEqual(t, nil, os.MkdirAll(filepath.Join(td, "a"), 0700))
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "a", "a.go"), []byte("package a\n"), 0600))
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	change, err := repo.Files([]string{"a/a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+
+	hist := &history{Durations: map[string]float64{historyKey(&checks.Build{}): 2, historyKey(&checks.Copyright{}): 1}}
+	enabled := []checks.Check{&checks.Copyright{}, &checks.Build{}, &checks.Shellcheck{}}
+	out := &bytes.Buffer{}
+	modes := []checks.Mode{checks.PreCommit}
+	printPlan(out, modes, enabled, &checks.Options{}, change, hist, 2)
+	expected := "Modes: pre-commit\n" +
+		"Modified files:\n  a/a.go\n" +
+		"Modified packages:\n  ./a\n" +
+		"Packages affected, including the ones importing a modified package:\n  ./a\n" +
+		"3 checks, started in this order on 2 workers; estimated 3.00s:\n" +
+		"  build (~2.00s):\n      go build -o " + os.DevNull + " ./a\n" +
+		"  shellcheck (~2.00s): nothing to run\n" +
+		"  copyright (~1.00s): in process\n"
+	ut.AssertEqual(t, expected, out.String())
+
+	out.Reset()
+	printPlan(out, modes, enabled, &checks.Options{}, nil, hist, 2)
+	ut.AssertEqual(t, "Modes: pre-commit\nNo change, nothing would run.\n", out.String())
+}
@@ -0,0 +1,281 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Inspection of the prerequisites, for 'pcg prereq list' and 'outdated', and
+// the cache of their detection.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// prereqCacheFile is the name of the file in the scm directory, e.g. .git/,
+// caching the detection of the prerequisites.
+const prereqCacheFile = "pre-commit-go-prereqs.json"
+
+// refreshPrereqs ignores the cached detection of the prerequisites. It is set
+// with -refresh-prereqs.
+var refreshPrereqs = false
+
+// prereqProbe is the cached detection of a prerequisite.
+type prereqProbe struct {
+	// Tool is the toolStamp() of the executable when it was probed.
+	Tool    string `json:"tool"`
+	Present bool   `json:"present"`
+	// Version is empty if the prerequisite is missing or its version wasn't
+	// probed yet.
+	Version string `json:"version,omitempty"`
+}
+
+// prereqCache caches whether the prerequisites are present and their version,
+// so the helper tools are not executed on every run. An entry is reused as
+// long as the executable keeps the same path, size and modification time.
+//
+// A nil *prereqCache probes every time.
+type prereqCache struct {
+	lock   sync.Mutex
+	Probes map[string]*prereqProbe `json:"probes"`
+	// Config is the configStamp() of the configuration the prerequisites and
+	// the hooks were last refreshed for by the post-checkout or post-merge hook.
+	Config string `json:"config,omitempty"`
+	dirty  bool
+}
+
+// loadPrereqCache loads the cache from the scm directory. It never fails; an
+// empty cache is returned if none is found or refreshPrereqs is set.
+func loadPrereqCache(repo scm.ReadOnlyRepo) *prereqCache {
+	c := &prereqCache{Probes: map[string]*prereqProbe{}}
+	if refreshPrereqs {
+		return c
+	}
+	p, err := scmFilePath(repo, prereqCacheFile)
+	if err != nil {
+		return c
+	}
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		return c
+	}
+	if err := json.Unmarshal(content, c); err != nil {
+		log.Printf("ignoring corrupted %s: %s", p, err)
+	}
+	if c.Probes == nil {
+		c.Probes = map[string]*prereqProbe{}
+	}
+	return c
+}
+
+// save writes the cache in the scm directory if it was updated.
+func (c *prereqCache) save(repo scm.ReadOnlyRepo) error {
+	if c == nil {
+		return nil
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if !c.dirty {
+		return nil
+	}
+	p, err := scmFilePath(repo, prereqCacheFile)
+	if err != nil {
+		return err
+	}
+	content, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	c.dirty = false
+	return ioutil.WriteFile(p, append(content, '\n'), 0666)
+}
+
+// isPresent is CheckPrerequisite.IsPresent() using the cache.
+func (c *prereqCache) isPresent(p checks.CheckPrerequisite) bool {
+	if c == nil {
+		return p.IsPresent()
+	}
+	key, stamp := prereqKey(p)
+	c.lock.Lock()
+	probe := c.Probes[key]
+	c.lock.Unlock()
+	if probe != nil && probe.Tool == stamp {
+		return probe.Present
+	}
+	present := p.IsPresent()
+	c.set(key, &prereqProbe{Tool: stamp, Present: present})
+	return present
+}
+
+// version is CheckPrerequisite.Version() using the cache.
+func (c *prereqCache) version(p checks.CheckPrerequisite) string {
+	if c == nil {
+		return p.Version()
+	}
+	key, stamp := prereqKey(p)
+	c.lock.Lock()
+	probe := c.Probes[key]
+	c.lock.Unlock()
+	if probe != nil && probe.Tool == stamp && (!probe.Present || probe.Version != "") {
+		return probe.Version
+	}
+	v := p.Version()
+	c.set(key, &prereqProbe{Tool: stamp, Present: v != "", Version: v})
+	return v
+}
+
+func (c *prereqCache) setConfig(stamp string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.Config != stamp {
+		c.Config = stamp
+		c.dirty = true
+	}
+}
+
+func (c *prereqCache) set(key string, probe *prereqProbe) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.Probes[key] = probe
+	c.dirty = true
+}
+
+// prereqInfo is a prerequisite, where it is installed and the checks
+// requiring it.
+type prereqInfo struct {
+	name   string
+	prereq checks.CheckPrerequisite
+	checks []string
+	// Set by detect().
+	path    string
+	version string
+}
+
+// collectPrereqs returns the prerequisites of enabledChecks sorted by name.
+func collectPrereqs(enabledChecks []checks.Check) []*prereqInfo {
+	m := map[string]*prereqInfo{}
+	for _, c := range enabledChecks {
+		for _, p := range c.GetPrerequisites() {
+			name := prereqName(p)
+			info, ok := m[name]
+			if !ok {
+				info = &prereqInfo{name: name, prereq: p}
+				m[name] = info
+			}
+			if !contains(info.checks, c.GetName()) {
+				info.checks = append(info.checks, c.GetName())
+			}
+		}
+	}
+	out := make([]*prereqInfo, 0, len(m))
+	for _, info := range m {
+		sort.Strings(info.checks)
+		out = append(out, info)
+	}
+	sort.Sort(byPrereqName(out))
+	return out
+}
+
+// detectAll looks up where the prerequisites are installed and their version
+// concurrently.
+func detectAll(infos []*prereqInfo, cache *prereqCache) {
+	var wg sync.WaitGroup
+	for _, info := range infos {
+		wg.Add(1)
+		go func(info *prereqInfo) {
+			defer wg.Done()
+			info.detect(cache)
+		}(info)
+	}
+	wg.Wait()
+}
+
+// detect looks up where the prerequisite is installed and its version.
+func (p *prereqInfo) detect(cache *prereqCache) {
+	if p.version = cache.version(p.prereq); p.version != "" {
+		p.path, _ = exec.LookPath(p.name)
+	}
+}
+
+// printPrereqs prints the prerequisites as a table.
+func printPrereqs(w io.Writer, infos []*prereqInfo) {
+	for _, info := range infos {
+		path := info.path
+		version := info.version
+		if version == "" {
+			path = "<missing>"
+			version = "-"
+		} else if path == "" {
+			path = info.name
+		}
+		fmt.Fprintf(w, "%s:\n  path:        %s\n  version:     %s\n  required by: %s\n", info.name, path, version, strings.Join(info.checks, ", "))
+	}
+}
+
+// outdatedPrereqs returns the prerequisites not matching their pinned
+// version. Unpinned prerequisites are ignored.
+func outdatedPrereqs(infos []*prereqInfo, pins map[string]string) []string {
+	var out []string
+	for _, info := range infos {
+		pin, ok := pins[info.name]
+		if !ok {
+			continue
+		}
+		if info.version == "" {
+			out = append(out, fmt.Sprintf("%s: missing, pinned to %s", info.name, pin))
+		} else if !matchesPin(info.version, pin) {
+			out = append(out, fmt.Sprintf("%s: %s installed, pinned to %s", info.name, info.version, pin))
+		}
+	}
+	return out
+}
+
+// Private stuff.
+
+// prereqName returns the executable of the prerequisite, or its URL if
+// HelpCommand is empty.
+func prereqName(p checks.CheckPrerequisite) string {
+	if len(p.HelpCommand) != 0 {
+		return p.HelpCommand[0]
+	}
+	return p.URL
+}
+
+// prereqKey returns the cache key of a prerequisite and the current stamp of
+// its executable.
+func prereqKey(p checks.CheckPrerequisite) (string, string) {
+	key := fmt.Sprintf("%s\x00%d\x00%s", strings.Join(p.HelpCommand, " "), p.ExpectedExitCode, p.URL)
+	stamp := "missing"
+	if len(p.HelpCommand) != 0 {
+		stamp = toolStamp(p.HelpCommand[0])
+	}
+	return key, stamp
+}
+
+// matchesPin returns true if the version detected, either a module version
+// like "v1.6.3" or a line like "version: 0.9.0", is the pinned one.
+func matchesPin(version, pin string) bool {
+	pin = strings.TrimPrefix(pin, "v")
+	for _, w := range strings.FieldsFunc(version, func(r rune) bool { return r == ' ' || r == ':' || r == ',' }) {
+		if strings.TrimPrefix(w, "v") == pin {
+			return true
+		}
+	}
+	return false
+}
+
+type byPrereqName []*prereqInfo
+
+func (b byPrereqName) Len() int           { return len(b) }
+func (b byPrereqName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byPrereqName) Less(i, j int) bool { return b[i].name < b[j].name }
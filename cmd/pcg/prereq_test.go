@@ -0,0 +1,131 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestCollectPrereqs(t *testing.T) {
+	t.Parallel()
+	tool := checks.CheckPrerequisite{HelpCommand: []string{"tool", "-h"}}
+	enabled := []checks.Check{
+		&checks.Golint{},
+		&checks.Gofmt{},
+		&checks.Custom{Prerequisites: []checks.CheckPrerequisite{tool, {URL: "example.com/nohelp"}}},
+		&checks.Errcheck{},
+		&checks.Errcheck{},
+	}
+	infos := collectPrereqs(enabled)
+	ut.AssertEqual(t, 4, len(infos))
+	ut.AssertEqual(t, "errcheck", infos[0].name)
+	ut.AssertEqual(t, []string{"errcheck"}, infos[0].checks)
+	ut.AssertEqual(t, "example.com/nohelp", infos[1].name)
+	ut.AssertEqual(t, "golint", infos[2].name)
+	ut.AssertEqual(t, "tool", infos[3].name)
+	ut.AssertEqual(t, []string{"custom"}, infos[3].checks)
+}
+
+func TestCmdInstallPrereqMany(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init", "-q")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+	// More missing prerequisites than checks.
+	var prereqs []checks.CheckPrerequisite
+	for _, name := range []string{"pcg-missing-a", "pcg-missing-b", "pcg-missing-c"} {
+		prereqs = append(prereqs, checks.CheckPrerequisite{HelpCommand: []string{name}, URL: "example.com/" + name})
+	}
+	prereqs = append(prereqs, checks.CheckPrerequisite{InstallHint: "install it"})
+	config := &checks.Config{Modes: map[checks.Mode]checks.Settings{
+		checks.PrePush: {Checks: checks.Checks{"custom": {&checks.Custom{Command: []string{"true"}, Prerequisites: prereqs}}}},
+	}}
+	err = cmdInstallPrereq(processSession(), repo, config, []checks.Mode{checks.PrePush}, true)
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestPrintPrereqs(t *testing.T) {
+	t.Parallel()
+	infos := []*prereqInfo{
+		{name: "errcheck", checks: []string{"errcheck"}, path: "/bin/errcheck", version: "v1.6.3"},
+		{name: "shellcheck", checks: []string{"a", "b"}},
+	}
+	out := &bytes.Buffer{}
+	printPrereqs(out, infos)
+	expected := "errcheck:\n  path:        /bin/errcheck\n  version:     v1.6.3\n  required by: errcheck\n" +
+		"shellcheck:\n  path:        <missing>\n  version:     -\n  required by: a, b\n"
+	ut.AssertEqual(t, expected, out.String())
+}
+
+func TestOutdatedPrereqs(t *testing.T) {
+	t.Parallel()
+	infos := []*prereqInfo{
+		{name: "errcheck", version: "v1.6.3"},
+		{name: "golint", version: "v0.1.0"},
+		{name: "shellcheck", version: "version: 0.9.0"},
+		{name: "hadolint"},
+		{name: "goimports", version: "v0.2.0"},
+	}
+	pins := map[string]string{"errcheck": "v1.6.3", "golint": "v0.2.0", "shellcheck": "v0.9.0", "hadolint": "2.12.0"}
+	expected := []string{
+		"golint: v0.1.0 installed, pinned to v0.2.0",
+		"hadolint: missing, pinned to 2.12.0",
+	}
+	ut.AssertEqual(t, expected, outdatedPrereqs(infos, pins))
+}
+
+func TestPrereqCache(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+
+	goVersion := checks.CheckPrerequisite{HelpCommand: []string{"go", "version"}}
+	var nilCache *prereqCache
+	ut.AssertEqual(t, true, nilCache.isPresent(goVersion))
+	ut.AssertEqual(t, nil, nilCache.save(repo))
+
+	c := loadPrereqCache(repo)
+	ut.AssertEqual(t, 0, len(c.Probes))
+	// Nothing probed, nothing written.
+	ut.AssertEqual(t, nil, c.save(repo))
+	ut.AssertEqual(t, true, c.isPresent(goVersion))
+	key, stamp := prereqKey(goVersion)
+	ut.AssertEqual(t, &prereqProbe{Tool: stamp, Present: true}, c.Probes[key])
+	// The version is probed on first use.
+	v := c.version(goVersion)
+	ut.AssertEqual(t, true, v != "")
+	ut.AssertEqual(t, v, c.Probes[key].Version)
+	ut.AssertEqual(t, nil, c.save(repo))
+
+	// The cached probe is used as long as the executable is the same.
+	c = loadPrereqCache(repo)
+	ut.AssertEqual(t, &prereqProbe{Tool: stamp, Present: true, Version: v}, c.Probes[key])
+	c.Probes[key].Present = false
+	c.Probes[key].Version = ""
+	ut.AssertEqual(t, false, c.isPresent(goVersion))
+	ut.AssertEqual(t, "", c.version(goVersion))
+	c.Probes[key].Tool = "other"
+	ut.AssertEqual(t, true, c.isPresent(goVersion))
+
+	missing := checks.CheckPrerequisite{HelpCommand: []string{"pre-commit-go-missing-tool"}}
+	ut.AssertEqual(t, false, c.isPresent(missing))
+	key, _ = prereqKey(missing)
+	ut.AssertEqual(t, &prereqProbe{Tool: "missing"}, c.Probes[key])
+}
@@ -0,0 +1,155 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Live progress of the running checks on a terminal.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+// showProgress enables the live progress of the running checks. It is enabled
+// when stderr is a terminal; verbose logging and the structured output formats
+// disable it.
+var showProgress = isTerminal(os.Stderr) && os.Getenv("TERM") != "dumb"
+
+// progressInterval is how often the progress is redrawn.
+const progressInterval = 200 * time.Millisecond
+
+// liveProgress redraws one line per running check with its elapsed time and
+// the number of packages it processed so far, then the estimated remaining
+// time of the run.
+//
+// A nil *liveProgress does nothing.
+type liveProgress struct {
+	w       io.Writer
+	timings *checks.PackageTimings
+	now     func() time.Time
+	// end is when the run is estimated to complete, zero when unknown.
+	end  time.Time
+	done chan struct{}
+	wg   sync.WaitGroup
+
+	lock    sync.Mutex
+	running []runningCheck
+	lines   int
+}
+
+// runningCheck is a check being run.
+type runningCheck struct {
+	check checks.Check
+	start time.Time
+}
+
+// newLiveProgress starts redrawing the progress on w. timings is used to count
+// the packages processed by each check and eta is the estimated duration of
+// the run, 0 if unknown. It returns nil when showProgress is false.
+func newLiveProgress(w io.Writer, timings *checks.PackageTimings, eta time.Duration) *liveProgress {
+	if !showProgress {
+		return nil
+	}
+	p := &liveProgress{w: w, timings: timings, now: time.Now, done: make(chan struct{})}
+	if eta > 0 {
+		p.end = p.now().Add(eta)
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+		t := time.NewTicker(progressInterval)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+				p.redraw()
+			case <-p.done:
+				return
+			}
+		}
+	}()
+	return p
+}
+
+// start adds check to the running checks.
+func (p *liveProgress) start(check checks.Check) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.running = append(p.running, runningCheck{check, p.now()})
+}
+
+// finish removes check from the running checks.
+func (p *liveProgress) finish(check checks.Check) {
+	if p == nil {
+		return
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	for i, r := range p.running {
+		if r.check == check {
+			p.running = append(p.running[:i], p.running[i+1:]...)
+			break
+		}
+	}
+}
+
+// stop stops redrawing and erases the progress.
+func (p *liveProgress) stop() {
+	if p == nil {
+		return
+	}
+	close(p.done)
+	p.wg.Wait()
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.running = nil
+	p.draw()
+}
+
+// Private stuff.
+
+func (p *liveProgress) redraw() {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.draw()
+}
+
+// draw replaces the lines drawn previously with one line per running check
+// and the remaining time. The lock must be held.
+func (p *liveProgress) draw() {
+	out := ""
+	if p.lines != 0 {
+		// Move up to the first line drawn and clear up to the end of the screen.
+		out = fmt.Sprintf("\x1b[%dA\r\x1b[J", p.lines)
+	}
+	now := p.now()
+	for _, r := range p.running {
+		name := r.check.GetName()
+		out += fmt.Sprintf("  %s %5.1fs", name, now.Sub(r.start).Seconds())
+		if n := p.timings.Packages(name); n != 0 {
+			out += fmt.Sprintf(", %d package(s) done", n)
+		}
+		out += "\n"
+	}
+	p.lines = len(p.running)
+	if p.lines != 0 && !p.end.IsZero() {
+		remaining := p.end.Sub(now)
+		if remaining < 0 {
+			remaining = 0
+		}
+		out += fmt.Sprintf("  ETA %5.1fs\n", remaining.Seconds())
+		p.lines++
+	}
+	if out != "" {
+		_, _ = io.WriteString(p.w, out)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestLiveProgress(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	b := &bytes.Buffer{}
+	timings := checks.NewPackageTimings()
+	p := &liveProgress{w: b, timings: timings, now: func() time.Time { return now }}
+	test := &fakeCheck{name: "test"}
+	gofmt := &fakeCheck{name: "gofmt"}
+	p.start(test)
+	p.start(gofmt)
+	now = now.Add(1500 * time.Millisecond)
+	timings.Record("test", "./foo", time.Second)
+	p.draw()
+	ut.AssertEqual(t, "  test   1.5s, 1 package(s) done\n  gofmt   1.5s\n", b.String())
+
+	b.Reset()
+	p.finish(gofmt)
+	p.draw()
+	ut.AssertEqual(t, "\x1b[2A\r\x1b[J  test   1.5s, 1 package(s) done\n", b.String())
+
+	b.Reset()
+	p.finish(test)
+	p.draw()
+	ut.AssertEqual(t, "\x1b[1A\r\x1b[J", b.String())
+
+	// Nothing is left to erase.
+	b.Reset()
+	p.draw()
+	ut.AssertEqual(t, "", b.String())
+
+	// The remaining time is estimated from the history.
+	p.end = now.Add(3 * time.Second)
+	p.start(test)
+	now = now.Add(time.Second)
+	p.draw()
+	ut.AssertEqual(t, "  test   1.0s, 1 package(s) done\n  ETA   2.0s\n", b.String())
+
+	b.Reset()
+	now = now.Add(5 * time.Second)
+	p.draw()
+	ut.AssertEqual(t, "\x1b[2A\r\x1b[J  test   6.0s, 1 package(s) done\n  ETA   0.0s\n", b.String())
+
+	b.Reset()
+	p.finish(test)
+	p.draw()
+	ut.AssertEqual(t, "\x1b[2A\r\x1b[J", b.String())
+}
+
+func TestLiveProgressNil(t *testing.T) {
+	t.Parallel()
+	var p *liveProgress
+	p.start(&fakeCheck{name: "test"})
+	p.finish(&fakeCheck{name: "test"})
+	p.stop()
+}
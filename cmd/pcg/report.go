@@ -0,0 +1,175 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Structured results of a run, printed by 'pcg run -format json' and
+// 'pcg run -format sarif'.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// Check statuses in a report.
+const (
+	statusSuccess = "success"
+	statusFailure = "failure"
+	statusWarning = "warning"
+)
+
+// checkReport is the outcome of a check in a report.
+type checkReport struct {
+	Name string `json:"name"`
+	// Status is one of statusSuccess, statusFailure or statusWarning, the
+	// latter for a non-blocking check that failed.
+	Status string `json:"status"`
+	// Duration is in seconds.
+	Duration float64 `json:"duration"`
+	// Cached is true when the check was skipped because it already passed on
+	// the same content.
+	Cached bool `json:"cached,omitempty"`
+	// Commands are the command lines run, when the check runs external
+	// commands.
+	Commands [][]string `json:"commands,omitempty"`
+	// Output is the error message of the check, including the output of the
+	// commands it ran.
+	Output string `json:"output,omitempty"`
+	// Issues are the locations parsed from Output, when it lists some.
+	Issues []checks.Issue `json:"issues,omitempty"`
+	// Coverage is the coverage per package, when the check measures it.
+	Coverage []packageCoverage `json:"coverage,omitempty"`
+}
+
+// packageCoverage is the coverage of the functions of a package.
+type packageCoverage struct {
+	// Package is the directory of the package, relative to the repository root.
+	Package string `json:"package"`
+	// Covered and Total are numbers of statements.
+	Covered int     `json:"covered"`
+	Total   int     `json:"total"`
+	Percent float64 `json:"percent"`
+}
+
+// report is the outcome of a run.
+type report struct {
+	Modes   []checks.Mode `json:"modes"`
+	Success bool          `json:"success"`
+	// Duration is in seconds.
+	Duration float64       `json:"duration"`
+	Checks   []checkReport `json:"checks"`
+}
+
+// newReport returns the report of results, the checks run on change for
+// modes.
+func newReport(modes []checks.Mode, results []result, options *checks.Options, change scm.Change, duration time.Duration) *report {
+	r := &report{Modes: modes, Success: true, Duration: duration.Seconds(), Checks: []checkReport{}}
+	for _, res := range results {
+		c := checkReport{Name: res.check.GetName(), Status: statusSuccess, Duration: res.duration.Seconds(), Cached: res.cached, Coverage: coverageByPackage(res.coverage)}
+		if cmd, ok := res.check.(checks.Commander); ok && change != nil {
+			if scope := checkScope(res.check, change); scope != nil {
+				c.Commands = cmd.Commands(scope, options.ForCheck(res.check))
+			}
+		}
+		if res.err != nil {
+			c.Status = statusFailure
+			if !res.blocking() {
+				c.Status = statusWarning
+			} else {
+				r.Success = false
+			}
+			c.Output = res.err.Error()
+			if c.Issues = res.issues; c.Issues == nil {
+				// The check doesn't parse its output, e.g. a check implemented outside
+				// of this repository.
+				c.Issues = checks.ParseIssues(c.Output)
+				if isWarning(res.check) {
+					for i := range c.Issues {
+						c.Issues[i].Severity = checks.SeverityWarning
+					}
+				}
+			}
+			if change != nil {
+				// Some tools, e.g. errcheck, print absolute paths.
+				for i := range c.Issues {
+					if f := c.Issues[i].File; filepath.IsAbs(f) {
+						if rel, err := filepath.Rel(change.Repo().Root(), f); err == nil {
+							c.Issues[i].File = filepath.ToSlash(rel)
+						}
+					}
+				}
+			}
+		}
+		r.Checks = append(r.Checks, c)
+	}
+	return r
+}
+
+// write writes the report in format: "json" for a single JSON document,
+// "ndjson" for one JSON document per check, "sarif" for a SARIF log or "html"
+// for a standalone HTML page.
+func (r *report) write(w io.Writer, format string) error {
+	if format == "html" {
+		return r.html(w)
+	}
+	if format != "ndjson" {
+		var v interface{} = r
+		if format == "sarif" {
+			v = r.sarif()
+		}
+		content, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(w, "%s\n", content)
+		return err
+	}
+	e := json.NewEncoder(w)
+	for _, c := range r.Checks {
+		if err := e.Encode(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Private stuff.
+
+// coverageByPackage sums profile per package directory, sorted by directory.
+func coverageByPackage(profile checks.CoverageProfile) []packageCoverage {
+	var out []packageCoverage
+	index := map[string]int{}
+	for _, f := range profile {
+		pkg := path.Dir(f.Source)
+		i, ok := index[pkg]
+		if !ok {
+			i = len(out)
+			index[pkg] = i
+			out = append(out, packageCoverage{Package: pkg})
+		}
+		out[i].Covered += f.Covered
+		out[i].Total += f.Total
+	}
+	for i := range out {
+		if out[i].Total != 0 {
+			out[i].Percent = 100. * float64(out[i].Covered) / float64(out[i].Total)
+		}
+	}
+	sort.Sort(packageCoverages(out))
+	return out
+}
+
+type packageCoverages []packageCoverage
+
+func (p packageCoverages) Len() int           { return len(p) }
+func (p packageCoverages) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p packageCoverages) Less(i, j int) bool { return p[i].Package < p[j].Package }
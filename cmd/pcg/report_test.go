@@ -0,0 +1,81 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+	results := []result{
+		{check: &fakeCheck{name: "ok"}, duration: time.Second},
+		{check: &fakeCheck{name: "broken"}, duration: 2 * time.Second, err: errors.New("broken failed:\na.go:1: bad")},
+	}
+	r := newReport([]checks.Mode{checks.PrePush}, results, &checks.Options{}, nil, 3*time.Second)
+	expected := &report{
+		Modes:    []checks.Mode{checks.PrePush},
+		Duration: 3,
+		Checks: []checkReport{
+			{Name: "ok", Status: statusSuccess, Duration: 1},
+			{Name: "broken", Status: statusFailure, Duration: 2, Output: "broken failed:\na.go:1: bad", Issues: []checks.Issue{{File: "a.go", Line: 1, Message: "bad", Severity: checks.SeverityError}}},
+		},
+	}
+	ut.AssertEqual(t, expected, r)
+
+	out := &bytes.Buffer{}
+	ut.AssertEqual(t, nil, r.write(out, "ndjson"))
+	ut.AssertEqual(t, "{\"name\":\"ok\",\"status\":\"success\",\"duration\":1}\n{\"name\":\"broken\",\"status\":\"failure\",\"duration\":2,\"output\":\"broken failed:\\na.go:1: bad\",\"issues\":[{\"file\":\"a.go\",\"line\":1,\"message\":\"bad\",\"severity\":\"error\"}]}\n", out.String())
+	out.Reset()
+	ut.AssertEqual(t, nil, r.write(out, "json"))
+	ut.AssertEqual(t, true, bytes.HasPrefix(out.Bytes(), []byte("{\n  \"modes\": [\n    \"pre-push\"\n  ],\n  \"success\": false,\n")))
+}
+
+func TestReportSARIF(t *testing.T) {
+	t.Parallel()
+	r := &report{
+		Checks: []checkReport{
+			{Name: "golint", Status: statusWarning, Output: "golint failed:\na.go:1:2: bad", Issues: []checks.Issue{{File: "a.go", Line: 1, Column: 2, Message: "bad", Severity: checks.SeverityWarning}}},
+			{Name: "test", Status: statusFailure, Output: "tests failed"},
+		},
+	}
+	s := r.sarif()
+	ut.AssertEqual(t, "2.1.0", s.Version)
+	ut.AssertEqual(t, 1, len(s.Runs))
+	ut.AssertEqual(t, []sarifRule{{ID: "golint", ShortDescription: sarifMessage{checks.KnownChecks["golint"]().GetDescription()}, Help: sarifMessage{checks.Remediation("golint")}}}, s.Runs[0].Tool.Driver.Rules)
+	expected := []sarifResult{
+		{
+			RuleID:  "golint",
+			Level:   "warning",
+			Message: sarifMessage{"bad"},
+			Locations: []sarifLocation{{sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: "a.go", URIBaseID: "%SRCROOT%"},
+				Region:           &sarifRegion{StartLine: 1, StartColumn: 2},
+			}}},
+		},
+	}
+	ut.AssertEqual(t, expected, s.Runs[0].Results)
+}
+
+func TestCoverageByPackage(t *testing.T) {
+	t.Parallel()
+	profile := checks.CoverageProfile{
+		{Source: "foo/b.go", Covered: 1, Total: 2},
+		{Source: "a.go", Covered: 0, Total: 0},
+		{Source: "foo/a.go", Covered: 2, Total: 2},
+	}
+	expected := []packageCoverage{
+		{Package: ".", Covered: 0, Total: 0},
+		{Package: "foo", Covered: 3, Total: 4, Percent: 75},
+	}
+	ut.AssertEqual(t, expected, coverageByPackage(profile))
+	ut.AssertEqual(t, []packageCoverage(nil), coverageByPackage(nil))
+}
@@ -0,0 +1,114 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// SARIF 2.1.0 output, e.g. for GitHub Code Scanning.
+
+package main
+
+import "github.com/maruel/pre-commit-go/checks"
+
+// sarifSchema is the JSON schema of the SARIF 2.1.0 format.
+const sarifSchema = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+	Help             sarifMessage `json:"help"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI       string `json:"uri"`
+	URIBaseID string `json:"uriBaseId"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// sarif returns the issues of the report as a SARIF log. Each check is a
+// rule and each issue a result; the failures without any issue parsed, e.g. a
+// failed test, have no location to report and are skipped.
+func (r *report) sarif() *sarifLog {
+	run := sarifRun{
+		Tool: sarifTool{Driver: sarifDriver{
+			Name:           "pcg",
+			Version:        version,
+			InformationURI: "https://github.com/maruel/pre-commit-go",
+			Rules:          []sarifRule{},
+		}},
+		Results: []sarifResult{},
+	}
+	for _, c := range r.Checks {
+		if len(c.Issues) == 0 {
+			continue
+		}
+		rule := sarifRule{ID: c.Name, Help: sarifMessage{checks.Remediation(c.Name)}}
+		if factory, ok := checks.KnownChecks[c.Name]; ok {
+			rule.ShortDescription.Text = factory().GetDescription()
+		} else {
+			rule.ShortDescription.Text = c.Name
+		}
+		run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, rule)
+		for _, i := range c.Issues {
+			level := "error"
+			if c.Status == statusWarning || i.Severity == checks.SeverityWarning {
+				level = "warning"
+			}
+			loc := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: i.File, URIBaseID: "%SRCROOT%"}}
+			if i.Line > 0 {
+				loc.Region = &sarifRegion{StartLine: i.Line, StartColumn: i.Column}
+			}
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    c.Name,
+				Level:     level,
+				Message:   sarifMessage{i.Message},
+				Locations: []sarifLocation{{loc}},
+			})
+		}
+	}
+	return &sarifLog{Schema: sarifSchema, Version: "2.1.0", Runs: []sarifRun{run}}
+}
@@ -0,0 +1,190 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// selftest verifies the installed hook end to end.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// selftestScenario is a commit attempted in the temporary clone.
+type selftestScenario struct {
+	name string
+	// check is the check that must be enabled in mode pre-commit for the
+	// scenario to be meaningful, "" if none.
+	check string
+	files map[string]string
+	// blocked is true if the hook is expected to refuse the commit.
+	blocked bool
+}
+
+var selftestScenarios = []selftestScenario{
+	{
+		"commit good file",
+		"",
+		map[string]string{
+			"pcgselftest/good.go": "package pcgselftest\n\n// Good returns 1.\nfunc Good() int {\n\treturn 1\n}\n",
+		},
+		false,
+	},
+	{
+		"commit badly formatted file",
+		"gofmt",
+		map[string]string{
+			"pcgselftest/bad.go": "package pcgselftest\n\n// Bad returns 1.\nfunc Bad() int {\nreturn 1\n}\n",
+		},
+		true,
+	},
+	{
+		"commit failing test",
+		"test",
+		map[string]string{
+			"pcgselftest/fail_test.go": "package pcgselftest\n\nimport \"testing\"\n\nfunc TestFail(t *testing.T) {\n\tt.Fail()\n}\n",
+		},
+		true,
+	},
+}
+
+// cmdSelfTest clones the repository in a temporary directory, installs the
+// hooks in it with exe, the pcg executable, and verifies that the pre-commit
+// hook permits a good commit and blocks bad ones.
+func cmdSelfTest(repo scm.ReadOnlyRepo, config *checks.Config, configFile, exe string) (err error) {
+	tmpDir, err := ioutil.TempDir("", "pre-commit-go")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err2 := internal.RemoveAll(tmpDir); err == nil {
+			err = err2
+		}
+	}()
+	clone := filepath.Join(tmpDir, "clone")
+
+	if exe, err = filepath.Abs(exe); err != nil {
+		return err
+	}
+	env := []string{
+		"PATH=" + filepath.Dir(exe) + string(os.PathListSeparator) + os.Getenv("PATH"),
+		"GIT_AUTHOR_NAME=pcg", "GIT_AUTHOR_EMAIL=pcg@localhost",
+		"GIT_COMMITTER_NAME=pcg", "GIT_COMMITTER_EMAIL=pcg@localhost",
+	}
+
+	var failed []string
+	stage := func(name string, f func() error) bool {
+		fmt.Printf("selftest: %s ... ", name)
+		if err := f(); err != nil {
+			fmt.Printf("FAILED\n  %s\n", strings.Replace(err.Error(), "\n", "\n  ", -1))
+			failed = append(failed, name)
+			return false
+		}
+		fmt.Printf("ok\n")
+		return true
+	}
+	run := func(args ...string) error {
+		out, code, err := internal.Capture(clone, env, args...)
+		if err != nil {
+			return err
+		}
+		if code != 0 {
+			return fmt.Errorf("%s failed:\n%s", strings.Join(args, " "), out)
+		}
+		return nil
+	}
+
+	ok := stage("clone", func() error {
+		if out, code, err := internal.Capture(tmpDir, nil, "git", "clone", "-q", repo.Root(), clone); code != 0 || err != nil {
+			return fmt.Errorf("git clone failed: %s\n%s", err, out)
+		}
+		if configFile == "<N/A>" {
+			return nil
+		}
+		// Use the exact same configuration, even if it is not checked in.
+		content, err := ioutil.ReadFile(configFile)
+		if err != nil {
+			return err
+		}
+		name := "pre-commit-go.yml"
+		if ext := filepath.Ext(configFile); ext == ".toml" || ext == ".json" {
+			name = "pre-commit-go" + ext
+		}
+		return ioutil.WriteFile(filepath.Join(clone, ".git", name), content, 0666)
+	})
+	ok = ok && stage("install", func() error {
+		if err := run(exe, "install", "-m", string(checks.PreCommit), "-n"); err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(filepath.Join(clone, ".git", "hooks", "pre-commit"))
+		if err != nil {
+			return err
+		}
+		if !strings.Contains(string(content), "run-hook pre-commit") {
+			return errors.New("the pre-commit hook wasn't installed by pcg")
+		}
+		return nil
+	})
+	if ok {
+		enabled := map[string]bool{}
+		// Files must have the copyright header if enforced, otherwise the good
+		// file would be refused.
+		header := ""
+		enabledChecks, _ := config.EnabledChecks([]checks.Mode{checks.PreCommit})
+		for _, c := range enabledChecks {
+			enabled[c.GetName()] = true
+			if cop, ok := c.(*checks.Copyright); ok {
+				header = cop.Header + "\n\n"
+			}
+		}
+		for _, s := range selftestScenarios {
+			if s.check != "" && !enabled[s.check] {
+				fmt.Printf("selftest: %s ... skipped; check %s is not enabled in mode %s\n", s.name, s.check, checks.PreCommit)
+				continue
+			}
+			s := s
+			stage(s.name, func() error {
+				for name, content := range s.files {
+					p := filepath.Join(clone, filepath.FromSlash(name))
+					if err := os.MkdirAll(filepath.Dir(p), 0777); err != nil {
+						return err
+					}
+					if err := ioutil.WriteFile(p, []byte(header+content), 0666); err != nil {
+						return err
+					}
+				}
+				if err := run("git", "add", "."); err != nil {
+					return err
+				}
+				out, code, err := internal.Capture(clone, env, "git", "commit", "-q", "-m", s.name)
+				if err != nil {
+					return err
+				}
+				if s.blocked {
+					if code == 0 {
+						return errors.New("the hook permitted the commit but it was expected to block it")
+					}
+					// Discard the refused files.
+					return run("git", "reset", "-q", "--hard")
+				}
+				if code != 0 {
+					return fmt.Errorf("the hook blocked the commit but it was expected to permit it:\n%s", out)
+				}
+				return nil
+			})
+		}
+	}
+	if len(failed) != 0 {
+		return fmt.Errorf("selftest failed at: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
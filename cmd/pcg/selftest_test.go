@@ -0,0 +1,58 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestCmdSelfTest(t *testing.T) {
+	// Builds pcg and commits in clones.
+	t.Parallel()
+	if testing.Short() {
+		t.SkipNow()
+	}
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	// The hook runs "pcg" from the PATH.
+	exe := filepath.Join(td, "bin", "pcg")
+	out, code, err := internal.Capture(".", nil, "go", "build", "-o", exe, ".")
+	ut.AssertEqualf(t, 0, code, "%s", out)
+	ut.AssertEqual(t, nil, err)
+
+	src := filepath.Join(td, "src")
+	ut.AssertEqual(t, nil, os.Mkdir(src, 0700))
+	env := []string{"GIT_AUTHOR_NAME=pcg", "GIT_AUTHOR_EMAIL=pcg@localhost", "GIT_COMMITTER_NAME=pcg", "GIT_COMMITTER_EMAIL=pcg@localhost"}
+	for _, args := range [][]string{{"init", "-q"}, {"commit", "-q", "--allow-empty", "-m", "initial"}} {
+		out, code, err := internal.Capture(src, env, append([]string{"git"}, args...)...)
+		ut.AssertEqualf(t, 0, code, "%s", out)
+		ut.AssertEqual(t, nil, err)
+	}
+	repo, err := scm.GetRepo(src, td)
+	ut.AssertEqual(t, nil, err)
+
+	// The hook permits the good commit and blocks the badly formatted file. The
+	// failing test scenario is skipped since test is not enabled.
+	configFile := filepath.Join(td, "pass.yml")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(configFile, []byte("min_version: 0.4.7\nmodes:\n  pre-commit:\n    checks:\n      gofmt:\n      - {}\n"), 0600))
+	config, err := loadConfigFile(configFile, false)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, cmdSelfTest(repo, config, configFile, exe))
+
+	// A hook blocking everything blocks the good commit.
+	configFile = filepath.Join(td, "fail.yml")
+	ut.AssertEqual(t, nil, ioutil.WriteFile(configFile, []byte("min_version: 0.4.7\nmodes:\n  pre-commit:\n    checks:\n      custom:\n      - display_name: fail\n        command: [\"false\"]\n        check_exit_code: true\n"), 0600))
+	config, err = loadConfigFile(configFile, false)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, errors.New("selftest failed at: commit good file"), cmdSelfTest(repo, config, configFile, exe))
+}
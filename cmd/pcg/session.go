@@ -0,0 +1,103 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// The standard streams and the environment of a run, the ones of the hook
+// forwarded to 'pcg daemon' instead of the process' own.
+
+package main
+
+import (
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+// session is where a run reads its input, writes its output and looks up its
+// environment. It is the process' own, except in 'pcg daemon' which runs the
+// hooks forwarded to it concurrently, each with its own.
+type session struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+	// env is the environment of the run as "KEY=value" items.
+	env []string
+	// colors is the palette of stdout.
+	colors palette
+	// progress enables the live progress of the running checks on stderr.
+	progress bool
+}
+
+// processSession returns the session of the process, with its current
+// standard streams.
+func processSession() *session {
+	return &session{
+		stdin:    os.Stdin,
+		stdout:   os.Stdout,
+		stderr:   os.Stderr,
+		env:      os.Environ(),
+		colors:   colors,
+		progress: showProgress,
+	}
+}
+
+// getenv returns the value of the environment variable key, "" if it is not
+// set.
+func (s *session) getenv(key string) string {
+	for i := len(s.env) - 1; i >= 0; i-- {
+		if strings.HasPrefix(s.env[i], key+"=") {
+			return s.env[i][len(key)+1:]
+		}
+	}
+	return ""
+}
+
+// envOverrides returns what to set on top of the environment of the process
+// for the commands to run with the environment of the session. A variable the
+// session doesn't have is listed without "=" to remove it, see
+// internal.Capture. It is empty for the session of the process.
+func (s *session) envOverrides() []string {
+	own := map[string]string{}
+	for _, kv := range os.Environ() {
+		if k, v, ok := splitEnv(kv); ok {
+			own[k] = v
+		}
+	}
+	var out []string
+	for _, kv := range s.env {
+		k, v, ok := splitEnv(kv)
+		if !ok || keptEnv[k] {
+			continue
+		}
+		if old, ok := own[k]; !ok || old != v {
+			out = append(out, kv)
+		}
+		delete(own, k)
+	}
+	var removed []string
+	for k := range own {
+		if !keptEnv[k] {
+			removed = append(removed, k)
+		}
+	}
+	sort.Strings(removed)
+	return append(out, removed...)
+}
+
+// Private stuff.
+
+// keptEnv are the variables internal.Capture sets for every command, which
+// the session must not override.
+var keptEnv = map[string]bool{"LANG": true, "LANGUAGE": true}
+
+// splitEnv splits the "KEY=value" item kv. On Windows, the variables holding
+// the current directory of the drives start with "=", e.g. "=C:=C:\".
+func splitEnv(kv string) (string, string, bool) {
+	for i := 1; i < len(kv); i++ {
+		if kv[i] == '=' {
+			return kv[:i], kv[i+1:], true
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,37 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestSessionGetenv(t *testing.T) {
+	t.Parallel()
+	s := &session{env: []string{"FOO=bar", "FOOBAR=baz", "EMPTY=", "FOO=qux"}}
+	ut.AssertEqual(t, "qux", s.getenv("FOO"))
+	ut.AssertEqual(t, "baz", s.getenv("FOOBAR"))
+	ut.AssertEqual(t, "", s.getenv("EMPTY"))
+	ut.AssertEqual(t, "", s.getenv("MISSING"))
+}
+
+func TestSessionEnvOverrides(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, []string(nil), processSession().envOverrides())
+	var env []string
+	for _, kv := range os.Environ() {
+		if !strings.HasPrefix(kv, "PATH=") {
+			env = append(env, kv)
+		}
+	}
+	// LANG is always set by internal.Capture.
+	env = append(env, "PCG_TEST_SESSION=1", "LANG=fr_FR.UTF-8")
+	s := &session{env: env}
+	ut.AssertEqual(t, []string{"PCG_TEST_SESSION=1", "PATH"}, s.envOverrides())
+}
@@ -0,0 +1,40 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Splitting the checks across parallel CI jobs with 'run -shard'.
+
+package main
+
+import (
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+// shard is the part of the checks run by this CI job, set with
+// 'pcg run -shard N/M'. nil runs everything.
+var shard *checks.Shard
+
+// shardChecks returns the checks of enabledChecks run by the shard s. test
+// runs on every shard, on its part of the packages; the other checks are
+// dealt whole to the shards by name. The local history of the durations isn't
+// used since each CI job has its own. coverage is not split since its
+// thresholds apply to all the packages.
+func shardChecks(enabledChecks []checks.Check, s *checks.Shard) []checks.Check {
+	var keys []string
+	for _, c := range enabledChecks {
+		if _, ok := c.(*checks.Test); !ok {
+			keys = append(keys, historyKey(c))
+		}
+	}
+	selected := map[string]bool{}
+	for _, k := range s.Select(keys) {
+		selected[k] = true
+	}
+	var out []checks.Check
+	for _, c := range enabledChecks {
+		if _, ok := c.(*checks.Test); ok || selected[historyKey(c)] {
+			out = append(out, c)
+		}
+	}
+	return out
+}
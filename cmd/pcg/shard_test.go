@@ -0,0 +1,24 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestShardChecks(t *testing.T) {
+	t.Parallel()
+	build := &checks.Build{}
+	gofmt := &checks.Gofmt{}
+	govet := &checks.Govet{}
+	test := &checks.Test{}
+	enabled := []checks.Check{build, gofmt, govet, test}
+	// test runs on both shards, the others are dealt by name.
+	ut.AssertEqual(t, []checks.Check{build, govet, test}, shardChecks(enabled, &checks.Shard{Index: 0, Count: 2}))
+	ut.AssertEqual(t, []checks.Check{gofmt, test}, shardChecks(enabled, &checks.Shard{Index: 1, Count: 2}))
+}
@@ -0,0 +1,87 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+// skipEnvVar is the environment variable listing the checks to skip, e.g.
+// PRECOMMITGO_SKIP=golint,coverage.
+const skipEnvVar = "PRECOMMITGO_SKIP"
+
+// reSkipChecks matches the "[skip-checks: golint, coverage]" trailer in a
+// commit message.
+var reSkipChecks = regexp.MustCompile(`\[skip-checks:([^\]]*)\]`)
+
+// skippedChecks returns the names of the checks to skip, as requested by
+// PRECOMMITGO_SKIP in the environment of s and by the [skip-checks: ...]
+// trailers of the commit message, if any.
+func skippedChecks(s *session, message string) []string {
+	seen := map[string]bool{}
+	add := func(list string) {
+		for _, name := range strings.Split(list, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				seen[name] = true
+			}
+		}
+	}
+	add(s.getenv(skipEnvVar))
+	for _, line := range strings.Split(message, "\n") {
+		// Ignore the comments in the file passed to the commit-msg hook.
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		for _, m := range reSkipChecks.FindAllStringSubmatch(line, -1) {
+			add(m[1])
+		}
+	}
+	out := make([]string, 0, len(seen))
+	for name := range seen {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// filterSkipped returns the checks not listed in skip and prints the ones
+// that are skipped to the stdout of s.
+func filterSkipped(s *session, enabledChecks []checks.Check, skip []string) []checks.Check {
+	if len(skip) == 0 {
+		return enabledChecks
+	}
+	for _, name := range skip {
+		if _, ok := checks.KnownChecks[name]; !ok {
+			fmt.Fprintf(s.stdout, "warning: cannot skip unknown check \"%s\"\n", name)
+		}
+	}
+	out := make([]checks.Check, 0, len(enabledChecks))
+	var skipped []string
+	for _, c := range enabledChecks {
+		if contains(skip, c.GetName()) {
+			skipped = append(skipped, c.GetName())
+			continue
+		}
+		out = append(out, c)
+	}
+	if len(skipped) != 0 {
+		fmt.Fprintf(s.stdout, "skipped: %s\n", strings.Join(skipped, ", "))
+	}
+	return out
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+)
+
+func TestSkippedChecks(t *testing.T) {
+	t.Parallel()
+	s := &session{}
+	ut.AssertEqual(t, []string{}, skippedChecks(s, ""))
+	ut.AssertEqual(t, []string{"coverage", "golint"}, skippedChecks(s, "Fix foo\n\n[skip-checks: golint, coverage]\n"))
+	ut.AssertEqual(t, []string{}, skippedChecks(s, "Fix foo\n# [skip-checks: golint]\n"))
+	s.env = []string{skipEnvVar + "=gofmt,,golint "}
+	ut.AssertEqual(t, []string{"gofmt", "golint"}, skippedChecks(s, ""))
+	ut.AssertEqual(t, []string{"coverage", "gofmt", "golint"}, skippedChecks(s, "[skip-checks:coverage][skip-checks: golint]"))
+}
+
+func TestFilterSkipped(t *testing.T) {
+	t.Parallel()
+	enabled := []checks.Check{&checks.Gofmt{}, &checks.Golint{}, &checks.Build{}}
+	out := &bytes.Buffer{}
+	s := &session{stdout: out}
+	ut.AssertEqual(t, enabled, filterSkipped(s, enabled, nil))
+	ut.AssertEqual(t, []checks.Check{&checks.Gofmt{}, &checks.Build{}}, filterSkipped(s, enabled, []string{"golint", "coverage"}))
+	ut.AssertEqual(t, "skipped: golint\n", out.String())
+}
@@ -0,0 +1,111 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Configuration split in a directory, one file per mode or group of checks.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/gopkg.in/yaml.v2"
+)
+
+// configDirName returns the name of the directory holding the configuration
+// split in multiple files, e.g. .pre-commit-go for pre-commit-go.yml. It
+// returns "" when name is not a YAML file name.
+func configDirName(name string) string {
+	if ext := path.Ext(name); ext == ".yml" || ext == ".yaml" {
+		return "." + strings.TrimSuffix(name, ext)
+	}
+	return ""
+}
+
+// loadConfigDir merges the configuration files in dir and returns the result
+// as YAML. The files are merged in alphabetical order; files in any of the
+// supported encodings are loaded and the others are ignored, e.g. a README.
+//
+// Each file holds a complete configuration with only its piece set, e.g.
+// modes.lint. Two files setting the same key to different values is an error,
+// so each piece can be reviewed on its own.
+func loadConfigDir(dir string) ([]byte, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	merged := map[interface{}]interface{}{}
+	owners := map[string]string{}
+	for _, e := range entries {
+		ext := path.Ext(e.Name())
+		if e.IsDir() || (ext != ".yml" && ext != ".yaml" && ext != ".toml" && ext != ".json") {
+			continue
+		}
+		p := filepath.Join(dir, e.Name())
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		if content, err = decodeConfig(p, content); err != nil {
+			return nil, err
+		}
+		var raw map[interface{}]interface{}
+		if err := yaml.Unmarshal(content, &raw); err != nil {
+			return nil, fmt.Errorf("%s: %s", p, err)
+		}
+		if err := mergeDisjoint(merged, raw, "", e.Name(), owners); err != nil {
+			return nil, fmt.Errorf("%s: %s", dir, err)
+		}
+	}
+	return yaml.Marshal(merged)
+}
+
+// Private stuff.
+
+// mergeDisjoint merges src, loaded from file, into dst. The mappings are
+// merged recursively; any other value set differently by two files is an
+// error. owners
+// maps the keys already set to the file setting them.
+func mergeDisjoint(dst, src map[interface{}]interface{}, where, file string, owners map[string]string) error {
+	keys := make([]string, 0, len(src))
+	byName := make(map[string]interface{}, len(src))
+	for k := range src {
+		keys = append(keys, fmt.Sprint(k))
+		byName[fmt.Sprint(k)] = k
+	}
+	sort.Strings(keys)
+	for _, name := range keys {
+		k := byName[name]
+		key := name
+		if where != "" {
+			key = where + "." + name
+		}
+		if s, ok := src[k].(map[interface{}]interface{}); ok {
+			if _, ok := dst[k]; !ok {
+				dst[k] = map[interface{}]interface{}{}
+				owners[key] = file
+			}
+			if d, ok := dst[k].(map[interface{}]interface{}); ok {
+				if err := mergeDisjoint(d, s, key, file, owners); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		if d, ok := dst[k]; ok {
+			if reflect.DeepEqual(d, src[k]) {
+				continue
+			}
+			return fmt.Errorf("%s is set by both %s and %s", key, owners[key], file)
+		}
+		dst[k] = src[k]
+		owners[key] = file
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+)
+
+func TestConfigDirName(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, ".pre-commit-go", configDirName("pre-commit-go.yml"))
+	ut.AssertEqual(t, "", configDirName("pre-commit-go.toml"))
+}
+
+func TestLoadConfigDir(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	files := map[string]string{
+		"base.yml":        "min_version: 0.4.7\nmax_parallel: 2\n",
+		"lint.toml":       "min_version = \"0.4.7\"\n[modes.lint]\nmax_duration = 60\n[[modes.lint.checks.golint]]\n",
+		"pre-commit.json": "{\"modes\": {\"pre-commit\": {\"max_duration\": 5, \"checks\": {\"gofmt\": [{}]}}}}",
+		"README.md":       "Owned by the lint team.\n",
+	}
+	for name, content := range files {
+		ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, name), []byte(content), 0666))
+	}
+	config, err := loadConfigFile(td, false)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "0.4.7", config.MinVersion)
+	ut.AssertEqual(t, 2, config.MaxParallel)
+	ut.AssertEqual(t, 60, config.Modes[checks.Lint].Options.MaxDuration)
+	ut.AssertEqual(t, 1, len(config.Modes[checks.Lint].Checks["golint"]))
+	ut.AssertEqual(t, 5, config.Modes[checks.PreCommit].Options.MaxDuration)
+	ut.AssertEqual(t, 1, len(config.Modes[checks.PreCommit].Checks["gofmt"]))
+
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "z.yml"), []byte("modes:\n  lint:\n    max_duration: 120\n"), 0666))
+	_, err = loadConfigFile(td, false)
+	ut.AssertEqual(t, errors.New(td+": modes.lint.max_duration is set by both lint.toml and z.yml"), err)
+}
@@ -0,0 +1,171 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// Persisted timings of the profiled runs, reported by 'pcg stats'.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// statsFile is the name of the file in the scm directory, e.g. .git/.
+const statsFile = "pre-commit-go-stats.json"
+
+// maxStatsRuns is the number of runs kept in the stats file.
+const maxStatsRuns = 100
+
+// statsRun is the timings of a run done with 'pcg run -profile'.
+type statsRun struct {
+	Time  time.Time     `json:"time"`
+	Modes []checks.Mode `json:"modes"`
+	// Duration is the wall clock time of the run in seconds.
+	Duration float64 `json:"duration"`
+	// Checks is the duration of each check in seconds, keyed by historyKey().
+	Checks map[string]float64 `json:"checks"`
+	// Packages is the duration of each package in seconds, keyed by
+	// "<check>: <package>".
+	Packages map[string]float64 `json:"packages"`
+}
+
+// newStatsRun returns the statsRun for the results of a run.
+func newStatsRun(start time.Time, duration time.Duration, modes []checks.Mode, results []result, timings *checks.PackageTimings) *statsRun {
+	s := &statsRun{
+		Time:     start,
+		Modes:    modes,
+		Duration: duration.Seconds(),
+		Checks:   map[string]float64{},
+		Packages: map[string]float64{},
+	}
+	for _, r := range results {
+		s.Checks[historyKey(r.check)] = r.duration.Seconds()
+	}
+	for check, pkgs := range timings.Durations() {
+		for pkg, d := range pkgs {
+			s.Packages[check+": "+pkg] = d.Seconds()
+		}
+	}
+	return s
+}
+
+// stats is the timings of the last profiled runs, oldest first.
+type stats struct {
+	Runs []*statsRun `json:"runs"`
+}
+
+// loadStats loads the stats from the scm directory. It never fails; empty
+// stats are returned if none are found.
+func loadStats(repo scm.ReadOnlyRepo) *stats {
+	s := &stats{}
+	p, err := scmFilePath(repo, statsFile)
+	if err != nil {
+		return s
+	}
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		return s
+	}
+	if err := json.Unmarshal(content, s); err != nil {
+		log.Printf("ignoring corrupted %s: %s", p, err)
+		s.Runs = nil
+	}
+	return s
+}
+
+// save writes the stats in the scm directory.
+func (s *stats) save(repo scm.ReadOnlyRepo) error {
+	p, err := scmFilePath(repo, statsFile)
+	if err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p, append(content, '\n'), 0666)
+}
+
+// add appends a run, dropping the oldest ones above maxStatsRuns.
+func (s *stats) add(r *statsRun) {
+	s.Runs = append(s.Runs, r)
+	if len(s.Runs) > maxStatsRuns {
+		s.Runs = s.Runs[len(s.Runs)-maxStatsRuns:]
+	}
+}
+
+// timing is the average duration of a check or a package.
+type timing struct {
+	name    string
+	average time.Duration
+	runs    int
+}
+
+// slowest returns the average duration of the checks and of the packages over
+// the last n runs, slowest first. n <= 0 means all runs.
+func (s *stats) slowest(n int) ([]timing, []timing) {
+	runs := s.Runs
+	if n > 0 && len(runs) > n {
+		runs = runs[len(runs)-n:]
+	}
+	checks := map[string][]float64{}
+	packages := map[string][]float64{}
+	for _, r := range runs {
+		for k, v := range r.Checks {
+			checks[k] = append(checks[k], v)
+		}
+		for k, v := range r.Packages {
+			packages[k] = append(packages[k], v)
+		}
+	}
+	return averages(checks), averages(packages)
+}
+
+// printTimings prints the top slowest timings.
+func printTimings(w io.Writer, title string, timings []timing, top int) {
+	if len(timings) == 0 {
+		return
+	}
+	if top > 0 && len(timings) > top {
+		timings = timings[:top]
+	}
+	fmt.Fprintf(w, "%s:\n", title)
+	for _, t := range timings {
+		fmt.Fprintf(w, "  %8.2fs  %s (%d runs)\n", t.average.Seconds(), t.name, t.runs)
+	}
+}
+
+// Private stuff.
+
+func averages(durations map[string][]float64) []timing {
+	out := make([]timing, 0, len(durations))
+	for name, values := range durations {
+		total := 0.
+		for _, v := range values {
+			total += v
+		}
+		out = append(out, timing{name, time.Duration(total / float64(len(values)) * float64(time.Second)), len(values)})
+	}
+	sort.Sort(bySlowest(out))
+	return out
+}
+
+type bySlowest []timing
+
+func (b bySlowest) Len() int      { return len(b) }
+func (b bySlowest) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b bySlowest) Less(i, j int) bool {
+	if b[i].average != b[j].average {
+		return b[i].average > b[j].average
+	}
+	return b[i].name < b[j].name
+}
@@ -0,0 +1,70 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestStatsSlowest(t *testing.T) {
+	t.Parallel()
+	timings := checks.NewPackageTimings()
+	timings.Record("test", "./foo", 3*time.Second)
+	results := []result{
+		{check: &checks.Build{}, duration: time.Second},
+		{check: &checks.Test{}, duration: 4 * time.Second},
+	}
+	s := &stats{}
+	s.add(newStatsRun(time.Time{}, 4*time.Second, []checks.Mode{checks.PrePush}, results, timings))
+	s.add(newStatsRun(time.Time{}, 2*time.Second, []checks.Mode{checks.PrePush}, results[:1], nil))
+	s.Runs[1].Checks[historyKey(&checks.Build{})] = 3
+
+	build := historyKey(&checks.Build{})
+	test := historyKey(&checks.Test{})
+	checkTimings, packageTimings := s.slowest(0)
+	ut.AssertEqual(t, []timing{{test, 4 * time.Second, 1}, {build, 2 * time.Second, 2}}, checkTimings)
+	ut.AssertEqual(t, []timing{{"test: ./foo", 3 * time.Second, 1}}, packageTimings)
+	checkTimings, packageTimings = s.slowest(1)
+	ut.AssertEqual(t, []timing{{build, 3 * time.Second, 1}}, checkTimings)
+	ut.AssertEqual(t, []timing{}, packageTimings)
+
+	out := &bytes.Buffer{}
+	printTimings(out, "Checks", []timing{{test, 4 * time.Second, 1}, {build, 2 * time.Second, 2}}, 1)
+	ut.AssertEqual(t, "Checks:\n      4.00s  "+test+" (1 runs)\n", out.String())
+}
+
+func TestStatsMaxRuns(t *testing.T) {
+	t.Parallel()
+	s := &stats{}
+	for i := 0; i < maxStatsRuns+2; i++ {
+		s.add(&statsRun{Duration: float64(i)})
+	}
+	ut.AssertEqual(t, maxStatsRuns, len(s.Runs))
+	ut.AssertEqual(t, 2., s.Runs[0].Duration)
+}
+
+func TestStatsSaveLoad(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	_, code, err := internal.Capture(td, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(td, td)
+	ut.AssertEqual(t, nil, err)
+
+	s := loadStats(repo)
+	ut.AssertEqual(t, 0, len(s.Runs))
+	s.add(&statsRun{Time: time.Date(2015, 1, 2, 3, 4, 5, 0, time.UTC), Modes: []checks.Mode{checks.PreCommit}, Duration: 1, Checks: map[string]float64{"a": 1}, Packages: map[string]float64{}})
+	ut.AssertEqual(t, nil, s.save(repo))
+	ut.AssertEqual(t, s, loadStats(repo))
+}
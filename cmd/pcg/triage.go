@@ -0,0 +1,93 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// isTerminal returns true if f is a character device, e.g. a TTY.
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// triage asks the user what to do about each failed check, reading the answers
+// from in. The non-blocking checks are skipped. It returns an error if any failure is left unresolved.
+func triage(results []result, change scm.Change, options *checks.Options, in io.Reader, out io.Writer) error {
+	reader := bufio.NewReader(in)
+	for _, r := range results {
+		if !r.blocking() {
+			continue
+		}
+		name := r.check.GetName()
+		err := r.err
+		fmt.Fprintf(out, "%s failed: %s\n", name, firstLine(err.Error()))
+		for err != nil {
+			fmt.Fprintf(out, "[v]iew output, [r]e-run, [f]ix, [s]uppress, [a]bort? ")
+			answer, readErr := reader.ReadString('\n')
+			if readErr != nil && answer == "" {
+				fmt.Fprintf(out, "\n")
+				return checksFailed(errors.New("checks failed"))
+			}
+			switch strings.TrimSpace(answer) {
+			case "v":
+				fmt.Fprintf(out, "%s\n", err)
+			case "r":
+				err = rerun(r.check, change, options, out)
+			case "f":
+				fixer, ok := r.check.(checks.Fixer)
+				if !ok {
+					fmt.Fprintf(out, "%s can't fix its issues\n", name)
+					continue
+				}
+				files, fixErr := fixer.Fix(checkScope(r.check, change), options.ForCheck(r.check))
+				if fixErr != nil {
+					fmt.Fprintf(out, "%s\n", fixErr)
+					continue
+				}
+				for _, f := range files {
+					fmt.Fprintf(out, "fixed %s\n", f)
+				}
+				err = rerun(r.check, change, options, out)
+			case "s":
+				fmt.Fprintf(out, "suppressed %s\n", name)
+				err = nil
+			case "a":
+				return checksFailed(errors.New("checks failed"))
+			}
+		}
+	}
+	return nil
+}
+
+// rerun runs check again and prints the outcome.
+func rerun(check checks.Check, change scm.Change, options *checks.Options, out io.Writer) error {
+	_, r := callRun(context.Background(), check, change, options)
+	err := r.Err
+	if err != nil {
+		fmt.Fprintf(out, "%s still fails: %s\n", check.GetName(), firstLine(err.Error()))
+	} else {
+		fmt.Fprintf(out, "%s passed\n", check.GetName())
+	}
+	return err
+}
+
+// firstLine returns the first line of s.
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i != -1 {
+		return s[:i]
+	}
+	return s
+}
@@ -0,0 +1,80 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestTriage(t *testing.T) {
+	t.Parallel()
+	flaky := &fakeCheck{name: "flaky", errs: []error{errors.New("flaky failed\ndetails"), nil}}
+	fixable := &fakeFixer{fakeCheck{name: "fixable", errs: []error{nil}}}
+	results := []result{
+		{check: &fakeCheck{name: "ok"}},
+		{check: flaky, err: errors.New("flaky failed\ndetails")},
+		{check: fixable, err: errors.New("fixable failed")},
+		{check: &fakeCheck{name: "broken"}, err: errors.New("broken failed")},
+	}
+	out := &bytes.Buffer{}
+	in := strings.NewReader("v\nr\nr\nf\nx\nf\ns\n")
+	ut.AssertEqual(t, nil, triage(results, nil, &checks.Options{}, in, out))
+	prompt := "[v]iew output, [r]e-run, [f]ix, [s]uppress, [a]bort? "
+	expected := "flaky failed: flaky failed\n" +
+		prompt + "flaky failed\ndetails\n" +
+		prompt + "flaky still fails: flaky failed\n" +
+		prompt + "flaky passed\n" +
+		"fixable failed: fixable failed\n" +
+		prompt + "fixed a.go\nfixable passed\n" +
+		"broken failed: broken failed\n" +
+		prompt + prompt + "broken can't fix its issues\n" +
+		prompt + "suppressed broken\n"
+	ut.AssertEqual(t, expected, out.String())
+}
+
+func TestTriageAbort(t *testing.T) {
+	t.Parallel()
+	results := []result{{check: &fakeCheck{name: "broken"}, err: errors.New("broken failed")}}
+	out := &bytes.Buffer{}
+	ut.AssertEqual(t, checksFailed(errors.New("checks failed")), triage(results, nil, &checks.Options{}, strings.NewReader("a\n"), out))
+	out.Reset()
+	ut.AssertEqual(t, checksFailed(errors.New("checks failed")), triage(results, nil, &checks.Options{}, strings.NewReader(""), out))
+}
+
+// Private stuff.
+
+// fakeCheck returns errs in order on each Run.
+type fakeCheck struct {
+	name string
+	errs []error
+}
+
+func (f *fakeCheck) GetDescription() string                       { return f.name }
+func (f *fakeCheck) GetName() string                              { return f.name }
+func (f *fakeCheck) GetPrerequisites() []checks.CheckPrerequisite { return nil }
+
+func (f *fakeCheck) Run(change scm.Change, options *checks.Options) checks.Result {
+	if len(f.errs) == 0 {
+		return checks.Result{Err: errors.New(f.name + " failed")}
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return checks.Result{Err: err}
+}
+
+type fakeFixer struct {
+	fakeCheck
+}
+
+func (f *fakeFixer) Fix(change scm.Change, options *checks.Options) ([]string, error) {
+	return []string{"a.go"}, nil
+}
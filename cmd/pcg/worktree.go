@@ -0,0 +1,76 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+// worktree runs the pre-commit checks on the index in a temporary worktree.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+// hookEnv are the variables git sets for the hooks that describe the main
+// checkout.
+var hookEnv = []string{"GIT_DIR", "GIT_WORK_TREE", "GIT_INDEX_FILE", "GIT_PREFIX"}
+
+// checkoutIndex checks out the index of repo in a temporary worktree and
+// returns it with the function to remove it.
+//
+// The checks must be run with worktreeOptions so the commands they run don't
+// use the main checkout.
+func checkoutIndex(repo scm.Repo) (scm.Repo, func() error, error) {
+	tmpDir, err := ioutil.TempDir("", tmpPrefix)
+	if err != nil {
+		return nil, nil, err
+	}
+	dir, gopath := worktreeDir(repo, tmpDir)
+	w, err := repo.CheckoutIndex(dir, gopath)
+	if err != nil {
+		_ = internal.RemoveAll(tmpDir)
+		return nil, nil, err
+	}
+	remove := func() error {
+		err := repo.RemoveWorktree(dir)
+		if err2 := internal.RemoveAll(tmpDir); err == nil {
+			err = err2
+		}
+		return err
+	}
+	return w, remove, nil
+}
+
+// worktreeOptions returns a copy of options that clears the variables git sets
+// for the hook, so the commands run by the checks, e.g. go build stamping the
+// VCS information, use the worktree instead of the main checkout. The process
+// environment is left untouched since the daemon serves other hooks.
+func worktreeOptions(options *checks.Options) *checks.Options {
+	out := *options
+	out.Env = append(append([]string{}, options.Env...), hookEnv...)
+	return &out
+}
+
+// worktreeDir returns the directory in tmpDir where to check out repo and the
+// GOPATH to use. A checkout without go.mod in GOPATH is checked out at the same
+// import path in tmpDir, which is prepended to GOPATH, so its imports still
+// resolve.
+func worktreeDir(repo scm.ReadOnlyRepo, tmpDir string) (string, string) {
+	root := repo.Root()
+	gopath := repo.GOPATH()
+	if _, err := os.Stat(filepath.Join(root, "go.mod")); err != nil {
+		for _, p := range filepath.SplitList(gopath) {
+			src := filepath.Join(p, "src") + string(filepath.Separator)
+			if p != "" && strings.HasPrefix(root, src) {
+				return filepath.Join(tmpDir, "src", root[len(src):]), tmpDir + string(filepath.ListSeparator) + gopath
+			}
+		}
+	}
+	return filepath.Join(tmpDir, filepath.Base(root)), gopath
+}
@@ -0,0 +1,79 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+	"github.com/maruel/pre-commit-go/checks"
+	"github.com/maruel/pre-commit-go/internal"
+	"github.com/maruel/pre-commit-go/scm"
+)
+
+func TestWorktreeDir(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	gopath := filepath.Join(td, "gopath")
+	repoDir := filepath.Join(gopath, "src", "example.com", "foo")
+	ut.AssertEqual(t, nil, os.MkdirAll(repoDir, 0700))
+	_, code, err := internal.Capture(repoDir, nil, "git", "init")
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, nil, err)
+	repo, err := scm.GetRepo(repoDir, gopath)
+	ut.AssertEqual(t, nil, err)
+
+	// Without go.mod, the import path is kept.
+	tmpDir := filepath.Join(td, "tmp")
+	dir, p := worktreeDir(repo, tmpDir)
+	ut.AssertEqual(t, filepath.Join(tmpDir, "src", "example.com", "foo"), dir)
+	ut.AssertEqual(t, tmpDir+string(filepath.ListSeparator)+gopath, p)
+
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(repoDir, "go.mod"), []byte("module example.com/foo\n"), 0600))
+	dir, p = worktreeDir(repo, tmpDir)
+	ut.AssertEqual(t, filepath.Join(tmpDir, "foo"), dir)
+	ut.AssertEqual(t, gopath, p)
+}
+
+func TestCheckoutIndex(t *testing.T) {
+	t.Parallel()
+	td, cleanup := internal.TempDir(t)
+	defer cleanup()
+	for _, args := range [][]string{{"init", "-q"}, {"config", "user.email", "nobody@localhost"}, {"config", "user.name", "nobody"}} {
+		_, code, err := internal.Capture(td, nil, append([]string{"git"}, args...)...)
+		ut.AssertEqual(t, 0, code)
+		ut.AssertEqual(t, nil, err)
+	}
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "go.mod"), []byte("module foo\n"), 0600))
+	for _, args := range [][]string{{"add", "."}, {"commit", "-q", "-m", "initial"}} {
+		_, code, err := internal.Capture(td, nil, append([]string{"git"}, args...)...)
+		ut.AssertEqual(t, 0, code)
+		ut.AssertEqual(t, nil, err)
+	}
+	repo, err := scm.GetRepo(td, "")
+	ut.AssertEqual(t, nil, err)
+
+	w, remove, err := checkoutIndex(repo)
+	ut.AssertEqual(t, nil, err)
+	content, err := ioutil.ReadFile(filepath.Join(w.Root(), "go.mod"))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "module foo\n", string(content))
+	ut.AssertEqual(t, nil, remove())
+	_, err = os.Stat(filepath.Dir(w.Root()))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+}
+
+func TestWorktreeOptions(t *testing.T) {
+	t.Parallel()
+	options := &checks.Options{MaxDuration: 1, Env: []string{"FOO=bar"}}
+	w := worktreeOptions(options)
+	ut.AssertEqual(t, []string{"FOO=bar", "GIT_DIR", "GIT_WORK_TREE", "GIT_INDEX_FILE", "GIT_PREFIX"}, w.Env)
+	ut.AssertEqual(t, 1, w.MaxDuration)
+	ut.AssertEqual(t, []string{"FOO=bar"}, options.Env)
+}
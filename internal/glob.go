@@ -0,0 +1,41 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"path"
+	"strings"
+)
+
+// MatchPath returns true if the path p, using "/", matches the glob pattern as
+// a whole, where "**" matches any number of directories.
+func MatchPath(pattern, p string) bool {
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(p, "/"))
+}
+
+// Private stuff.
+
+// matchSegments matches the path components of a file against the ones of a
+// pattern.
+func matchSegments(pattern, f []string) bool {
+	for len(pattern) != 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(f); i++ {
+				if matchSegments(pattern[1:], f[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(f) == 0 {
+			return false
+		}
+		if matched, _ := path.Match(pattern[0], f[0]); !matched {
+			return false
+		}
+		pattern, f = pattern[1:], f[1:]
+	}
+	return len(f) == 0
+}
@@ -0,0 +1,21 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestMatchPath(t *testing.T) {
+	t.Parallel()
+	ut.AssertEqual(t, true, MatchPath("a/*.go", "a/b.go"))
+	ut.AssertEqual(t, false, MatchPath("a/*.go", "a/b/c.go"))
+	ut.AssertEqual(t, true, MatchPath("a/**/*.go", "a/b.go"))
+	ut.AssertEqual(t, true, MatchPath("a/**/*.go", "a/b/c/d.go"))
+	ut.AssertEqual(t, true, MatchPath("**", "a/b"))
+	ut.AssertEqual(t, false, MatchPath("a/**", "b/a"))
+}
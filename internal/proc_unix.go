@@ -0,0 +1,24 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+//go:build !windows
+// +build !windows
+
+package internal
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// newProcessGroup makes the command the leader of a new process group, so its
+// children can be killed along with it.
+func newProcessGroup(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// killProcessTree kills the process group of the command.
+func killProcessTree(c *exec.Cmd) error {
+	return syscall.Kill(-c.Process.Pid, syscall.SIGKILL)
+}
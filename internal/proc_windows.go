@@ -0,0 +1,19 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"os/exec"
+	"strconv"
+)
+
+// newProcessGroup is a no-op on Windows; taskkill walks the process tree.
+func newProcessGroup(c *exec.Cmd) {
+}
+
+// killProcessTree kills the command and all its children.
+func killProcessTree(c *exec.Cmd) error {
+	return exec.Command("taskkill", "/F", "/T", "/PID", strconv.Itoa(c.Process.Pid)).Run()
+}
@@ -0,0 +1,24 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+// TempDir creates a temporary directory for the test t. It returns it with the
+// function removing it, usually deferred. t fails if either fails.
+func TempDir(t testing.TB) (string, func()) {
+	dir, err := ioutil.TempDir("", "pre-commit-go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir, func() {
+		if err := RemoveAll(dir); err != nil {
+			t.Errorf("%s", err)
+		}
+	}
+}
@@ -0,0 +1,592 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// ParseTOML parses a TOML document. Tables are decoded as
+// map[string]interface{}, arrays as []interface{}, integers as int64 and
+// floats as float64.
+//
+// Dates and times are not supported.
+func ParseTOML(content []byte) (map[string]interface{}, error) {
+	p := &tomlParser{s: string(content), line: 1}
+	out, err := p.parse()
+	if err != nil {
+		return nil, fmt.Errorf("line %d: %s", p.line, err)
+	}
+	return out, nil
+}
+
+// FormatTOML formats v as a TOML document. The keys are sorted. The nil values
+// are skipped since TOML has no representation for them.
+func FormatTOML(v map[string]interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	if err := formatTable(&b, nil, v, false); err != nil {
+		return nil, err
+	}
+	return bytes.TrimLeft(b.Bytes(), "\n"), nil
+}
+
+// Private stuff.
+
+type tomlParser struct {
+	s    string
+	i    int
+	line int
+}
+
+func (p *tomlParser) parse() (map[string]interface{}, error) {
+	root := map[string]interface{}{}
+	cur := root
+	for {
+		p.skipBlank(true)
+		if p.eof() {
+			return root, nil
+		}
+		if p.s[p.i] == '[' {
+			array := strings.HasPrefix(p.s[p.i:], "[[")
+			if array {
+				p.i += 2
+			} else {
+				p.i++
+			}
+			keys, err := p.parseKeys()
+			if err != nil {
+				return nil, err
+			}
+			closing := "]"
+			if array {
+				closing = "]]"
+			}
+			if !strings.HasPrefix(p.s[p.i:], closing) {
+				return nil, fmt.Errorf("expected %s", closing)
+			}
+			p.i += len(closing)
+			parent, err := descend(root, keys[:len(keys)-1])
+			if err != nil {
+				return nil, err
+			}
+			last := keys[len(keys)-1]
+			if array {
+				var items []interface{}
+				if v, ok := parent[last]; ok {
+					if items, ok = v.([]interface{}); !ok {
+						return nil, fmt.Errorf("%s is not an array", strings.Join(keys, "."))
+					}
+				}
+				cur = map[string]interface{}{}
+				parent[last] = append(items, cur)
+			} else if cur, err = descend(parent, []string{last}); err != nil {
+				return nil, err
+			}
+		} else {
+			keys, err := p.parseKeys()
+			if err != nil {
+				return nil, err
+			}
+			if !p.consume('=') {
+				return nil, fmt.Errorf("expected = after %s", strings.Join(keys, "."))
+			}
+			p.skipBlank(false)
+			v, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			t, err := descend(cur, keys[:len(keys)-1])
+			if err != nil {
+				return nil, err
+			}
+			last := keys[len(keys)-1]
+			if _, ok := t[last]; ok {
+				return nil, fmt.Errorf("duplicate key %s", strings.Join(keys, "."))
+			}
+			t[last] = v
+		}
+		p.skipBlank(false)
+		if !p.eof() && p.s[p.i] != '\n' && p.s[p.i] != '\r' {
+			return nil, fmt.Errorf("unexpected %q", p.s[p.i])
+		}
+	}
+}
+
+// descend returns the table at keys below t, creating the missing ones. An
+// array of tables resolves to its last table.
+func descend(t map[string]interface{}, keys []string) (map[string]interface{}, error) {
+	for _, k := range keys {
+		switch v := t[k].(type) {
+		case nil:
+			n := map[string]interface{}{}
+			t[k] = n
+			t = n
+		case map[string]interface{}:
+			t = v
+		case []interface{}:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("%s is not a table", k)
+			}
+			n, ok := v[len(v)-1].(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("%s is not a table", k)
+			}
+			t = n
+		default:
+			return nil, fmt.Errorf("%s is not a table", k)
+		}
+	}
+	return t, nil
+}
+
+func (p *tomlParser) eof() bool {
+	return p.i >= len(p.s)
+}
+
+// consume skips the spaces then the character c, if present.
+func (p *tomlParser) consume(c byte) bool {
+	p.skipBlank(false)
+	if !p.eof() && p.s[p.i] == c {
+		p.i++
+		return true
+	}
+	return false
+}
+
+// skipBlank skips the spaces and the comments, and the new lines if
+// newlines is true.
+func (p *tomlParser) skipBlank(newlines bool) {
+	for !p.eof() {
+		switch c := p.s[p.i]; {
+		case c == ' ' || c == '\t':
+			p.i++
+		case c == '#':
+			for !p.eof() && p.s[p.i] != '\n' {
+				p.i++
+			}
+		case (c == '\n' || c == '\r') && newlines:
+			if c == '\n' {
+				p.line++
+			}
+			p.i++
+		default:
+			return
+		}
+	}
+}
+
+// parseKeys parses a dotted key.
+func (p *tomlParser) parseKeys() ([]string, error) {
+	var keys []string
+	for {
+		p.skipBlank(false)
+		if p.eof() {
+			return nil, fmt.Errorf("expected a key")
+		}
+		var k string
+		switch p.s[p.i] {
+		case '"', '\'':
+			var err error
+			if k, err = p.parseString(); err != nil {
+				return nil, err
+			}
+		default:
+			start := p.i
+			for !p.eof() && isBareKeyChar(p.s[p.i]) {
+				p.i++
+			}
+			if start == p.i {
+				return nil, fmt.Errorf("unexpected %q", p.s[p.i])
+			}
+			k = p.s[start:p.i]
+		}
+		keys = append(keys, k)
+		if !p.consume('.') {
+			p.skipBlank(false)
+			return keys, nil
+		}
+	}
+}
+
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) parseValue() (interface{}, error) {
+	if p.eof() {
+		return nil, fmt.Errorf("expected a value")
+	}
+	switch p.s[p.i] {
+	case '"', '\'':
+		return p.parseString()
+	case '[':
+		p.i++
+		out := []interface{}{}
+		for {
+			p.skipBlank(true)
+			if p.eof() {
+				return nil, fmt.Errorf("unterminated array")
+			}
+			if p.s[p.i] == ']' {
+				p.i++
+				return out, nil
+			}
+			v, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, v)
+			p.skipBlank(true)
+			if !p.eof() && p.s[p.i] == ',' {
+				p.i++
+			} else if p.eof() || p.s[p.i] != ']' {
+				return nil, fmt.Errorf("expected , or ] in array")
+			}
+		}
+	case '{':
+		p.i++
+		out := map[string]interface{}{}
+		if p.consume('}') {
+			return out, nil
+		}
+		for {
+			keys, err := p.parseKeys()
+			if err != nil {
+				return nil, err
+			}
+			if !p.consume('=') {
+				return nil, fmt.Errorf("expected = after %s", strings.Join(keys, "."))
+			}
+			p.skipBlank(false)
+			v, err := p.parseValue()
+			if err != nil {
+				return nil, err
+			}
+			t, err := descend(out, keys[:len(keys)-1])
+			if err != nil {
+				return nil, err
+			}
+			t[keys[len(keys)-1]] = v
+			if p.consume('}') {
+				return out, nil
+			}
+			if !p.consume(',') {
+				return nil, fmt.Errorf("expected , or } in inline table")
+			}
+		}
+	}
+	start := p.i
+	for !p.eof() && (isBareKeyChar(p.s[p.i]) || p.s[p.i] == '+' || p.s[p.i] == '.') {
+		p.i++
+	}
+	return parseScalar(p.s[start:p.i])
+}
+
+// parseScalar parses a boolean or a number.
+func parseScalar(s string) (interface{}, error) {
+	switch s {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "inf", "+inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	case "nan", "+nan", "-nan":
+		return math.NaN(), nil
+	case "":
+		return nil, fmt.Errorf("expected a value")
+	}
+	clean := strings.Replace(s, "_", "", -1)
+	if i, err := strconv.ParseInt(clean, 10, 64); err == nil {
+		return i, nil
+	}
+	if len(clean) > 2 && clean[0] == '0' {
+		base := map[byte]int{'x': 16, 'o': 8, 'b': 2}[clean[1]]
+		if base != 0 {
+			if i, err := strconv.ParseInt(clean[2:], base, 64); err == nil {
+				return i, nil
+			}
+		}
+	}
+	if f, err := strconv.ParseFloat(clean, 64); err == nil {
+		return f, nil
+	}
+	return nil, fmt.Errorf("unsupported value %q", s)
+}
+
+// parseString parses a basic or literal string, single or multi-line.
+func (p *tomlParser) parseString() (string, error) {
+	q := p.s[p.i]
+	multi := strings.HasPrefix(p.s[p.i:], strings.Repeat(string(q), 3))
+	delim := string(q)
+	if multi {
+		delim = strings.Repeat(string(q), 3)
+		p.i += 3
+		// A newline immediately following the opening delimiter is trimmed.
+		if strings.HasPrefix(p.s[p.i:], "\r\n") {
+			p.i += 2
+			p.line++
+		} else if strings.HasPrefix(p.s[p.i:], "\n") {
+			p.i++
+			p.line++
+		}
+	} else {
+		p.i++
+	}
+	var b bytes.Buffer
+	for {
+		if p.eof() {
+			return "", fmt.Errorf("unterminated string")
+		}
+		if strings.HasPrefix(p.s[p.i:], delim) {
+			p.i += len(delim)
+			return b.String(), nil
+		}
+		c := p.s[p.i]
+		if c == '\n' {
+			if !multi {
+				return "", fmt.Errorf("unterminated string")
+			}
+			p.line++
+		}
+		if c != '\\' || q == '\'' {
+			b.WriteByte(c)
+			p.i++
+			continue
+		}
+		p.i++
+		if p.eof() {
+			return "", fmt.Errorf("unterminated string")
+		}
+		e := p.s[p.i]
+		p.i++
+		switch e {
+		case 'b':
+			b.WriteByte('\b')
+		case 't':
+			b.WriteByte('\t')
+		case 'n':
+			b.WriteByte('\n')
+		case 'f':
+			b.WriteByte('\f')
+		case 'r':
+			b.WriteByte('\r')
+		case '"', '\\':
+			b.WriteByte(e)
+		case 'u', 'U':
+			n := 4
+			if e == 'U' {
+				n = 8
+			}
+			if p.i+n > len(p.s) {
+				return "", fmt.Errorf("invalid escape \\%c", e)
+			}
+			r, err := strconv.ParseUint(p.s[p.i:p.i+n], 16, 32)
+			if err != nil {
+				return "", fmt.Errorf("invalid escape \\%c%s", e, p.s[p.i:p.i+n])
+			}
+			b.WriteRune(rune(r))
+			p.i += n
+		default:
+			if !multi || (e != ' ' && e != '\t' && e != '\n' && e != '\r') {
+				return "", fmt.Errorf("invalid escape \\%c", e)
+			}
+			// A line ending backslash trims the whitespace up to the next
+			// non-whitespace character.
+			p.i--
+			for !p.eof() && strings.IndexByte(" \t\r\n", p.s[p.i]) != -1 {
+				if p.s[p.i] == '\n' {
+					p.line++
+				}
+				p.i++
+			}
+		}
+	}
+}
+
+// formatTable writes the table m at path. The simple values are written first,
+// then the tables and the arrays of tables.
+func formatTable(b *bytes.Buffer, path []string, m map[string]interface{}, header bool) error {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var tables []string
+	simple := false
+	for _, k := range keys {
+		if isTable(m[k]) || isTableArray(m[k]) {
+			tables = append(tables, k)
+		} else if m[k] != nil {
+			simple = true
+		}
+	}
+	if header && (simple || len(tables) == 0) {
+		fmt.Fprintf(b, "\n[%s]\n", formatKeys(path))
+	}
+	for _, k := range keys {
+		if m[k] == nil || isTable(m[k]) || isTableArray(m[k]) {
+			continue
+		}
+		s, err := formatValue(m[k])
+		if err != nil {
+			return fmt.Errorf("%s: %s", k, err)
+		}
+		fmt.Fprintf(b, "%s = %s\n", formatKey(k), s)
+	}
+	for _, k := range tables {
+		sub := append(append([]string{}, path...), k)
+		if t, ok := m[k].(map[string]interface{}); ok {
+			if err := formatTable(b, sub, t, true); err != nil {
+				return err
+			}
+			continue
+		}
+		for _, item := range m[k].([]interface{}) {
+			fmt.Fprintf(b, "\n[[%s]]\n", formatKeys(sub))
+			if err := formatTable(b, sub, item.(map[string]interface{}), false); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func isTable(v interface{}) bool {
+	_, ok := v.(map[string]interface{})
+	return ok
+}
+
+// isTableArray returns true if v is a non empty array of tables only.
+func isTableArray(v interface{}) bool {
+	a, ok := v.([]interface{})
+	if !ok || len(a) == 0 {
+		return false
+	}
+	for _, item := range a {
+		if !isTable(item) {
+			return false
+		}
+	}
+	return true
+}
+
+func formatKeys(keys []string) string {
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = formatKey(k)
+	}
+	return strings.Join(out, ".")
+}
+
+func formatKey(k string) string {
+	if k == "" {
+		return `""`
+	}
+	for i := 0; i < len(k); i++ {
+		if !isBareKeyChar(k[i]) {
+			return formatString(k)
+		}
+	}
+	return k
+}
+
+func formatValue(v interface{}) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return formatString(t), nil
+	case bool:
+		return strconv.FormatBool(t), nil
+	case int:
+		return strconv.Itoa(t), nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	case uint64:
+		return strconv.FormatUint(t, 10), nil
+	case float64:
+		switch {
+		case math.IsInf(t, 1):
+			return "inf", nil
+		case math.IsInf(t, -1):
+			return "-inf", nil
+		case math.IsNaN(t):
+			return "nan", nil
+		}
+		s := strconv.FormatFloat(t, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s, nil
+	case []interface{}:
+		items := make([]string, 0, len(t))
+		for _, item := range t {
+			s, err := formatValue(item)
+			if err != nil {
+				return "", err
+			}
+			items = append(items, s)
+		}
+		return "[" + strings.Join(items, ", ") + "]", nil
+	case map[string]interface{}:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		items := make([]string, 0, len(t))
+		for _, k := range keys {
+			if t[k] == nil {
+				continue
+			}
+			s, err := formatValue(t[k])
+			if err != nil {
+				return "", err
+			}
+			items = append(items, formatKey(k)+" = "+s)
+		}
+		return "{" + strings.Join(items, ", ") + "}", nil
+	}
+	return "", fmt.Errorf("unsupported type %T", v)
+}
+
+// formatString quotes s as a TOML basic string.
+func formatString(s string) string {
+	var b bytes.Buffer
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\b':
+			b.WriteString(`\b`)
+		case '\f':
+			b.WriteString(`\f`)
+		default:
+			if r < 0x20 || r == 0x7f || r == utf8.RuneError {
+				fmt.Fprintf(&b, `\u%04X`, r)
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
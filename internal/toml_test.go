@@ -0,0 +1,139 @@
+// Copyright 2015 Marc-Antoine Ruel. All rights reserved.
+// Use of this source code is governed under the Apache License, Version 2.0
+// that can be found in the LICENSE file.
+
+package internal
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
+)
+
+func TestParseTOML(t *testing.T) {
+	t.Parallel()
+	content := `# Comment.
+version = 2
+max_duration = 1_200
+ratio = 0.5
+ok = true
+name = "a \"b\"\tc \u00e9"
+raw = 'C:\path'
+multi = """
+line1
+line2"""
+list = [
+  "a", # First.
+  "b",
+]
+inline = {x = 1, y.z = "w"}
+
+[modes.pre-commit]
+options = {max_duration = 5}
+
+[[modes.pre-commit.checks.gofmt]]
+paths = []
+
+[[modes.pre-commit.checks.gofmt]]
+"quoted key" = 'x'
+
+[modes.pre-commit.checks.gofmt.when]
+ci = false
+`
+	expected := map[string]interface{}{
+		"version":      int64(2),
+		"max_duration": int64(1200),
+		"ratio":        0.5,
+		"ok":           true,
+		"name":         "a \"b\"\tc \u00e9",
+		"raw":          `C:\path`,
+		"multi":        "line1\nline2",
+		"list":         []interface{}{"a", "b"},
+		"inline":       map[string]interface{}{"x": int64(1), "y": map[string]interface{}{"z": "w"}},
+		"modes": map[string]interface{}{
+			"pre-commit": map[string]interface{}{
+				"options": map[string]interface{}{"max_duration": int64(5)},
+				"checks": map[string]interface{}{
+					"gofmt": []interface{}{
+						map[string]interface{}{"paths": []interface{}{}},
+						map[string]interface{}{
+							"quoted key": "x",
+							"when":       map[string]interface{}{"ci": false},
+						},
+					},
+				},
+			},
+		},
+	}
+	actual, err := ParseTOML([]byte(content))
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, expected, actual)
+}
+
+func TestParseTOMLErrors(t *testing.T) {
+	t.Parallel()
+	data := []struct {
+		content  string
+		expected error
+	}{
+		{"a = 1\na = 2\n", errors.New("line 2: duplicate key a")},
+		{"a 1\n", errors.New("line 1: expected = after a")},
+		{"a = 1979-05-27\n", errors.New("line 1: unsupported value \"1979-05-27\"")},
+		{"a = \"b\n", errors.New("line 1: unterminated string")},
+		{"a = 1 b = 2\n", errors.New("line 1: unexpected 'b'")},
+		{"a = 1\n[a]\n", errors.New("line 2: a is not a table")},
+	}
+	for i, item := range data {
+		_, err := ParseTOML([]byte(item.content))
+		ut.AssertEqualIndex(t, i, item.expected, err)
+	}
+}
+
+func TestFormatTOML(t *testing.T) {
+	t.Parallel()
+	v := map[string]interface{}{
+		"version": 2,
+		"skip":    nil,
+		"ratio":   1.0,
+		"name":    "a \"b\"\n",
+		"modes": map[string]interface{}{
+			"pre-commit": map[string]interface{}{
+				"options": map[string]interface{}{"max_duration": 5},
+				"checks": map[string]interface{}{
+					"gofmt": []interface{}{
+						map[string]interface{}{"paths": []interface{}{"a", "b"}},
+					},
+					"custom": []interface{}{},
+				},
+			},
+		},
+		"empty": map[string]interface{}{},
+	}
+	expected := `name = "a \"b\"\n"
+ratio = 1.0
+version = 2
+
+[empty]
+
+[modes.pre-commit.checks]
+custom = []
+
+[[modes.pre-commit.checks.gofmt]]
+paths = ["a", "b"]
+
+[modes.pre-commit.options]
+max_duration = 5
+`
+	content, err := FormatTOML(v)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, expected, string(content))
+
+	// Integers are parsed as int64 and nil values are dropped.
+	delete(v, "skip")
+	v["version"] = int64(2)
+	v["modes"].(map[string]interface{})["pre-commit"].(map[string]interface{})["options"].(map[string]interface{})["max_duration"] = int64(5)
+	actual, err := ParseTOML(content)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, v, actual)
+}
@@ -10,31 +10,41 @@
 package internal
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"log"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
+	"time"
 )
 
+// CommandLog, when set, receives every command run by Capture with its
+// directory, environment overrides, exit code and complete output, e.g. to
+// debug a hook after the fact.
+var CommandLog *log.Logger
+
 // Capture runs an executable from a directory returns the output, exit code
-// and error if appropriate. It sets the environment variables specified.
+// and error if appropriate. It sets the environment variables specified as
+// "KEY=value"; an item without "=" removes the variable instead.
 func Capture(wd string, env []string, args ...string) (string, int, error) {
+	return CaptureContext(context.Background(), wd, env, args...)
+}
+
+// CaptureContext is Capture but the executable and all the processes it
+// started are killed when ctx is done, in which case ctx.Err() is returned.
+func CaptureContext(ctx context.Context, wd string, env []string, args ...string) (string, int, error) {
 	exitCode := -1
-	//log.Printf("Capture(%s, %s, %s)", wd, env, args)
-	var c *exec.Cmd
-	switch len(args) {
-	case 0:
+	if len(args) == 0 {
 		return "", -1, errors.New("no command specified")
-	case 1:
-		c = exec.Command(args[0])
-	default:
-		c = exec.Command(args[0], args[1:]...)
 	}
 	if wd == "" {
 		return "", -1, errors.New("wd is required")
 	}
-	c.Dir = wd
 	procEnv := map[string]string{}
 	for _, item := range os.Environ() {
 		items := strings.SplitN(item, "=", 2)
@@ -43,14 +53,66 @@ func Capture(wd string, env []string, args ...string) (string, int, error) {
 	procEnv["LANG"] = "en_US.UTF-8"
 	procEnv["LANGUAGE"] = "en_US.UTF-8"
 	for _, item := range env {
-		items := strings.SplitN(item, "=", 2)
-		procEnv[items[0]] = items[1]
+		if items := strings.SplitN(item, "=", 2); len(items) == 2 {
+			procEnv[items[0]] = items[1]
+		} else {
+			delete(procEnv, item)
+		}
+	}
+	name := args[0]
+	if path := procEnv["PATH"]; path != os.Getenv("PATH") && !strings.ContainsRune(name, filepath.Separator) {
+		// exec.Command searches the $PATH of this process.
+		if p, err := LookPath(name, path); err == nil {
+			name = p
+		}
 	}
+	c := exec.Command(name, args[1:]...)
+	c.Dir = wd
 	c.Env = make([]string, 0, len(procEnv))
 	for k, v := range procEnv {
 		c.Env = append(c.Env, k+"="+v)
 	}
-	out, err := c.CombinedOutput()
+	var out bytes.Buffer
+	c.Stdout = &out
+	c.Stderr = &out
+	if ctx.Done() != nil {
+		// Only when needed, since the processes in another group don't receive
+		// Ctrl-C from the terminal.
+		newProcessGroup(c)
+	}
+	start := time.Now()
+	if err := c.Start(); err != nil {
+		logCommand(wd, env, args, exitCode, 0, "", err)
+		return "", exitCode, err
+	}
+	// exited is set once Wait returns; the process is reaped then and its
+	// process group id may be reused, so it must not be killed anymore.
+	var lock sync.Mutex
+	exited := false
+	killed := false
+	done := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+			lock.Lock()
+			defer lock.Unlock()
+			if !exited {
+				_ = killProcessTree(c)
+				killed = true
+			}
+		case <-done:
+		}
+	}()
+	err := c.Wait()
+	lock.Lock()
+	exited = true
+	wasKilled := killed
+	lock.Unlock()
+	close(done)
+	if wasKilled {
+		logCommand(wd, env, args, exitCode, time.Since(start), out.String(), ctx.Err())
+		return out.String(), exitCode, ctx.Err()
+	}
 	if c.ProcessState != nil {
 		if waitStatus, ok := c.ProcessState.Sys().(syscall.WaitStatus); ok {
 			exitCode = waitStatus.ExitStatus()
@@ -60,5 +122,63 @@ func Capture(wd string, env []string, args ...string) (string, int, error) {
 		}
 	}
 	// TODO(maruel): Handle code page on Windows.
-	return string(out), exitCode, err
+	logCommand(wd, env, args, exitCode, time.Since(start), out.String(), err)
+	return out.String(), exitCode, err
+}
+
+// LookPath is exec.LookPath searching the directories listed in path instead
+// of $PATH, e.g. to find the executables with the $PATH of another process.
+func LookPath(file, path string) (string, error) {
+	if strings.ContainsRune(file, filepath.Separator) {
+		return exec.LookPath(file)
+	}
+	for _, dir := range filepath.SplitList(path) {
+		if dir == "" {
+			// Unlike exec.LookPath, the current directory is never searched.
+			continue
+		}
+		if p, err := exec.LookPath(filepath.Join(dir, file)); err == nil {
+			return p, nil
+		}
+	}
+	return "", &exec.Error{Name: file, Err: exec.ErrNotFound}
+}
+
+// RedactEnv returns env, a list of "KEY=value" items, with the values of the
+// variables that likely hold a secret replaced, so it can be logged. It is the
+// case of the names containing TOKEN, SECRET, PASSWORD or KEY, e.g.
+// GITHUB_TOKEN.
+func RedactEnv(env []string) []string {
+	out := make([]string, len(env))
+	for i, item := range env {
+		out[i] = item
+		if j := strings.IndexByte(item, '='); j != -1 {
+			name := strings.ToUpper(item[:j])
+			for _, s := range secretNames {
+				if strings.Contains(name, s) {
+					out[i] = item[:j+1] + "<redacted>"
+					break
+				}
+			}
+		}
+	}
+	return out
+}
+
+// Private stuff.
+
+// secretNames are the parts of the names of the environment variables
+// redacted by RedactEnv.
+var secretNames = []string{"TOKEN", "SECRET", "PASSWORD", "KEY"}
+
+// logCommand logs a command run by CaptureContext to CommandLog.
+func logCommand(wd string, env, args []string, exitCode int, duration time.Duration, out string, err error) {
+	if CommandLog == nil {
+		return
+	}
+	status := ""
+	if err != nil {
+		status = "; " + err.Error()
+	}
+	CommandLog.Printf("%s$ %s\nenvironment: %s\nexit code %d in %s%s\n%s", wd, strings.Join(args, " "), strings.Join(RedactEnv(env), " "), exitCode, duration, status, out)
 }
@@ -5,11 +5,17 @@
 package internal
 
 import (
+	"bytes"
+	"context"
 	"errors"
+	"io/ioutil"
+	"log"
 	"os"
+	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/maruel/pre-commit-go/Godeps/_workspace/src/github.com/maruel/ut"
 )
@@ -24,6 +30,78 @@ func TestCaptureNormal(t *testing.T) {
 	ut.AssertEqual(t, nil, err)
 }
 
+func TestCaptureCommandLog(t *testing.T) {
+	// Not parallel since CommandLog is global.
+	b := &bytes.Buffer{}
+	CommandLog = log.New(b, "", 0)
+	defer func() {
+		CommandLog = nil
+	}()
+	wd, err := os.Getwd()
+	ut.AssertEqual(t, nil, err)
+	out, _, err := Capture(wd, []string{"FOO=BAR"}, "go", "version")
+	ut.AssertEqual(t, nil, err)
+	lines := strings.SplitN(b.String(), "\n", 4)
+	ut.AssertEqual(t, wd+"$ go version", lines[0])
+	ut.AssertEqual(t, "environment: FOO=BAR", lines[1])
+	ut.AssertEqual(t, true, strings.HasPrefix(lines[2], "exit code 0 in "))
+	ut.AssertEqual(t, out, lines[3])
+}
+
+func TestCaptureCommandLogRedacted(t *testing.T) {
+	// Not parallel since CommandLog is global.
+	b := &bytes.Buffer{}
+	CommandLog = log.New(b, "", 0)
+	defer func() {
+		CommandLog = nil
+	}()
+	wd, err := os.Getwd()
+	ut.AssertEqual(t, nil, err)
+	_, _, err = Capture(wd, []string{"FOO=BAR", "GITHUB_TOKEN=abc"}, "go", "version")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "environment: FOO=BAR GITHUB_TOKEN=<redacted>", strings.SplitN(b.String(), "\n", 3)[1])
+}
+
+func TestCaptureUnsetEnv(t *testing.T) {
+	t.Parallel()
+	wd, err := os.Getwd()
+	ut.AssertEqual(t, nil, err)
+	out, _, err := Capture(wd, []string{"GOFLAGS=-mod=mod"}, "go", "env", "GOFLAGS")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "-mod=mod\n", out)
+	out, _, err = Capture(wd, []string{"GOFLAGS=-mod=mod", "GOFLAGS"}, "go", "env", "GOFLAGS")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, false, strings.Contains(out, "-mod=mod"))
+}
+
+func TestCapturePath(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	td, cleanup := TempDir(t)
+	defer cleanup()
+	ut.AssertEqual(t, nil, ioutil.WriteFile(filepath.Join(td, "pcg-test-tool"), []byte("#!/bin/sh\necho hi\n"), 0777))
+	p, err := LookPath("pcg-test-tool", td)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, filepath.Join(td, "pcg-test-tool"), p)
+	_, err = LookPath("pcg-test-tool", os.Getenv("PATH"))
+	ut.AssertEqual(t, true, err != nil)
+	// The executable is searched in the $PATH of the command, not of the process.
+	out, code, err := Capture(td, []string{"PATH=" + td + string(os.PathListSeparator) + os.Getenv("PATH")}, "pcg-test-tool")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 0, code)
+	ut.AssertEqual(t, "hi\n", out)
+}
+
+func TestRedactEnv(t *testing.T) {
+	t.Parallel()
+	env := []string{"PATH=/bin", "GITHUB_TOKEN=abc", "aws_secret_access_key=def", "DB_PASSWORD=", "SSH_AUTH_SOCK=/tmp/a", "API_KEY=ghi", "EMPTY"}
+	expected := []string{"PATH=/bin", "GITHUB_TOKEN=<redacted>", "aws_secret_access_key=<redacted>", "DB_PASSWORD=<redacted>", "SSH_AUTH_SOCK=/tmp/a", "API_KEY=<redacted>", "EMPTY"}
+	ut.AssertEqual(t, expected, RedactEnv(env))
+	ut.AssertEqual(t, "abc", env[1][len("GITHUB_TOKEN="):])
+}
+
 func TestCaptureEmpty(t *testing.T) {
 	t.Parallel()
 	wd, err := os.Getwd()
@@ -53,6 +131,22 @@ func TestCaptureMissing(t *testing.T) {
 	ut.AssertEqual(t, true, err != nil)
 }
 
+func TestCaptureContextTimeout(t *testing.T) {
+	t.Parallel()
+	if runtime.GOOS == "windows" {
+		t.Skip("requires sh")
+	}
+	wd, err := os.Getwd()
+	ut.AssertEqual(t, nil, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	// The grandchild keeps the output pipe open; it must be killed too.
+	_, _, err = CaptureContext(ctx, wd, nil, "sh", "-c", "sleep 30 & sleep 30")
+	ut.AssertEqual(t, context.DeadlineExceeded, err)
+	ut.AssertEqual(t, true, time.Since(start) < 10*time.Second)
+}
+
 func TestCaptureNoWd(t *testing.T) {
 	t.Parallel()
 	_, code, err := Capture("", nil, "go")
@@ -44,12 +44,23 @@ type Change interface {
 	// level and generated files (like proto-gen-go generated files) should be
 	// ignored.
 	IsIgnored(p string) bool
+	// Filtered returns this Change without the ignored files: its Sets only
+	// contain the files that are not ignored and the packages that contain at
+	// least one of them. It is computed once and shared by all the checks of a
+	// run, instead of each check filtering the files.
+	Filtered() Change
+	// Tree returns all the files in the checkout that are not ignored,
+	// including the ones not tracked, as 'gofmt .' would see them. The checkout
+	// is walked once, skipping the ignored directories.
+	Tree() []string
 }
 
 // Set is a subset of files/directories/packages relative to the change and the
 // overall repository.
 type Set interface {
-	// GoFiles returns all the source files, including tests.
+	// Files returns all the files, including non Go source files.
+	Files() []string
+	// GoFiles returns all the Go source files, including tests.
 	GoFiles() []string
 	// Packages returns all the packages included in this set, using the relative
 	// notation, e.g. with prefix "./" relative to the checkout root. So this
@@ -77,9 +88,18 @@ type change struct {
 
 	lock    sync.Mutex
 	content map[string][]byte
+	imports *importCache
+	// blobs is the git hash of the files whose imports can be memoized in
+	// imports.
+	blobs map[string]string
+
+	filterOnce sync.Once
+	filtered   *filteredChange
+	treeOnce   sync.Once
+	tree       []string
 }
 
-func newChange(r ReadOnlyRepo, files, allFiles, ignorePatterns IgnorePatterns) *change {
+func newChange(r ReadOnlyRepo, files, allFiles, ignorePatterns IgnorePatterns, imports *importCache, blobs map[string]string) *change {
 	//log.Printf("Change{%s, %s}", files, allFiles)
 	root := r.Root()
 	// An error occurs when the repository is not inside GOPATH. Ignore this
@@ -90,7 +110,11 @@ func newChange(r ReadOnlyRepo, files, allFiles, ignorePatterns IgnorePatterns) *
 		packageName:    pkgName,
 		ignorePatterns: ignorePatterns,
 		content:        map[string][]byte{},
+		imports:        imports,
+		blobs:          blobs,
 	}
+	c.direct.files = files
+	c.all.files = allFiles
 
 	// Map of <relative directory> : <relative package>
 	testDirs := map[string]string{}
@@ -99,7 +123,7 @@ func newChange(r ReadOnlyRepo, files, allFiles, ignorePatterns IgnorePatterns) *
 		if !strings.HasSuffix(f, ".go") {
 			continue
 		}
-		c.direct.files = append(c.direct.files, f)
+		c.direct.goFiles = append(c.direct.goFiles, f)
 		dir := dirName(f)
 		if _, ok := sourceDirs[dir]; !ok {
 			relPkgName := dirToPkg(dir)
@@ -126,7 +150,7 @@ func newChange(r ReadOnlyRepo, files, allFiles, ignorePatterns IgnorePatterns) *
 		if !strings.HasSuffix(f, ".go") {
 			continue
 		}
-		c.all.files = append(c.all.files, f)
+		c.all.goFiles = append(c.all.goFiles, f)
 		dir := dirName(f)
 		allDirs[dir] = append(allDirs[dir], filepath.Base(f))
 		if _, ok := allSourceDirs[dir]; !ok {
@@ -172,6 +196,7 @@ func newChange(r ReadOnlyRepo, files, allFiles, ignorePatterns IgnorePatterns) *
 	}()
 	wg.Wait()
 
+	c.indirect.goFiles = c.direct.goFiles
 	c.indirect.files = c.direct.files
 	if len(c.direct.packages) == len(c.all.packages) && len(c.direct.testPackages) == len(c.all.testPackages) {
 		// Everything is affected. Skip processing files.
@@ -210,12 +235,7 @@ func newChange(r ReadOnlyRepo, files, allFiles, ignorePatterns IgnorePatterns) *
 						wg.Done()
 						parallel <- true
 					}()
-					content := c.Content(filepath.Join(baseDir, f))
-					if content == nil {
-						return
-					}
-					_, localImports := getImports(content)
-					for _, imp := range localImports {
+					for _, imp := range c.fileImports(filepath.Join(baseDir, f)) {
 						if importedDir, ok := allPkgs[imp]; ok {
 							isTest := strings.HasSuffix(f, "_test.go")
 							c.lock.Lock()
@@ -324,20 +344,170 @@ func (c *change) Content(p string) []byte {
 	return content
 }
 
+// fileImports returns the imports of the Go file p, memoized in c.imports when
+// its content is the one in the index.
+func (c *change) fileImports(p string) []string {
+	blob := c.blobs[p]
+	if c.imports != nil && blob != "" {
+		if imports, ok := c.imports.get(blob); ok {
+			return imports
+		}
+	}
+	content := c.Content(p)
+	if content == nil {
+		return nil
+	}
+	_, imports := getImports(content)
+	if c.imports != nil && blob != "" {
+		c.imports.set(blob, imports)
+	}
+	return imports
+}
+
 func (c *change) IsIgnored(p string) bool {
 	return c.ignorePatterns.Match(p)
 }
 
+func (c *change) Filtered() Change {
+	c.filterOnce.Do(func() {
+		// Set of relative packages containing at least one Go file not ignored.
+		pkgs := map[string]bool{}
+		testPkgs := map[string]bool{}
+		for _, f := range c.all.goFiles {
+			if !c.IsIgnored(f) {
+				pkg := dirToPkg(dirName(f))
+				pkgs[pkg] = true
+				if strings.HasSuffix(f, "_test.go") {
+					testPkgs[pkg] = true
+				}
+			}
+		}
+		c.filtered = &filteredChange{
+			change:   c,
+			direct:   c.filterSet(&c.direct, pkgs, testPkgs),
+			indirect: c.filterSet(&c.indirect, pkgs, testPkgs),
+			all:      c.filterSet(&c.all, pkgs, testPkgs),
+		}
+	})
+	return c.filtered
+}
+
+func (c *change) Tree() []string {
+	c.treeOnce.Do(func() {
+		root := c.repo.Root()
+		_ = filepath.Walk(root, func(p string, info os.FileInfo, err error) error {
+			if err != nil || p == root {
+				return nil
+			}
+			rel := p[len(root)+1:]
+			if c.IsIgnored(rel) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !info.IsDir() {
+				c.tree = append(c.tree, rel)
+			}
+			return nil
+		})
+	})
+	return c.tree
+}
+
+// filterSet returns s without the ignored files and the packages not in pkgs
+// and testPkgs.
+func (c *change) filterSet(s *set, pkgs, testPkgs map[string]bool) set {
+	var out set
+	for _, f := range s.files {
+		if !c.IsIgnored(f) {
+			out.files = append(out.files, f)
+		}
+	}
+	for _, f := range s.goFiles {
+		if !c.IsIgnored(f) {
+			out.goFiles = append(out.goFiles, f)
+		}
+	}
+	for _, p := range s.packages {
+		if pkgs[p] {
+			out.packages = append(out.packages, p)
+		}
+	}
+	for _, p := range s.testPackages {
+		if testPkgs[p] {
+			out.testPackages = append(out.testPackages, p)
+		}
+	}
+	return out
+}
+
+// filteredChange is the Change returned by change.Filtered().
+type filteredChange struct {
+	*change
+	direct   set
+	indirect set
+	all      set
+}
+
+func (f *filteredChange) Changed() Set {
+	return &f.direct
+}
+
+func (f *filteredChange) Indirect() Set {
+	return &f.indirect
+}
+
+func (f *filteredChange) All() Set {
+	return &f.all
+}
+
+func (f *filteredChange) Filtered() Change {
+	return f
+}
+
+// maxImportCache is the number of files whose imports are memoized by an
+// importCache; it is emptied once exceeded.
+const maxImportCache = 100000
+
+// importCache memoizes the imports of the Go files by their git hash, see
+// Repo.CacheImports().
+type importCache struct {
+	lock    sync.Mutex
+	imports map[string][]string
+}
+
+func (i *importCache) get(blob string) ([]string, bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	imports, ok := i.imports[blob]
+	return imports, ok
+}
+
+func (i *importCache) set(blob string, imports []string) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if len(i.imports) >= maxImportCache {
+		i.imports = map[string][]string{}
+	}
+	i.imports[blob] = imports
+}
+
 type set struct {
 	files        []string
+	goFiles      []string
 	packages     []string
 	testPackages []string
 }
 
-func (s *set) GoFiles() []string {
+func (s *set) Files() []string {
 	return s.files
 }
 
+func (s *set) GoFiles() []string {
+	return s.goFiles
+}
+
 func (s *set) Packages() []string {
 	return s.packages
 }
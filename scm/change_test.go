@@ -42,10 +42,11 @@ func TestChangeEmpty(t *testing.T) {
 	r := &dummyRepo{t, "<root>"}
 	files := []string{}
 	allFiles := []string{}
-	c := newChange(r, files, allFiles, nil)
+	c := newChange(r, files, allFiles, nil, nil, nil)
 	ut.AssertEqual(t, r, c.Repo())
 	ut.AssertEqual(t, "", c.Package())
 	changed := c.Changed()
+	ut.AssertEqual(t, []string{}, changed.Files())
 	ut.AssertEqual(t, []string(nil), changed.GoFiles())
 	ut.AssertEqual(t, []string(nil), changed.Packages())
 	ut.AssertEqual(t, []string(nil), changed.TestPackages())
@@ -59,14 +60,56 @@ func TestChangeEmpty(t *testing.T) {
 	ut.AssertEqual(t, []string(nil), all.TestPackages())
 }
 
+func TestChangeFiles(t *testing.T) {
+	t.Parallel()
+	files := []string{"README.md", "foo.go", "scripts/a.py"}
+	c := newChange(&dummyRepo{t, "<root>"}, files, files, nil, nil, nil)
+	ut.AssertEqual(t, files, c.Changed().Files())
+	ut.AssertEqual(t, files, c.Indirect().Files())
+	ut.AssertEqual(t, files, c.All().Files())
+	ut.AssertEqual(t, []string{"foo.go"}, c.Changed().GoFiles())
+	ut.AssertEqual(t, []string{"foo.go"}, c.All().GoFiles())
+}
+
 func TestChangIgnore(t *testing.T) {
 	t.Parallel()
-	c := newChange(&dummyRepo{t, "<root>"}, nil, nil, IgnorePatterns{"*.pb.go"})
+	c := newChange(&dummyRepo{t, "<root>"}, nil, nil, IgnorePatterns{"*.pb.go"}, nil, nil)
 	ut.AssertEqual(t, false, c.IsIgnored("foo.go"))
 	ut.AssertEqual(t, true, c.IsIgnored("foo.pb.go"))
 	ut.AssertEqual(t, true, c.IsIgnored("bar/foo.pb.go"))
 }
 
+func TestChangeFiltered(t *testing.T) {
+	t.Parallel()
+	files := []string{"README.md", "a/a.pb.go", "b/b.go", "b/b_test.go", "b/c.pb.go", "foo.go"}
+	c := newChange(&dummyRepo{t, "<root>"}, files, files, IgnorePatterns{"*.pb.go"}, nil, nil)
+	f := c.Filtered()
+	ut.AssertEqual(t, f, c.Filtered())
+	ut.AssertEqual(t, f, f.Filtered())
+	ut.AssertEqual(t, []string{".", "./a", "./b"}, c.Changed().Packages())
+	ut.AssertEqual(t, []string{"README.md", "b/b.go", "b/b_test.go", "foo.go"}, f.Changed().Files())
+	ut.AssertEqual(t, []string{"b/b.go", "b/b_test.go", "foo.go"}, f.Changed().GoFiles())
+	ut.AssertEqual(t, []string{".", "./b"}, f.Changed().Packages())
+	ut.AssertEqual(t, []string{"./b"}, f.Changed().TestPackages())
+	ut.AssertEqual(t, []string{".", "./b"}, f.All().Packages())
+	ut.AssertEqual(t, c.Content("README.md"), f.Content("README.md"))
+}
+
+func TestChangeTree(t *testing.T) {
+	t.Parallel()
+	root, _, cleanup := makeTree(t, map[string]string{
+		"a.go":          "package a",
+		"_vendor/b.go":  "package b",
+		"c/c.go":        "package c",
+		"c/c.pb.go":     "package c",
+		".hidden/d.txt": "",
+	})
+	defer cleanup()
+	c := newChange(&dummyRepo{t, root}, nil, nil, IgnorePatterns{".*", "_*", "*.pb.go"}, nil, nil)
+	// The files are listed even if not tracked.
+	ut.AssertEqual(t, []string{"a.go", filepath.Join("c", "c.go")}, c.Tree())
+}
+
 var commonTree = map[string]string{
 	"bar/bar.go":      "package bar\nfunc Bar() int { return 1}",
 	"bar/bar_test.go": "package bar",
@@ -100,7 +143,7 @@ func TestChangeIndirect(t *testing.T) {
 		})
 	defer cleanup()
 	r := &dummyRepo{t, root}
-	c := newChange(r, []string{"a/a.go"}, allFiles, nil)
+	c := newChange(r, []string{"a/a.go"}, allFiles, nil, nil, nil)
 	ut.AssertEqual(t, r, c.Repo())
 	ut.AssertEqual(t, "", c.Package())
 	changed := c.Changed()
@@ -143,7 +186,7 @@ func TestChangeIndirectReverse(t *testing.T) {
 		})
 	defer cleanup()
 	r := &dummyRepo{t, root}
-	c := newChange(r, []string{"z/z.go"}, allFiles, nil)
+	c := newChange(r, []string{"z/z.go"}, allFiles, nil, nil, nil)
 	ut.AssertEqual(t, r, c.Repo())
 	ut.AssertEqual(t, "", c.Package())
 	changed := c.Changed()
@@ -176,7 +219,7 @@ func TestChangeAll(t *testing.T) {
 		})
 	defer cleanup()
 	r := &dummyRepo{t, root}
-	c := newChange(r, []string{"bar/bar.go", "foo/foo.go", "main.go"}, allFiles, nil)
+	c := newChange(r, []string{"bar/bar.go", "foo/foo.go", "main.go"}, allFiles, nil, nil, nil)
 	ut.AssertEqual(t, r, c.Repo())
 	ut.AssertEqual(t, "", c.Package())
 	changed := c.Changed()
@@ -314,23 +357,47 @@ func (d *dummyRepo) HEAD() Commit                  { d.t.FailNow(); return "" }
 func (d *dummyRepo) Ref() string                   { d.t.FailNow(); return "" }
 func (d *dummyRepo) Upstream() (Commit, error)     { d.t.FailNow(); return "", nil }
 func (d *dummyRepo) Eval(e string) (Commit, error) { d.t.FailNow(); return "", nil }
+func (d *dummyRepo) MergeBase(a, b Commit) (Commit, error) {
+	d.t.FailNow()
+	return "", nil
+}
+func (d *dummyRepo) CountCommits(old, recent Commit) (int, error) {
+	d.t.FailNow()
+	return 0, nil
+}
+func (d *dummyRepo) Unpushed(recent Commit) (Commit, error) {
+	d.t.FailNow()
+	return "", nil
+}
+func (d *dummyRepo) Message(c Commit) (string, error) {
+	d.t.FailNow()
+	return "", nil
+}
+func (d *dummyRepo) UserEmail() string        { d.t.FailNow(); return "" }
+func (d *dummyRepo) Config(key string) string { d.t.FailNow(); return "" }
+func (d *dummyRepo) Blame(file string) ([]string, error) {
+	d.t.FailNow()
+	return nil, nil
+}
 func (d *dummyRepo) Between(recent, old Commit, ignoredPaths IgnorePatterns) (Change, error) {
 	d.t.FailNow()
 	return nil, nil
 }
+func (d *dummyRepo) Staged(ignoredPaths IgnorePatterns) ([]string, error) {
+	d.t.FailNow()
+	return nil, nil
+}
+func (d *dummyRepo) Files(files []string, ignoredPaths IgnorePatterns) (Change, error) {
+	d.t.FailNow()
+	return nil, nil
+}
 func (d *dummyRepo) GOPATH() string { return d.root }
 
 // makeTree creates a temporary directory and creates the files in it.
 //
 // Returns the root directly, all files created and the cleanup function.
 func makeTree(t *testing.T, files map[string]string) (string, []string, func()) {
-	td, err := ioutil.TempDir("", "pre-commit-go")
-	ut.AssertEqual(t, nil, err)
-	cleanup := func() {
-		if err := internal.RemoveAll(td); err != nil {
-			t.Fail()
-		}
-	}
+	td, cleanup := internal.TempDir(t)
 	allFiles := make([]string, 0, len(files))
 	for f, c := range files {
 		allFiles = append(allFiles, f)
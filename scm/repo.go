@@ -8,11 +8,14 @@ package scm
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -29,6 +32,8 @@ const (
 	GitInitialCommit Commit = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
 	// Current is a meta-reference to the current tree.
 	Current Commit = ""
+	// NotCommittedYet is the author returned by Blame() for modified lines.
+	NotCommittedYet = "not.committed.yet"
 )
 
 // ReadOnlyRepo represents a source control managemed checkout.
@@ -54,6 +59,27 @@ type ReadOnlyRepo interface {
 	Upstream() (Commit, error)
 	// Eval returns the commit hash by evaluating refish.
 	Eval(refish string) (Commit, error)
+	// MergeBase returns the best common ancestor of two commits.
+	MergeBase(a, b Commit) (Commit, error)
+	// CountCommits returns the number of commits reachable from recent but not
+	// from old.
+	CountCommits(old, recent Commit) (int, error)
+	// Unpushed returns the commit to diff recent against to get the changes of
+	// the commits reachable from recent but from none of the remote-tracking
+	// branches. It is recent itself if all of them were already pushed and
+	// GitInitialCommit if none of them has a parent.
+	Unpushed(recent Commit) (Commit, error)
+	// Message returns the commit message of a commit.
+	Message(c Commit) (string, error)
+	// UserEmail returns the email of the configured user, "" if none.
+	UserEmail() string
+	// Config returns the value of the repository configuration key, "" if
+	// unset.
+	Config(key string) string
+	// Blame returns the email of the author of each line of a file in the
+	// current tree; the first item is line 1. Lines not committed yet are
+	// attributed to NotCommittedYet.
+	Blame(file string) ([]string, error)
 
 	// Between returns a change with files touched between from and to in it.
 	// If recent is Current, it diffs against the current tree, independent of
@@ -72,6 +98,16 @@ type ReadOnlyRepo interface {
 	//
 	// Returns nil and no error if there's no file difference.
 	Between(recent, old Commit, ignorePatterns IgnorePatterns) (Change, error)
+	// Staged returns the files added, modified or deleted in the index compared
+	// to HEAD, without the ignored ones. It is much cheaper than Between() since
+	// the packages are not enumerated.
+	Staged(ignorePatterns IgnorePatterns) ([]string, error)
+	// Files returns a change with only the specified files in it, as found in
+	// the current tree. files are relative to Root(). Untracked files are
+	// accepted; they are added to the files in the tree.
+	//
+	// Returns nil and no error if all the files are ignored.
+	Files(files []string, ignorePatterns IgnorePatterns) (Change, error)
 	// GOPATH returns the GOPATH. Mostly used in tests.
 	GOPATH() string
 }
@@ -82,12 +118,43 @@ type ReadOnlyRepo interface {
 // interface.
 type Repo interface {
 	ReadOnlyRepo
-	// Stash stashes the content that is not in the index.
-	Stash() (bool, error)
+	// Stash stashes the content that is not in the index. untracked defines
+	// what happens to the untracked files.
+	Stash(untracked Untracked) (bool, error)
 	// Stash restores the stash generated from Stash.
 	Restore() error
-	// Checkout checks out a commit or a branch.
+	// Checkout checks out a commit or a branch. The post-checkout hook is not
+	// run.
 	Checkout(ref string) error
+	// Fetch fetches refspecs from a remote. The fetched commits are then
+	// available via Eval("FETCH_HEAD") or by their hash.
+	Fetch(remote string, refspecs ...string) error
+	// Add adds the current content of files, relative to Root(), to the index.
+	Add(files ...string) error
+	// SetConfig sets the repository configuration key to value, in the local
+	// configuration of the checkout.
+	SetConfig(key, value string) error
+	// CheckoutIndex checks out the content of the index, e.g. the changes to be
+	// committed, in a new linked worktree at dir with HEAD detached on the
+	// current commit. Unlike Stash, the checkout is left untouched, even if the
+	// process is interrupted.
+	//
+	// The returned repository uses gopath and keeps its state files in the
+	// ScmDir() of this one. Remove it with RemoveWorktree.
+	CheckoutIndex(dir, gopath string) (Repo, error)
+	// RemoveWorktree removes the linked worktree at dir.
+	RemoveWorktree(dir string) error
+	// WithEnv returns this repository running the git commands with the
+	// environment variables env set, e.g. the ones of a hook forwarded to
+	// another process. Like with internal.Capture, an item without "=" removes
+	// the variable.
+	WithEnv(env []string) Repo
+	// CacheImports makes this repository memoize the imports of the Go files
+	// enumerated by Between() and Files() by their content in the index, for a
+	// long running process like 'pcg daemon' that only needs to parse the files
+	// modified since its previous run. It is shared by the repositories
+	// returned by WithEnv() and CheckoutIndex() from then on.
+	CacheImports()
 }
 
 // GetRepo returns a valid Repo if one is found.
@@ -95,23 +162,62 @@ func GetRepo(wd, gopath string) (Repo, error) {
 	return getRepo(wd, gopath)
 }
 
+// Untracked defines how Stash handles the untracked files, the files neither
+// tracked nor ignored.
+type Untracked string
+
+// All the supported handlings of the untracked files.
+const (
+	// UntrackedExclude stashes the untracked files with the changes not in the
+	// index, so they are not in the checkout while it is stashed. It is the
+	// default.
+	UntrackedExclude Untracked = "exclude"
+	// UntrackedInclude leaves the untracked files in the checkout.
+	UntrackedInclude Untracked = "include"
+	// UntrackedFail refuses to stash when there are untracked files.
+	UntrackedFail Untracked = "fail"
+)
+
+// UnmarshalYAML implements yaml.Unmarshaler.
+func (u *Untracked) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	v := ""
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+	val := Untracked(v)
+	if val != UntrackedExclude && val != UntrackedInclude && val != UntrackedFail {
+		return fmt.Errorf("invalid untracked \"%s\"", val)
+	}
+	*u = val
+	return nil
+}
+
 // IgnorePatterns is a list of glob that when matching, means the file should
 // be ignored.
+//
+// A pattern without "/" is matched against each path component. A pattern
+// with "/" is matched against the path relative to the repository root, or
+// one of its parent directories, where "**" matches any number of
+// directories. A pattern prefixed with "!" includes back the files ignored by
+// the previous patterns; the last matching pattern wins.
 type IgnorePatterns []string
 
 // Match returns true when the file should be ignored.
 func (i *IgnorePatterns) Match(p string) bool {
-	chunks := strings.Split(p, pathSeparator)
+	chunks := strings.Split(filepath.ToSlash(p), "/")
+	ignored := false
 	for _, ignorePattern := range *i {
-		for _, chunk := range chunks {
-			if matched, err := filepath.Match(ignorePattern, chunk); matched {
-				return true
-			} else if err != nil {
-				log.Printf("bad pattern %q", ignorePattern)
-			}
+		pattern := strings.TrimPrefix(ignorePattern, "!")
+		negate := pattern != ignorePattern
+		if ignored != negate {
+			// This pattern can't change the outcome.
+			continue
+		}
+		if matchIgnore(pattern, chunks) {
+			ignored = !negate
 		}
 	}
-	return false
+	return ignored
 }
 
 func (i *IgnorePatterns) String() string {
@@ -126,6 +232,29 @@ func (i *IgnorePatterns) Set(value string) error {
 
 // Private details.
 
+// matchIgnore returns true if the path components chunks match pattern, as
+// described in IgnorePatterns.
+func matchIgnore(pattern string, chunks []string) bool {
+	if !strings.Contains(pattern, "/") {
+		for _, chunk := range chunks {
+			if matched, err := path.Match(pattern, chunk); matched {
+				return true
+			} else if err != nil {
+				log.Printf("bad pattern %q", pattern)
+				return false
+			}
+		}
+		return false
+	}
+	pattern = strings.Trim(pattern, "/")
+	for n := len(chunks); n > 0; n-- {
+		if internal.MatchPath(pattern, strings.Join(chunks[:n], "/")) {
+			return true
+		}
+	}
+	return false
+}
+
 var reCommit = regexp.MustCompile("^[0-9a-f]{40}$")
 
 type repo interface {
@@ -153,6 +282,12 @@ func getRepo(wd, gopath string) (repo, error) {
 type git struct {
 	root   string
 	gopath string
+	// env is set for a worktree created by CheckoutIndex, so the git commands
+	// don't use the variables git sets for the hooks of the main checkout, like
+	// GIT_INDEX_FILE.
+	env []string
+	// imports is set by CacheImports.
+	imports *importCache
 
 	lock   sync.Mutex
 	gitDir string
@@ -211,6 +346,77 @@ func (g *git) Eval(refish string) (Commit, error) {
 	return "", fmt.Errorf("couldn't evaluate %s", refish)
 }
 
+func (g *git) MergeBase(a, b Commit) (Commit, error) {
+	if out, code, _ := g.capture(nil, "merge-base", string(a), string(b)); code == 0 {
+		return Commit(out), nil
+	}
+	return "", fmt.Errorf("no common ancestor between %s and %s", a, b)
+}
+
+func (g *git) CountCommits(old, recent Commit) (int, error) {
+	out, code, _ := g.capture(nil, "rev-list", "--count", string(old)+".."+string(recent))
+	if code != 0 {
+		return 0, fmt.Errorf("failed to count commits between %s and %s", old, recent)
+	}
+	n, err := strconv.Atoi(out)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count commits between %s and %s: %s", old, recent, err)
+	}
+	return n, nil
+}
+
+func (g *git) Unpushed(recent Commit) (Commit, error) {
+	// The first line lists the oldest commit not pushed, followed by its parents.
+	out, code, _ := g.capture(nil, "rev-list", "--reverse", "--topo-order", "--parents", string(recent), "--not", "--remotes")
+	if code != 0 {
+		return "", fmt.Errorf("failed to list the commits of %s not pushed", recent)
+	}
+	if out == "" {
+		return recent, nil
+	}
+	if i := strings.IndexByte(out, '\n'); i != -1 {
+		out = out[:i]
+	}
+	items := strings.Fields(out)
+	if len(items) < 2 {
+		return GitInitialCommit, nil
+	}
+	return Commit(items[1]), nil
+}
+
+func (g *git) Message(c Commit) (string, error) {
+	out, code, _ := g.capture(nil, "log", "-1", "--format=%B", string(c))
+	if code != 0 {
+		return "", fmt.Errorf("failed to get the message of %s", c)
+	}
+	return out, nil
+}
+
+func (g *git) UserEmail() string {
+	return g.Config("user.email")
+}
+
+func (g *git) Config(key string) string {
+	if out, code, _ := g.capture(nil, "config", "--get", key); code == 0 {
+		return out
+	}
+	return ""
+}
+
+func (g *git) Blame(file string) ([]string, error) {
+	out, code, _ := g.capture(nil, "blame", "--line-porcelain", "--", file)
+	if code != 0 {
+		return nil, fmt.Errorf("blame failed:\n%s", out)
+	}
+	var authors []string
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "author-mail ") {
+			authors = append(authors, strings.Trim(line[len("author-mail "):], "<>"))
+		}
+	}
+	return authors, nil
+}
+
 func (g *git) untracked() []string {
 	return g.captureList(nil, nil, "ls-files", "--others", "--exclude-standard", "-z")
 }
@@ -223,6 +429,17 @@ func (g *git) staged() []string {
 	return g.captureList(nil, nil, "diff", "--name-only", "--no-color", "--no-ext-diff", "--cached", "--diff-filter=ACMRT", "-z")
 }
 
+func (g *git) Staged(ignorePatterns IgnorePatterns) ([]string, error) {
+	out, code, err := g.capture(nil, "diff", "--cached", "--name-only", "--no-renames", "--no-color", "--no-ext-diff", "-z")
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return nil, fmt.Errorf("git diff --cached failed: %s", out)
+	}
+	return splitList(out, ignorePatterns), nil
+}
+
 func (g *git) Between(recent, old Commit, ignorePatterns IgnorePatterns) (Change, error) {
 	log.Printf("Between(%q, %q, %s)", recent, old, ignorePatterns)
 	if old == Current {
@@ -298,24 +515,65 @@ func (g *git) Between(recent, old Commit, ignorePatterns IgnorePatterns) (Change
 	sort.Strings(allFiles)
 	wg.Wait()
 
-	return newChange(g, files, allFiles, ignorePatterns), nil
+	return newChange(g, files, allFiles, ignorePatterns, g.imports, g.blobs()), nil
+}
+
+func (g *git) Files(files []string, ignorePatterns IgnorePatterns) (Change, error) {
+	log.Printf("Files(%q, %s)", files, ignorePatterns)
+	filesSet := map[string]bool{}
+	for _, f := range files {
+		f = filepath.ToSlash(filepath.Clean(f))
+		if f == "." || f == ".." || strings.HasPrefix(f, "../") || filepath.IsAbs(f) {
+			return nil, fmt.Errorf("%s is outside the repository", f)
+		}
+		if fi, err := os.Stat(filepath.Join(g.root, f)); err != nil || fi.IsDir() {
+			return nil, fmt.Errorf("%s is not a file", f)
+		}
+		if !ignorePatterns.Match(f) {
+			filesSet[f] = true
+		}
+	}
+	if len(filesSet) == 0 {
+		return nil, nil
+	}
+	selected := make([]string, 0, len(filesSet))
+	for f := range filesSet {
+		selected = append(selected, f)
+	}
+	sort.Strings(selected)
+
+	// Add the untracked files to the files in the tree.
+	allFiles := g.captureList(nil, ignorePatterns, "ls-files", "-z")
+	for _, f := range allFiles {
+		delete(filesSet, f)
+	}
+	for f := range filesSet {
+		allFiles = append(allFiles, f)
+	}
+	sort.Strings(allFiles)
+	return newChange(g, selected, allFiles, ignorePatterns, g.imports, g.blobs()), nil
 }
 
 func (g *git) GOPATH() string {
 	return g.gopath
 }
 
-func (g *git) Stash() (bool, error) {
-	// Ensure everything is either tracked or ignored. This is because git stash
-	// doesn't stash untracked files.
-	// The 2 checks are run in parallel with the first stashing command.
+func (g *git) Stash(untracked Untracked) (bool, error) {
+	if untracked == "" {
+		untracked = UntrackedExclude
+	}
+	// git stash only stashes the untracked files when asked to, so they are
+	// listed first. The 2 checks are run in parallel with the first stashing
+	// command.
+	hasUntracked := false
 	errUntrackedCh := make(chan error)
 	go func() {
-		if untracked := g.untracked(); untracked == nil {
+		if files := g.untracked(); files == nil {
 			errUntrackedCh <- errors.New("failed to get list of untracked files")
-		} else if len(untracked) != 0 {
-			errUntrackedCh <- fmt.Errorf("can't stash if there are untracked files: %q", untracked)
+		} else if len(files) != 0 && untracked == UntrackedFail {
+			errUntrackedCh <- fmt.Errorf("can't stash if there are untracked files: %q", files)
 		} else {
+			hasUntracked = len(files) != 0 && untracked == UntrackedExclude
 			errUntrackedCh <- nil
 		}
 	}()
@@ -343,15 +601,19 @@ func (g *git) Stash() (bool, error) {
 	if err := <-errUntrackedCh; err != nil {
 		return false, err
 	}
-	if err := <-errUnstagedCh; err == ignore {
+	if err := <-errUnstagedCh; err == ignore && !hasUntracked {
 		// No need to stash, there's no unstaged files.
 		return false, nil
-	} else if err != nil {
+	} else if err != nil && err != ignore {
 		return false, err
 	}
 	oldStash := <-oldStashCh
 
-	if out, e, err := g.capture(nil, "stash", "save", "-q", "--keep-index"); e != 0 || err != nil {
+	args := []string{"stash", "save", "-q", "--keep-index"}
+	if hasUntracked {
+		args = append(args, "--include-untracked")
+	}
+	if out, e, err := g.capture(nil, args...); e != 0 || err != nil {
 		if g.HEAD() == GitInitialCommit {
 			return false, errors.New("Can't stash until there's at least one commit")
 		}
@@ -377,15 +639,105 @@ func (g *git) Restore() error {
 	return nil
 }
 
+func (g *git) CheckoutIndex(dir, gopath string) (Repo, error) {
+	if g.HEAD() == GitInitialCommit {
+		return nil, errors.New("can't create a worktree until there's at least one commit")
+	}
+	scmDir, err := g.ScmDir()
+	if err != nil {
+		return nil, err
+	}
+	// The index being committed isn't necessarily .git/index, e.g. with "git
+	// commit -a", so it is written as a tree in the hook's environment.
+	tree, e, err := g.capture(nil, "write-tree")
+	if e != 0 || err != nil {
+		return nil, fmt.Errorf("failed to write the index:\n%s", tree)
+	}
+	if out, e, err := g.capture(nil, "worktree", "add", "-q", "--detach", "--no-checkout", dir, string(g.HEAD())); e != 0 || err != nil {
+		return nil, fmt.Errorf("failed to create the worktree:\n%s", out)
+	}
+	gitDir, err := findGitDir(dir)
+	if err != nil {
+		_ = g.RemoveWorktree(dir)
+		return nil, err
+	}
+	w := &git{
+		root:    dir,
+		gopath:  gopath,
+		env:     append(append([]string{}, g.env...), "GIT_DIR="+gitDir, "GIT_WORK_TREE="+dir, "GIT_INDEX_FILE="+filepath.Join(gitDir, "index")),
+		imports: g.imports,
+		gitDir:  scmDir,
+	}
+	for _, args := range [][]string{{"read-tree", tree}, {"checkout-index", "-a", "-f", "-q"}} {
+		if out, e, err := w.capture(nil, args...); e != 0 || err != nil {
+			_ = g.RemoveWorktree(dir)
+			return nil, fmt.Errorf("failed to check out the index:\n%s", out)
+		}
+	}
+	return w, nil
+}
+
+func (g *git) RemoveWorktree(dir string) error {
+	if out, e, err := g.capture(nil, "worktree", "remove", "--force", dir); e != 0 || err != nil {
+		return fmt.Errorf("failed to remove the worktree:\n%s", out)
+	}
+	return nil
+}
+
+func (g *git) WithEnv(env []string) Repo {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return &git{
+		root:    g.root,
+		gopath:  g.gopath,
+		env:     append(append([]string{}, g.env...), env...),
+		imports: g.imports,
+		gitDir:  g.gitDir,
+	}
+}
+
+func (g *git) CacheImports() {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	if g.imports == nil {
+		g.imports = &importCache{imports: map[string][]string{}}
+	}
+}
+
 func (g *git) Checkout(ref string) error {
-	if out, e, err := g.capture(nil, "checkout", "-f", "-q", ref); e != 0 || err != nil {
+	// The hooks are disabled since the checkout is transient, e.g. pcg's own
+	// post-checkout hook must not install the hooks of the commit checked.
+	if out, e, err := g.capture(nil, "-c", "core.hooksPath="+os.DevNull, "checkout", "-f", "-q", ref); e != 0 || err != nil {
 		return fmt.Errorf("checkout failed:\n%s", out)
 	}
 	return nil
 }
 
+func (g *git) Fetch(remote string, refspecs ...string) error {
+	args := append([]string{"fetch", "-q", remote}, refspecs...)
+	if out, e, err := g.capture(nil, args...); e != 0 || err != nil {
+		return fmt.Errorf("fetch failed:\n%s", out)
+	}
+	return nil
+}
+
+func (g *git) Add(files ...string) error {
+	args := append([]string{"add", "--"}, files...)
+	if out, e, err := g.capture(nil, args...); e != 0 || err != nil {
+		return fmt.Errorf("add failed:\n%s", out)
+	}
+	return nil
+}
+
+func (g *git) SetConfig(key, value string) error {
+	if out, e, err := g.capture(nil, "config", "--local", key, value); e != 0 || err != nil {
+		return fmt.Errorf("config failed:\n%s", out)
+	}
+	return nil
+}
+
 func (g *git) capture(env []string, args ...string) (string, int, error) {
-	out, code, err := internal.Capture(g.root, env, append([]string{"git"}, args...)...)
+	out, code, err := internal.Capture(g.root, append(append([]string{}, g.env...), env...), append([]string{"git"}, args...)...)
 	return strings.TrimRight(out, "\n\r"), code, err
 }
 
@@ -402,6 +754,12 @@ func (g *git) captureList(env []string, ignorePatterns IgnorePatterns, args ...s
 	if code != 0 || err != nil {
 		return nil
 	}
+	return splitList(out, ignorePatterns)
+}
+
+// splitList splits the output of a git command using the -z argument and
+// strips the files in ignorePatterns.
+func splitList(out string, ignorePatterns IgnorePatterns) []string {
 	// Reduce initial memory allocation churn.
 	list := make([]string, 0, 128)
 	for {
@@ -418,10 +776,67 @@ func (g *git) captureList(env []string, ignorePatterns IgnorePatterns, args ...s
 	return list
 }
 
+// blobs returns the git hash of the files in the checkout whose content is the
+// one in the index, to look up their imports in g.imports. It returns nil when
+// the imports are not memoized.
+func (g *git) blobs() map[string]string {
+	if g.imports == nil {
+		return nil
+	}
+	out, code, err := g.capture(nil, "ls-files", "-s", "-z")
+	if code != 0 || err != nil {
+		return nil
+	}
+	blobs := map[string]string{}
+	for _, line := range strings.Split(out, "\x00") {
+		// "<mode> <hash> <stage>\t<file>"
+		i := strings.IndexByte(line, '\t')
+		if i == -1 {
+			continue
+		}
+		if fields := strings.Fields(line[:i]); len(fields) == 3 {
+			blobs[line[i+1:]] = fields[1]
+		}
+	}
+	for _, f := range g.unstaged() {
+		delete(blobs, f)
+	}
+	return blobs
+}
+
 func (g *git) isValid(c Commit) bool {
 	return reCommit.MatchString(string(c))
 }
 
+// findGitDir returns the .git directory of the checkout rooted at dir, without
+// running git so the environment of a hook doesn't interfere.
+//
+// It supports both the .git directory and the "gitdir: " file used by
+// worktrees and submodules.
+func findGitDir(dir string) (string, error) {
+	p := filepath.Join(dir, ".git")
+	fi, err := os.Stat(p)
+	if err != nil {
+		return "", err
+	}
+	if fi.IsDir() {
+		return p, nil
+	}
+	content, err := ioutil.ReadFile(p)
+	if err != nil {
+		return "", err
+	}
+	s := strings.TrimSpace(string(content))
+	if !strings.HasPrefix(s, "gitdir: ") {
+		return "", fmt.Errorf("invalid %s", p)
+	}
+	s = s[len("gitdir: "):]
+	if !filepath.IsAbs(s) {
+		s = filepath.Join(dir, s)
+	}
+	return filepath.Clean(s), nil
+}
+
 // getGitDir returns the .git directory path.
 func getGitDir(wd string) (string, error) {
 	gitDir, err := captureAbs(wd, "git", "rev-parse", "--git-dir")
@@ -9,6 +9,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -22,12 +23,8 @@ func TestGetRepoGitSlowSuccess(t *testing.T) {
 	if isDrone() {
 		t.Skipf("Give up on drone, it uses a weird go template which makes it not standard when using git init")
 	}
-	tmpDir, err := ioutil.TempDir("", "pre-commit-go")
-	defer func() {
-		if err := internal.RemoveAll(tmpDir); err != nil {
-			t.Errorf("%s", err)
-		}
-	}()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
 
 	setup(t, tmpDir)
 	r, err := getRepo(tmpDir, tmpDir)
@@ -49,14 +46,14 @@ func TestGetRepoGitSlowSuccess(t *testing.T) {
 	run(t, tmpDir, nil, "add", "src/foo/file1.go")
 	check(t, r, []string{}, []string{})
 
-	done, err := r.Stash()
+	done, err := r.Stash(UntrackedFail)
 	ut.AssertEqual(t, nil, err)
 	ut.AssertEqual(t, false, done)
 
 	write(t, tmpDir, "src/foo/file1.go", "package foo\n// hello\n")
 	check(t, r, []string{}, []string{"src/foo/file1.go"})
 
-	done, err = r.Stash()
+	done, err = r.Stash(UntrackedFail)
 	ut.AssertEqual(t, errors.New("Can't stash until there's at least one commit"), err)
 	ut.AssertEqual(t, false, done)
 
@@ -66,7 +63,7 @@ func TestGetRepoGitSlowSuccess(t *testing.T) {
 	commitInitial := assertHEAD(t, r, "f4edb8ac30289340040451b6f8c20d17614a9ae7")
 	ut.AssertEqual(t, "master", r.Ref())
 
-	done, err = r.Stash()
+	done, err = r.Stash(UntrackedFail)
 	ut.AssertEqual(t, nil, err)
 	ut.AssertEqual(t, true, done)
 	ut.AssertEqual(t, "package foo\n", read(t, tmpDir, "src/foo/file1.go"))
@@ -128,6 +125,9 @@ func TestGetRepoGitSlowSuccess(t *testing.T) {
 	ut.AssertEqual(t, []string{"src/foo/deleted/deleted.go"}, r.staged())
 	deterministicCommit(t, tmpDir)
 	commitWithDeleted := assertHEAD(t, r, "c9b5f312ec8eefb58beeaf8c3684bb832fdefef7")
+	base, err := r.MergeBase(commitWithDeleted, commitInitial)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, commitInitial, base)
 	c, err = r.Between(commitWithDeleted, GitInitialCommit, nil)
 	ut.AssertEqual(t, []string{"src/foo/deleted/deleted.go", "src/foo/file1.go"}, c.Changed().GoFiles())
 	ut.AssertEqual(t, []string{"src/foo/deleted/deleted.go", "src/foo/file1.go"}, c.Indirect().GoFiles())
@@ -173,14 +173,373 @@ func TestGetRepoGitSlowSuccess(t *testing.T) {
 	ut.AssertEqual(t, nil, c)
 }
 
-func TestGetRepoNoRepo(t *testing.T) {
+func TestCountCommits(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, tmpDir, "a.go", "package a\n")
+	run(t, tmpDir, nil, "add", "a.go")
+	deterministicCommit(t, tmpDir)
+	first := r.HEAD()
+	write(t, tmpDir, "b.go", "package a\n")
+	run(t, tmpDir, nil, "add", "b.go")
+	deterministicCommit(t, tmpDir)
+	n, err := r.CountCommits(first, r.HEAD())
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 1, n)
+	n, err = r.CountCommits(r.HEAD(), first)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, 0, n)
+	_, err = r.CountCommits("invalid", first)
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestCheckoutNoHook(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hooks are shell scripts")
+	}
 	t.Parallel()
-	tmpDir, err := ioutil.TempDir("", "pre-commit-go")
-	defer func() {
-		if err := internal.RemoveAll(tmpDir); err != nil {
-			t.Errorf("%s", err)
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, tmpDir, "a.go", "package a\n")
+	run(t, tmpDir, nil, "add", "a.go")
+	deterministicCommit(t, tmpDir)
+	first := r.HEAD()
+	write(t, tmpDir, "b.go", "package a\n")
+	run(t, tmpDir, nil, "add", "b.go")
+	deterministicCommit(t, tmpDir)
+	marker := filepath.Join(tmpDir, "ran")
+	write(t, tmpDir, filepath.Join(".git", "hooks", "post-checkout"), "#!/bin/sh\ntouch "+marker+"\n")
+	ut.AssertEqual(t, nil, os.Chmod(filepath.Join(tmpDir, ".git", "hooks", "post-checkout"), 0700))
+
+	ut.AssertEqual(t, nil, r.Checkout(string(first)))
+	assertHEAD(t, r, first)
+	_, err = os.Stat(marker)
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+}
+
+func TestUnpushed(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, tmpDir, "a.go", "package a\n")
+	run(t, tmpDir, nil, "add", "a.go")
+	deterministicCommit(t, tmpDir)
+	first := r.HEAD()
+	base, err := r.Unpushed(first)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, GitInitialCommit, base)
+
+	run(t, tmpDir, nil, "update-ref", "refs/remotes/origin/master", string(first))
+	base, err = r.Unpushed(first)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, first, base)
+
+	write(t, tmpDir, "b.go", "package a\n")
+	run(t, tmpDir, nil, "add", "b.go")
+	deterministicCommit(t, tmpDir)
+	write(t, tmpDir, "c.go", "package a\n")
+	run(t, tmpDir, nil, "add", "c.go")
+	deterministicCommit(t, tmpDir)
+	base, err = r.Unpushed(r.HEAD())
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, first, base)
+
+	_, err = r.Unpushed("invalid")
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestAddMessage(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, tmpDir, "a.go", "package a\n")
+	ut.AssertEqual(t, nil, r.Add("a.go"))
+	ut.AssertEqual(t, "A  a.go", run(t, tmpDir, nil, "status", "--porcelain", "a.go"))
+	ut.AssertEqual(t, true, r.Add("missing.go") != nil)
+	deterministicCommit(t, tmpDir)
+	msg, err := r.Message(r.HEAD())
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "yo", msg)
+	_, err = r.Message("invalid")
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestBlame(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, "nobody@localhost", r.UserEmail())
+	write(t, tmpDir, "a.go", "package a\n")
+	run(t, tmpDir, nil, "add", "a.go")
+	deterministicCommit(t, tmpDir)
+	write(t, tmpDir, "a.go", "package a\n\nvar b int\n")
+	authors, err := r.Blame("a.go")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"nobody@localhost", NotCommittedYet, NotCommittedYet}, authors)
+	_, err = r.Blame("missing.go")
+	ut.AssertEqual(t, true, err != nil)
+}
+
+func TestFiles(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, tmpDir, "a/a.go", "package a\n")
+	write(t, tmpDir, "b/b.go", "package b\n")
+	write(t, tmpDir, "b/b_test.go", "package b\n")
+	run(t, tmpDir, nil, "add", "a/a.go", "b/b.go")
+	write(t, tmpDir, "c/c.go", "package c\n")
+
+	c, err := r.Files([]string{"b/b_test.go", "./a/a.go", "a/a.go"}, nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"a/a.go", "b/b_test.go"}, c.Changed().GoFiles())
+	ut.AssertEqual(t, []string{"./a", "./b"}, c.Changed().Packages())
+	ut.AssertEqual(t, []string{"a/a.go", "b/b.go", "b/b_test.go"}, c.All().GoFiles())
+
+	c, err = r.Files([]string{"c/c.go"}, IgnorePatterns{"c"})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, nil, c)
+
+	_, err = r.Files([]string{"../a.go"}, nil)
+	ut.AssertEqual(t, errors.New("../a.go is outside the repository"), err)
+	_, err = r.Files([]string{"a"}, nil)
+	ut.AssertEqual(t, errors.New("a is not a file"), err)
+	_, err = r.Files([]string{"missing.go"}, nil)
+	ut.AssertEqual(t, errors.New("missing.go is not a file"), err)
+}
+
+func TestStaged(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	// Before the initial commit.
+	write(t, tmpDir, "a.go", "package a\n")
+	write(t, tmpDir, "vendor/v.go", "package v\n")
+	run(t, tmpDir, nil, "add", ".")
+	files, err := r.Staged(IgnorePatterns{"vendor"})
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"a.go"}, files)
+	deterministicCommit(t, tmpDir)
+
+	files, err = r.Staged(nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{}, files)
+	// The deleted files are included, the unstaged ones are not.
+	write(t, tmpDir, "README.md", "Hi\n")
+	run(t, tmpDir, nil, "add", "README.md")
+	run(t, tmpDir, nil, "rm", "-q", "--cached", "a.go")
+	write(t, tmpDir, "vendor/v.go", "package v\n// Changed.\n")
+	files, err = r.Staged(nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"README.md", "a.go"}, files)
+}
+
+func TestCheckoutIndex(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	root := filepath.Join(tmpDir, "repo")
+	ut.AssertEqual(t, nil, os.Mkdir(root, 0700))
+	setup(t, root)
+	r, err := getRepo(root, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	dir := filepath.Join(tmpDir, "worktree")
+	_, err = r.CheckoutIndex(dir, tmpDir)
+	ut.AssertEqual(t, errors.New("can't create a worktree until there's at least one commit"), err)
+
+	write(t, root, "a.go", "package a\n")
+	write(t, root, "b.go", "package a\n")
+	run(t, root, nil, "add", ".")
+	deterministicCommit(t, root)
+	head := r.HEAD()
+	// A staged change, an unstaged one on top and an untracked file.
+	write(t, root, "a.go", "package a\n// Staged.\n")
+	run(t, root, nil, "add", "a.go")
+	write(t, root, "a.go", "package a\n// Unstaged.\n")
+	write(t, root, "c.go", "package a\n")
+
+	w, err := r.CheckoutIndex(dir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, dir, w.Root())
+	ut.AssertEqual(t, head, w.HEAD())
+	ut.AssertEqual(t, "package a\n// Staged.\n", read(t, dir, "a.go"))
+	ut.AssertEqual(t, "package a\n", read(t, dir, "b.go"))
+	_, err = os.Stat(filepath.Join(dir, "c.go"))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+	scmDir, err := r.ScmDir()
+	ut.AssertEqual(t, nil, err)
+	wScmDir, err := w.ScmDir()
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, scmDir, wScmDir)
+	c, err := w.Between(Current, w.HEAD(), nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"a.go"}, c.Changed().Files())
+	ut.AssertEqual(t, []string{"a.go", "b.go"}, c.All().Files())
+
+	// The checkout is untouched.
+	ut.AssertEqual(t, "package a\n// Unstaged.\n", read(t, root, "a.go"))
+	ut.AssertEqual(t, nil, r.RemoveWorktree(dir))
+	_, err = os.Stat(dir)
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+	ut.AssertEqual(t, "package a\n// Unstaged.\n", read(t, root, "a.go"))
+	ut.AssertEqual(t, []string{"a.go"}, r.unstaged())
+}
+
+func TestWithEnv(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	root := filepath.Join(tmpDir, "repo")
+	ut.AssertEqual(t, nil, os.Mkdir(root, 0700))
+	setup(t, root)
+	r, err := getRepo(root, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, root, "a.go", "package a\n")
+	run(t, root, nil, "add", ".")
+	deterministicCommit(t, root)
+
+	// An empty index, as if everything was deleted.
+	w := r.WithEnv([]string{"GIT_INDEX_FILE=" + filepath.Join(tmpDir, "index")})
+	files, err := w.Staged(nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"a.go"}, files)
+	files, err = r.Staged(nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{}, files)
+	// An item without "=" removes the variable.
+	files, err = w.WithEnv([]string{"GIT_INDEX_FILE"}).Staged(nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{}, files)
+}
+
+func TestCacheImports(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, tmpDir, "a/a.go", "package a\n")
+	write(t, tmpDir, "b/b.go", "package b\n\nimport \"a\"\n")
+	run(t, tmpDir, nil, "add", ".")
+	deterministicCommit(t, tmpDir)
+	head := r.HEAD()
+	write(t, tmpDir, "a/a.go", "package a\n// Changed.\n")
+
+	r.CacheImports()
+	c, err := r.Between(Current, head, nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"./a", "./b"}, c.Indirect().Packages())
+	blob := run(t, tmpDir, nil, "rev-parse", ":b/b.go")
+	g := r.(*git)
+	ut.AssertEqual(t, map[string][]string{blob: {"a"}}, g.imports.imports)
+
+	// The imports of b/b.go are not parsed again, even through another
+	// repository.
+	g.imports.imports[blob] = nil
+	c, err = r.WithEnv(nil).Between(Current, head, nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"./a"}, c.Indirect().Packages())
+	// Unless it is modified.
+	write(t, tmpDir, "b/b.go", "package b\n\nimport \"a\"\n// Changed.\n")
+	c, err = r.Between(Current, head, nil)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, []string{"./a", "./b"}, c.Indirect().Packages())
+}
+
+func TestStashUntracked(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
+
+	setup(t, tmpDir)
+	r, err := getRepo(tmpDir, tmpDir)
+	ut.AssertEqual(t, nil, err)
+	write(t, tmpDir, "a.go", "package a\n")
+	run(t, tmpDir, nil, "add", "a.go")
+	deterministicCommit(t, tmpDir)
+	write(t, tmpDir, "scratch.txt", "notes\n")
+
+	done, err := r.Stash(UntrackedFail)
+	ut.AssertEqual(t, errors.New("can't stash if there are untracked files: [\"scratch.txt\"]"), err)
+	ut.AssertEqual(t, false, done)
+
+	// Nothing else changed so there's nothing to stash.
+	done, err = r.Stash(UntrackedInclude)
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, false, done)
+	ut.AssertEqual(t, "notes\n", read(t, tmpDir, "scratch.txt"))
+
+	done, err = r.Stash("")
+	ut.AssertEqual(t, nil, err)
+	ut.AssertEqual(t, true, done)
+	_, err = os.Stat(filepath.Join(tmpDir, "scratch.txt"))
+	ut.AssertEqual(t, true, os.IsNotExist(err))
+	ut.AssertEqual(t, nil, r.Restore())
+	ut.AssertEqual(t, "notes\n", read(t, tmpDir, "scratch.txt"))
+	ut.AssertEqual(t, []string{"scratch.txt"}, r.untracked())
+}
+
+func TestIgnorePatternsMatch(t *testing.T) {
+	t.Parallel()
+	i := IgnorePatterns{".*", "third_party/**", "!third_party/patches/**", "docs/gen", "!*.keep"}
+	data := []struct {
+		p        string
+		expected bool
+	}{
+		{"foo.go", false},
+		{".git/config", true},
+		{"a/.hidden/b.go", true},
+		{"third_party/x/x.go", true},
+		{"third_party/patches/x.patch", false},
+		{"src/third_party/x.go", false},
+		{"docs/gen/a.md", true},
+		{"docs/gen/.keep", false},
+		{"docs/general.md", false},
+	}
+	for i2, line := range data {
+		if actual := i.Match(line.p); actual != line.expected {
+			t.Errorf("%d: Match(%q) = %t", i2, line.p, actual)
 		}
-	}()
+	}
+}
+
+func TestGetRepoNoRepo(t *testing.T) {
+	t.Parallel()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
 
 	r, err := GetRepo(tmpDir, "")
 	ut.AssertEqual(t, errors.New("failed to find git checkout root"), err)
@@ -189,12 +548,8 @@ func TestGetRepoNoRepo(t *testing.T) {
 
 func TestGetRepoGitSlowFailures(t *testing.T) {
 	t.Parallel()
-	tmpDir, err := ioutil.TempDir("", "pre-commit-go")
-	defer func() {
-		if err := internal.RemoveAll(tmpDir); err != nil {
-			t.Errorf("%s", err)
-		}
-	}()
+	tmpDir, cleanup := internal.TempDir(t)
+	defer cleanup()
 
 	setup(t, tmpDir)
 	r, err := getRepo(tmpDir, tmpDir)
@@ -213,7 +568,7 @@ func TestGetRepoGitSlowFailures(t *testing.T) {
 	ut.AssertEqual(t, GitInitialCommit, r.HEAD())
 	ut.AssertEqual(t, "", r.Ref())
 
-	done, err := r.Stash()
+	done, err := r.Stash(UntrackedFail)
 	ut.AssertEqual(t, errors.New("failed to get list of untracked files"), err)
 	ut.AssertEqual(t, false, done)
 	errStr := r.Restore().Error()